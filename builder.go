@@ -47,6 +47,12 @@ func buildPhoneMetadataFromElement(document *PhoneNumberMetadataE, liteBuild boo
 		regionCode := territoryElement.ID
 
 		metadata := loadCountryMetadata(regionCode, &territoryElement, isShortNumberMetadata, isAlternateFormatsMetadata)
+		if liteBuild {
+			filterMetadataForLiteBuild(metadata)
+		}
+		if specialBuild {
+			filterMetadataForSpecialBuild(metadata)
+		}
 		collection.Metadata = append(collection.Metadata, metadata)
 	}
 	return &collection, nil
@@ -565,6 +571,7 @@ func setRelevantDescPatterns(metadata *PhoneMetadata, element *TerritoryE, isSho
 		metadata.Emergency = processPhoneNumberDescElement(generalDesc, element.Emergency)
 		metadata.TollFree = processPhoneNumberDescElement(generalDesc, element.TollFree)
 		metadata.PremiumRate = processPhoneNumberDescElement(generalDesc, element.PremiumRate)
+		metadata.SmsServices = processPhoneNumberDescElement(generalDesc, element.SmsServices)
 	}
 }
 
@@ -670,10 +677,13 @@ type TerritoryE struct {
 	ShortCode *PhoneNumberDescE `xml:"shortCode"`
 
 	// <!ELEMENT uan (nationalNumberPattern, possibleLengths, exampleNumber)>
-	Emergency *PhoneNumberDescE `xml:"Emergency"`
+	Emergency *PhoneNumberDescE `xml:"emergency"`
 
 	// <!ELEMENT voicemail (nationalNumberPattern, possibleLengths, exampleNumber)>
 	CarrierSpecific *PhoneNumberDescE `xml:"carrierSpecific"`
+
+	// <!ELEMENT smsServices (nationalNumberPattern, possibleLengths, exampleNumber)>
+	SmsServices *PhoneNumberDescE `xml:"smsServices"`
 }
 
 // <!ELEMENT numberFormat (leadingDigits*, format, intlFormat*)>
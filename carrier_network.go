@@ -0,0 +1,406 @@
+package phonenumbers
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers/gen"
+)
+
+// CarrierNetworkConfidence is how sure we are that the networks mapped to a carrier prefix are
+// the ones numbers with that prefix are actually on.
+type CarrierNetworkConfidence int
+
+const (
+	// The carrier name was found inside the brand or operator of the network, the way numbers used
+	// to be matched to networks before the mapping existed.
+	CARRIER_NETWORK_LOW CarrierNetworkConfidence = iota + 1
+	// Every word of the carrier name is a word of the brand or operator of the network, or the
+	// other way around.
+	CARRIER_NETWORK_MEDIUM
+	// The carrier name is the brand or operator of the network.
+	CARRIER_NETWORK_HIGH
+	// The mapping was curated by hand.
+	CARRIER_NETWORK_CURATED
+)
+
+var carrierNetworkConfidenceNames = map[CarrierNetworkConfidence]string{
+	CARRIER_NETWORK_LOW:     "low",
+	CARRIER_NETWORK_MEDIUM:  "medium",
+	CARRIER_NETWORK_HIGH:    "high",
+	CARRIER_NETWORK_CURATED: "curated",
+}
+
+func (c CarrierNetworkConfidence) String() string {
+	if name, found := carrierNetworkConfidenceNames[c]; found {
+		return name
+	}
+	return "unknown"
+}
+
+func parseCarrierNetworkConfidence(name string) (CarrierNetworkConfidence, error) {
+	for confidence, confidenceName := range carrierNetworkConfidenceNames {
+		if confidenceName == name {
+			return confidence, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown carrier network confidence: %s", name)
+}
+
+const (
+	// Separates the confidence from the ambiguity flag in the first value of each prefix of the
+	// generated carrier network map, e.g. "high;ambiguous".
+	CARRIER_NETWORK_FLAG_SEPARATOR = ";"
+	CARRIER_NETWORK_AMBIGUOUS      = "ambiguous"
+	// Separates the MCC from the MNC in the other values, e.g. "429-01".
+	CARRIER_NETWORK_MCC_MNC_SEPARATOR = "-"
+)
+
+// CarrierNetwork is a mobile network identified by its MCC and MNC.
+type CarrierNetwork struct {
+	Mcc string `json:"mcc"`
+	Mnc string `json:"mnc"`
+}
+
+// CarrierNetworks are the networks we believe numbers starting with Prefix are on. When more than
+// one network is equally likely the mapping is Ambiguous, and Networks is sorted with the most
+// likely network first otherwise.
+type CarrierNetworks struct {
+	Prefix     int                      `json:"prefix"`
+	Networks   []CarrierNetwork         `json:"networks"`
+	Confidence CarrierNetworkConfidence `json:"confidence"`
+	Ambiguous  bool                     `json:"ambiguous"`
+}
+
+var (
+	carrierNetworkOnce sync.Once
+	carrierNetworkMap  *intStringArrayMap
+)
+
+// GetCarrierNetworksForNumber returns the networks we believe the number is on, looked up by the
+// carrier prefix GetCarrierWithPrefixForNumber matches for the number. It returns nil if there
+// is no carrier for the number or its carrier couldn't be mapped to a network. Note due to number
+// porting this is only a guess, there is no guarantee to its accuracy.
+func GetCarrierNetworksForNumber(number *PhoneNumber) (*CarrierNetworks, error) {
+	return getCarrierNetworksForNumber(activeMetadata(), number)
+}
+
+func getCarrierNetworksForNumber(md *metadataState, number *PhoneNumber) (*CarrierNetworks, error) {
+	// the mapping is generated from the English carrier names, so the prefix must come from those
+	_, prefix, err := getCarrierWithPrefixForNumber(md, number, "en")
+	if err != nil {
+		return nil, err
+	}
+	if prefix == 0 {
+		return nil, nil
+	}
+	return GetCarrierNetworksForPrefix(prefix)
+}
+
+// GetCarrierNetworksForPrefix returns the networks mapped to the passed in carrier prefix, as
+// returned by GetCarrierWithPrefixForNumber, or nil if it isn't mapped to any.
+func GetCarrierNetworksForPrefix(prefix int) (*CarrierNetworks, error) {
+	var err error
+	carrierNetworkOnce.Do(func() {
+		carrierNetworkMap, err = loadIntStringArrayMap(gen.CarrierNetworkData)
+	})
+
+	if carrierNetworkMap == nil {
+		return nil, fmt.Errorf("error loading carrier network map: %v", err)
+	}
+
+	values, found := carrierNetworkMap.Map[prefix]
+	if !found || len(values) < 2 {
+		return nil, nil
+	}
+	return decodeCarrierNetworks(prefix, values)
+}
+
+// decodes the values of a prefix of the generated carrier network map, see encode
+func decodeCarrierNetworks(prefix int, values []string) (*CarrierNetworks, error) {
+	flags := strings.Split(values[0], CARRIER_NETWORK_FLAG_SEPARATOR)
+	confidence, err := parseCarrierNetworkConfidence(flags[0])
+	if err != nil {
+		return nil, err
+	}
+
+	networks := &CarrierNetworks{
+		Prefix:     prefix,
+		Networks:   make([]CarrierNetwork, 0, len(values)-1),
+		Confidence: confidence,
+		Ambiguous:  len(flags) > 1 && flags[1] == CARRIER_NETWORK_AMBIGUOUS,
+	}
+	for _, value := range values[1:] {
+		mcc, mnc, found := strings.Cut(value, CARRIER_NETWORK_MCC_MNC_SEPARATOR)
+		if !found {
+			return nil, fmt.Errorf("invalid network %s for carrier prefix %d", value, prefix)
+		}
+		networks.Networks = append(networks.Networks, CarrierNetwork{Mcc: mcc, Mnc: mnc})
+	}
+	return networks, nil
+}
+
+// encodes the networks as the values of a prefix in the generated carrier network map, the first
+// value being the confidence and ambiguity flag and the others the MCC and MNC of each network
+func (c *CarrierNetworks) encode() []string {
+	flags := c.Confidence.String()
+	if c.Ambiguous {
+		flags += CARRIER_NETWORK_FLAG_SEPARATOR + CARRIER_NETWORK_AMBIGUOUS
+	}
+	values := []string{flags}
+	for _, network := range c.Networks {
+		values = append(values, network.Mcc+CARRIER_NETWORK_MCC_MNC_SEPARATOR+network.Mnc)
+	}
+	return values
+}
+
+// CarrierNetworkOverride is a hand curated mapping of carriers to networks. It applies either to
+// every carrier prefix starting with Prefix, or to the prefixes of the carrier named Carrier in
+// Region. When Ambiguous is set the networks are equally likely, otherwise the first is the
+// network numbers are most likely on. An override without networks removes the mapping.
+type CarrierNetworkOverride struct {
+	Prefix    int              `json:"prefix,omitempty"`
+	Region    string           `json:"region,omitempty"`
+	Carrier   string           `json:"carrier,omitempty"`
+	Networks  []CarrierNetwork `json:"networks"`
+	Ambiguous bool             `json:"ambiguous,omitempty"`
+}
+
+// BuildCarrierNetworkMap builds the mapping from carrier prefixes to networks, in the format of
+// the generated carrier network map. carriers maps each carrier prefix to its English carrier
+// name, and networks are the known networks of every region.
+//
+// Overrides take precedence, the most specific prefix override first and then those naming the
+// carrier. Other carriers are matched by name to the networks of the regions sharing the country
+// calling code of the prefix, keeping only the networks matched with the highest confidence and
+// preferring those which are operational. Carriers which match no network are left out.
+func BuildCarrierNetworkMap(carriers map[int]string, networks []Network, overrides []CarrierNetworkOverride) (map[int][]string, error) {
+	md := activeMetadata()
+
+	regionNetworks := make(map[string][]Network)
+	for _, network := range networks {
+		regionNetworks[network.CountryCode] = append(regionNetworks[network.CountryCode], network)
+	}
+
+	prefixOverrides := make(map[int]*CarrierNetworkOverride)
+	carrierOverrides := make(map[string]*CarrierNetworkOverride)
+	for i := range overrides {
+		override := &overrides[i]
+		if override.Prefix != 0 {
+			prefixOverrides[override.Prefix] = override
+		} else if override.Region != "" && override.Carrier != "" {
+			carrierOverrides[override.Region+"|"+normalizeCarrierName(override.Carrier)] = override
+		} else {
+			return nil, fmt.Errorf("carrier network override %d needs a prefix or a region and carrier", i)
+		}
+		for _, network := range override.Networks {
+			if len(network.Mcc) != 3 || len(network.Mnc) < 2 || len(network.Mnc) > 3 {
+				return nil, fmt.Errorf("carrier network override %d has invalid network %s-%s", i, network.Mcc, network.Mnc)
+			}
+		}
+	}
+
+	prefixMap := make(map[int][]string)
+	for prefix, carrier := range carriers {
+		regions := regionsForCarrierPrefix(md, prefix)
+
+		override := prefixOverrideFor(prefixOverrides, prefix)
+		for _, region := range regions {
+			if override != nil {
+				break
+			}
+			override = carrierOverrides[region+"|"+normalizeCarrierName(carrier)]
+		}
+
+		var mapped *CarrierNetworks
+		if override != nil {
+			if len(override.Networks) == 0 {
+				continue
+			}
+			mapped = &CarrierNetworks{
+				Networks:   override.Networks,
+				Confidence: CARRIER_NETWORK_CURATED,
+				Ambiguous:  override.Ambiguous,
+			}
+		} else {
+			var candidates []Network
+			for _, region := range regions {
+				candidates = append(candidates, regionNetworks[region]...)
+			}
+			mapped = matchCarrierNetworks(carrier, candidates)
+			if mapped == nil {
+				continue
+			}
+		}
+		prefixMap[prefix] = mapped.encode()
+	}
+	return prefixMap, nil
+}
+
+// returns the override for the longest prefix of the passed in carrier prefix, if any
+func prefixOverrideFor(overrides map[int]*CarrierNetworkOverride, prefix int) *CarrierNetworkOverride {
+	for ; prefix > 0; prefix /= 10 {
+		if override, found := overrides[prefix]; found {
+			return override
+		}
+	}
+	return nil
+}
+
+// returns the regions the carrier prefix can belong to. When its country calling code is shared,
+// such as the NANPA countries, that's the region whose leading digits the prefix starts with, or
+// otherwise the regions which have no leading digits.
+func regionsForCarrierPrefix(md *metadataState, prefix int) []string {
+	digits := strconv.Itoa(prefix)
+	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i <= len(digits); i++ {
+		countryCode, _ := strconv.Atoi(digits[:i])
+		regions := getRegionCodesForCountryCode(md, countryCode)
+		if len(regions) <= 1 {
+			if len(regions) == 1 {
+				return regions
+			}
+			continue
+		}
+
+		nationalNumber := digits[i:]
+		var unprefixed []string
+		for _, region := range regions {
+			metadata := getMetadataForRegion(md, region)
+			if metadata == nil {
+				continue
+			}
+			if len(metadata.GetLeadingDigits()) == 0 {
+				unprefixed = append(unprefixed, region)
+			} else if regexFor("^(?:" + metadata.GetLeadingDigits() + ")").MatchString(nationalNumber) {
+				return []string{region}
+			}
+		}
+		return unprefixed
+	}
+	return nil
+}
+
+// matches the carrier by name to the passed in networks, returning nil if none match
+func matchCarrierNetworks(carrier string, networks []Network) *CarrierNetworks {
+	name := normalizeCarrierName(carrier)
+	if name == "" {
+		return nil
+	}
+
+	var best CarrierNetworkConfidence
+	var matches []Network
+	for _, network := range networks {
+		confidence := carrierNameConfidence(name, network.Brand)
+		if operatorConfidence := carrierNameConfidence(name, network.Operator); operatorConfidence > confidence {
+			confidence = operatorConfidence
+		}
+		if confidence == 0 || confidence < best {
+			continue
+		}
+		if confidence > best {
+			best = confidence
+			matches = matches[:0]
+		}
+		matches = append(matches, network)
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+
+	// prefer operational networks, if any of the matches are
+	operational := make([]Network, 0, len(matches))
+	for _, network := range matches {
+		if network.IsOperational() {
+			operational = append(operational, network)
+		}
+	}
+	if len(operational) > 0 {
+		matches = operational
+	}
+
+	mapped := &CarrierNetworks{Confidence: best}
+	seen := make(map[CarrierNetwork]bool, len(matches))
+	for _, network := range matches {
+		carrierNetwork := CarrierNetwork{Mcc: network.Mcc, Mnc: network.Mnc}
+		if !seen[carrierNetwork] {
+			seen[carrierNetwork] = true
+			mapped.Networks = append(mapped.Networks, carrierNetwork)
+		}
+	}
+	sort.Slice(mapped.Networks, func(i, j int) bool {
+		a, b := mapped.Networks[i], mapped.Networks[j]
+		return a.Mcc < b.Mcc || (a.Mcc == b.Mcc && a.Mnc < b.Mnc)
+	})
+	mapped.Ambiguous = len(mapped.Networks) > 1
+	return mapped
+}
+
+// returns how confidently the normalized carrier name matches the brand or operator of a network,
+// or 0 if it doesn't match at all
+func carrierNameConfidence(name, field string) CarrierNetworkConfidence {
+	// brands are often a list of alternatives, e.g. "Namaste / NT Mobile / Sky Phone"
+	alternatives := strings.Split(field, "/")
+	if len(alternatives) > 1 {
+		alternatives = append(alternatives, field)
+	}
+
+	var best CarrierNetworkConfidence
+	for _, alternative := range alternatives {
+		normalized := normalizeCarrierName(alternative)
+		if normalized == "" {
+			continue
+		}
+
+		var confidence CarrierNetworkConfidence
+		if normalized == name {
+			confidence = CARRIER_NETWORK_HIGH
+		} else if containsWords(normalized, name) || containsWords(name, normalized) {
+			confidence = CARRIER_NETWORK_MEDIUM
+		} else if len(name) >= 3 && strings.Contains(strings.ReplaceAll(normalized, " ", ""), strings.ReplaceAll(name, " ", "")) {
+			confidence = CARRIER_NETWORK_LOW
+		}
+		if confidence > best {
+			best = confidence
+		}
+	}
+	return best
+}
+
+// returns whether every word of words is a word of s
+func containsWords(s, words string) bool {
+	have := make(map[string]bool)
+	for _, word := range strings.Fields(s) {
+		have[word] = true
+	}
+	for _, word := range strings.Fields(words) {
+		if !have[word] {
+			return false
+		}
+	}
+	return true
+}
+
+// words that tell us nothing about which carrier a name refers to
+var carrierNameNoise = map[string]bool{
+	"ag": true, "bv": true, "co": true, "company": true, "corp": true, "corporation": true,
+	"gmbh": true, "inc": true, "limited": true, "llc": true, "ltd": true, "nv": true,
+	"plc": true, "private": true, "pvt": true, "sa": true, "the": true,
+}
+
+// lower cases the carrier name and reduces it to its words, separated by a single space
+func normalizeCarrierName(name string) string {
+	words := strings.FieldsFunc(strings.ToLower(name), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	kept := words[:0]
+	for _, word := range words {
+		if !carrierNameNoise[word] {
+			kept = append(kept, word)
+		}
+	}
+	return strings.Join(kept, " ")
+}
@@ -0,0 +1,214 @@
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// metadataChanges is a summary of the regions added, removed and changed by the metadata we build
+// compared to the metadata it replaces, so metadata updates can be reviewed
+type metadataChanges struct {
+	lines   []string
+	added   int
+	removed int
+	changed int
+}
+
+// compares the metadata built for file with what it replaces, previous being nil if there was
+// nothing to replace
+func (c *metadataChanges) compare(file string, previous, current *phonenumbers.PhoneMetadataCollection) {
+	if previous == nil {
+		c.lines = append(c.lines, fmt.Sprintf("%s: no previous metadata to compare with", file))
+		return
+	}
+
+	before := metadataByKey(previous)
+	after := metadataByKey(current)
+
+	keys := make([]string, 0, len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, found := before[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		old, hadOld := before[key]
+		updated, hasUpdated := after[key]
+		switch {
+		case !hadOld:
+			c.added++
+			c.lines = append(c.lines, fmt.Sprintf("%s: + %s", file, key))
+		case !hasUpdated:
+			c.removed++
+			c.lines = append(c.lines, fmt.Sprintf("%s: - %s", file, key))
+		default:
+			if changes := describeChanges(old, updated); len(changes) > 0 {
+				c.changed++
+				c.lines = append(c.lines, fmt.Sprintf("%s: ~ %s %s", file, key, strings.Join(changes, ", ")))
+			}
+		}
+	}
+}
+
+func (c *metadataChanges) print(w io.Writer) {
+	for _, line := range c.lines {
+		fmt.Fprintln(w, line)
+	}
+	fmt.Fprintf(w, "%d regions added, %d removed, %d changed\n", c.added, c.removed, c.changed)
+}
+
+// regions are identified by their region code, and non-geographical entities by their calling code
+func metadataByKey(collection *phonenumbers.PhoneMetadataCollection) map[string]*phonenumbers.PhoneMetadata {
+	byKey := make(map[string]*phonenumbers.PhoneMetadata, len(collection.GetMetadata()))
+	for _, metadata := range collection.GetMetadata() {
+		key := metadata.GetId()
+		if key == "001" {
+			key = fmt.Sprintf("001 (+%d)", metadata.GetCountryCode())
+		}
+		byKey[key] = metadata
+	}
+	return byKey
+}
+
+// returns which parts of the metadata of a region have changed
+func describeChanges(old, updated *phonenumbers.PhoneMetadata) []string {
+	var changes []string
+
+	oldDescs, updatedDescs := namedDescs(old), namedDescs(updated)
+	for i, named := range oldDescs {
+		oldDesc, updatedDesc := named.desc, updatedDescs[i].desc
+		if oldDesc.GetNationalNumberPattern() != updatedDesc.GetNationalNumberPattern() {
+			changes = append(changes, named.name+" pattern")
+		}
+		if !equalLengths(oldDesc.GetPossibleLength(), updatedDesc.GetPossibleLength()) || !equalLengths(oldDesc.GetPossibleLengthLocalOnly(), updatedDesc.GetPossibleLengthLocalOnly()) {
+			changes = append(changes, named.name+" lengths")
+		}
+	}
+
+	fields := []struct {
+		name         string
+		old, updated string
+	}{
+		{"country code", strconv.Itoa(int(old.GetCountryCode())), strconv.Itoa(int(updated.GetCountryCode()))},
+		{"leading digits", old.GetLeadingDigits(), updated.GetLeadingDigits()},
+		{"international prefix", old.GetInternationalPrefix(), updated.GetInternationalPrefix()},
+		{"preferred international prefix", old.GetPreferredInternationalPrefix(), updated.GetPreferredInternationalPrefix()},
+		{"national prefix", old.GetNationalPrefix(), updated.GetNationalPrefix()},
+		{"national prefix for parsing", old.GetNationalPrefixForParsing(), updated.GetNationalPrefixForParsing()},
+		{"national prefix transform rule", old.GetNationalPrefixTransformRule(), updated.GetNationalPrefixTransformRule()},
+	}
+	for _, field := range fields {
+		if field.old != field.updated {
+			changes = append(changes, field.name)
+		}
+	}
+
+	if !equalFormats(old.GetNumberFormat(), updated.GetNumberFormat()) {
+		changes = append(changes, "formats")
+	}
+	if !equalFormats(old.GetIntlNumberFormat(), updated.GetIntlNumberFormat()) {
+		changes = append(changes, "intl formats")
+	}
+
+	// anything else, such as example numbers, is summed up
+	if len(changes) == 0 && !proto.Equal(old, updated) {
+		changes = append(changes, "other fields")
+	}
+	return changes
+}
+
+type namedDesc struct {
+	name string
+	desc *phonenumbers.PhoneNumberDesc
+}
+
+func namedDescs(m *phonenumbers.PhoneMetadata) []namedDesc {
+	return []namedDesc{
+		{"general", m.GetGeneralDesc()}, {"fixed line", m.GetFixedLine()}, {"mobile", m.GetMobile()},
+		{"toll free", m.GetTollFree()}, {"premium rate", m.GetPremiumRate()}, {"shared cost", m.GetSharedCost()},
+		{"personal number", m.GetPersonalNumber()}, {"voip", m.GetVoip()}, {"pager", m.GetPager()},
+		{"uan", m.GetUan()}, {"emergency", m.GetEmergency()}, {"voicemail", m.GetVoicemail()},
+		{"short code", m.GetShortCode()}, {"standard rate", m.GetStandardRate()},
+		{"carrier specific", m.GetCarrierSpecific()}, {"sms services", m.GetSmsServices()},
+		{"no international dialling", m.GetNoInternationalDialling()},
+	}
+}
+
+func equalLengths(a, b []int32) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func equalFormats(a, b []*phonenumbers.NumberFormat) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// reads the metadata in a file written by generateBinFile, returning nil if there is no such file
+func readBinFile(path string) (*phonenumbers.PhoneMetadataCollection, error) {
+	body, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, quoted, found := strings.Cut(string(body), " = ")
+	if !found {
+		return nil, errors.New("no metadata variable found")
+	}
+	encoded, err := strconv.Unquote(strings.TrimSpace(quoted))
+	if err != nil {
+		return nil, err
+	}
+	compressed, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, err
+	}
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	collection := &phonenumbers.PhoneMetadataCollection{}
+	if err := proto.Unmarshal(data, collection); err != nil {
+		return nil, err
+	}
+	return collection, nil
+}
@@ -5,10 +5,11 @@ import (
 	"compress/gzip"
 	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"math"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"sort"
 	"strconv"
@@ -21,95 +22,261 @@ import (
 )
 
 func main() {
-	if err := buildMetadata(); err != nil {
+	src := flag.String("src", "", "a libphonenumber checkout or .tar.gz release to build from, by default the upstream repo is cloned")
+	out := flag.String("out", "gen", "the directory to write the generated files to")
+	regions := flag.String("regions", "", "comma separated region codes to also build a metadata subset of, 001 including every non-geographical entity")
+	regionsLite := flag.Bool("regions-lite", false, "leave example numbers out of the region subset too")
+	flag.Parse()
+
+	if err := buildMetadata(*src, *out, parseRegions(*regions), *regionsLite); err != nil {
 		fmt.Fprintln(os.Stderr, err)
 		os.Exit(1)
 	}
 }
 
-func buildMetadata() error {
-	fmt.Print("Cloning upstream repo... ")
+// build tags used to select between the variants of the number metadata
+const (
+	liteTag    = "phonenumbers_lite"
+	regionsTag = "phonenumbers_regions"
+)
 
-	if err := cloneUpstreamRepo("git@github.com:google/libphonenumber.git"); err != nil {
-		return err
+// metadataVariant is one of the variants of the number metadata we build. Each is written to
+// files with their own suffix and a build constraint so exactly one variant is compiled in.
+type metadataVariant struct {
+	name       string
+	suffix     string
+	constraint string
+	lite       bool
+	regions    map[string]bool // nil for every region
+}
+
+// the files holding the number metadata of each variant, the other data is shared by all of them
+const (
+	numberMetadataFile      = "metadata%s_bin.go"
+	shortNumberMetadataFile = "shortnumber_metadata%s_bin.go"
+	regionMetadataFile      = "countrycode_to_region%s_bin.go"
+
+	// stops builds with the regions tag compiling when there is no region subset to select
+	regionsGuardFile = "regions_missing.go"
+)
+
+const regionsGuard = `//go:build phonenumbers_regions
+
+package gen
+
+// The metadata of a region subset hasn't been built, so there's nothing for the
+// phonenumbers_regions tag to select. Run buildmetadata with -regions, which replaces this file
+// with the metadata of the regions passed, as described in the README.
+var _ = PHONENUMBERS_REGIONS_NEEDS_BUILDMETADATA_RUN_WITH_REGIONS
+`
+
+func metadataVariants(regions map[string]bool, regionsLite bool) []metadataVariant {
+	variants := []metadataVariant{
+		{name: "full", constraint: fmt.Sprintf("!%s && !%s", liteTag, regionsTag)},
+		{name: "lite", suffix: "_lite", constraint: fmt.Sprintf("%s && !%s", liteTag, regionsTag), lite: true},
+	}
+	if len(regions) > 0 {
+		variants = append(variants, metadataVariant{name: "regions", suffix: "_regions", constraint: regionsTag, lite: regionsLite, regions: regions})
 	}
+	return variants
+}
 
-	fmt.Print("OK\nBuilding number metadata...")
+func parseRegions(list string) map[string]bool {
+	if strings.TrimSpace(list) == "" {
+		return nil
+	}
+	regions := make(map[string]bool)
+	for _, region := range strings.Split(list, ",") {
+		regions[strings.ToUpper(strings.TrimSpace(region))] = true
+	}
+	return regions
+}
 
-	metadata, err := buildNumberMetadata("resources/PhoneNumberMetadata.xml", "NumberData", "metadata_bin.go", false)
+func buildMetadata(src, out string, regions map[string]bool, regionsLite bool) error {
+	srcDir, cleanup, err := prepareSource(src)
 	if err != nil {
 		return err
 	}
+	defer cleanup()
 
-	fmt.Print("OK\nBuilding short number metadata...")
+	srcPath := func(path string) string { return filepath.Join(srcDir, filepath.FromSlash(path)) }
+	outPath := func(file string) string { return filepath.Join(out, file) }
 
-	_, err = buildNumberMetadata("resources/ShortNumberMetadata.xml", "ShortNumberData", "shortnumber_metadata_bin.go", true)
-	if err != nil {
-		return err
+	if err := os.MkdirAll(out, 0775); err != nil {
+		return fmt.Errorf("error creating %s: %w", out, err)
 	}
 
-	fmt.Print("OK\nBuilding region metadata...")
+	changes := &metadataChanges{}
 
-	if err := buildRegionMetadata(metadata, "RegionData", "countrycode_to_region_bin.go"); err != nil {
-		return err
+	for _, variant := range metadataVariants(regions, regionsLite) {
+		fmt.Printf("OK\nBuilding %s number metadata...", variant.name)
+
+		metadata, err := buildNumberMetadata(srcPath("resources/PhoneNumberMetadata.xml"), "NumberData", outPath(fmt.Sprintf(numberMetadataFile, variant.suffix)), false, variant, changes)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("OK\nBuilding %s short number metadata...", variant.name)
+
+		_, err = buildNumberMetadata(srcPath("resources/ShortNumberMetadata.xml"), "ShortNumberData", outPath(fmt.Sprintf(shortNumberMetadataFile, variant.suffix)), true, variant, changes)
+		if err != nil {
+			return err
+		}
+
+		// lite builds have every region, so share the region metadata of the full build
+		if variant.lite && variant.regions == nil {
+			continue
+		}
+
+		fmt.Printf("OK\nBuilding %s region metadata...", variant.name)
+
+		constraint := "!" + regionsTag
+		if variant.regions != nil {
+			constraint = variant.constraint
+		}
+		if err := buildRegionMetadata(metadata, "RegionData", outPath(fmt.Sprintf(regionMetadataFile, variant.suffix)), constraint); err != nil {
+			return err
+		}
+	}
+
+	// a region subset built before is stale now, so remove it rather than leave it out of date,
+	// guarding against builds with the regions tag until one is built again
+	if len(regions) == 0 {
+		for _, file := range []string{numberMetadataFile, shortNumberMetadataFile, regionMetadataFile} {
+			stale := outPath(fmt.Sprintf(file, "_regions"))
+			if err := os.Remove(stale); err == nil {
+				fmt.Printf("\n > removed %s, pass -regions to rebuild it", stale)
+			}
+		}
+		if err := os.WriteFile(outPath(regionsGuardFile), []byte(regionsGuard), os.FileMode(0664)); err != nil {
+			return fmt.Errorf("error writing %s: %w", regionsGuardFile, err)
+		}
+	} else if err := os.Remove(outPath(regionsGuardFile)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing %s: %w", regionsGuardFile, err)
 	}
 
 	fmt.Print("OK\nBuilding timezone metadata...")
 
-	if err := buildTimezoneMetadata("resources/timezones/map_data.txt", "TimezoneData", "prefix_to_timezone_bin.go"); err != nil {
+	if err := buildTimezoneMetadata(srcPath("resources/timezones/map_data.txt"), "TimezoneData", outPath("prefix_to_timezone_bin.go")); err != nil {
 		return err
 	}
 
 	fmt.Println("OK\nBuilding carrier prefix metadata...")
 
-	if err := buildPrefixMetadata("resources/carrier", "CarrierData", "prefix_to_carriers_bin.go"); err != nil {
+	if err := buildPrefixMetadata(srcPath("resources/carrier"), "CarrierData", outPath("prefix_to_carriers_bin.go")); err != nil {
 		return err
 	}
 
-	fmt.Println("Building geographic prefix metadata...")
+	fmt.Println("Building carrier network metadata...")
 
-	if err := buildPrefixMetadata("resources/geocoding", "GeocodingData", "prefix_to_geocodings_bin.go"); err != nil {
+	if err := buildCarrierNetworkMetadata(srcPath("resources/carrier/en"), "cmd/buildmetadata/carrier_networks.json", "CarrierNetworkData", outPath("prefix_to_networks_bin.go")); err != nil {
 		return err
 	}
 
-	return nil
-}
-
-func cloneUpstreamRepo(url string) error {
-	os.RemoveAll("_build")
+	fmt.Println("Building geographic prefix metadata...")
 
-	cmd := exec.Command("git", "clone", "--depth=1", url, "_build")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("error cloning upstream repo: %w", err)
+	if err := buildPrefixMetadata(srcPath("resources/geocoding"), "GeocodingData", outPath("prefix_to_geocodings_bin.go")); err != nil {
+		return err
 	}
 
+	fmt.Println("\nChanges to number metadata:")
+	changes.print(os.Stdout)
+
 	return nil
 }
 
-func buildNumberMetadata(srcFile, varName, dstFile string, short bool) (*phonenumbers.PhoneMetadataCollection, error) {
-	body, err := os.ReadFile("_build/" + srcFile)
+func buildNumberMetadata(srcFile, varName, dstFile string, short bool, variant metadataVariant, changes *metadataChanges) (*phonenumbers.PhoneMetadataCollection, error) {
+	body, err := os.ReadFile(srcFile)
 	if err != nil {
 		return nil, fmt.Errorf("error reading %s: %w", srcFile, err)
 	}
 
-	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, false, false, short)
+	collection, err := phonenumbers.BuildPhoneMetadataCollection(body, variant.lite, false, short)
 	if err != nil {
 		return nil, fmt.Errorf("error parsing %s: %w", srcFile, err)
 	}
 
+	// every region has emergency numbers, so short number metadata without any means the builder
+	// missed them and the short number checks would silently find nothing
+	if short && !hasEmergencyNumbers(collection) {
+		return nil, fmt.Errorf("error parsing %s: no region has emergency numbers", srcFile)
+	}
+
+	if variant.regions != nil {
+		if collection, err = filterRegions(collection, variant.regions, short); err != nil {
+			return nil, fmt.Errorf("error filtering %s: %w", srcFile, err)
+		}
+	}
+
 	data, err := proto.Marshal(collection)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling metadata as protobuf: %w", err)
 	}
 
-	if err := os.WriteFile("gen/"+dstFile, generateBinFile(varName, data), os.FileMode(0664)); err != nil {
+	// the other variants are derived from the full metadata so only it is compared
+	if variant.regions == nil && !variant.lite {
+		previous, err := readBinFile(dstFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading previous %s: %w", dstFile, err)
+		}
+		// with nothing to replace, as when writing somewhere other than gen, compare with what the
+		// phonenumbers package embeds
+		if previous == nil {
+			if previous, err = embeddedMetadata(short); err != nil {
+				return nil, fmt.Errorf("error reading embedded metadata: %w", err)
+			}
+		}
+		changes.compare(filepath.Base(dstFile), previous, collection)
+	}
+
+	if err := os.WriteFile(dstFile, generateBinFile(varName, variant.constraint, data), os.FileMode(0664)); err != nil {
 		return nil, fmt.Errorf("error writing %s: %w", dstFile, err)
 	}
 
 	return collection, nil
 }
 
-func buildRegionMetadata(metadata *phonenumbers.PhoneMetadataCollection, varName, dstFile string) error {
+// returns the metadata the phonenumbers package was built with
+func embeddedMetadata(short bool) (*phonenumbers.PhoneMetadataCollection, error) {
+	if short {
+		return phonenumbers.ShortNumberMetadataCollection()
+	}
+	return phonenumbers.MetadataCollection()
+}
+
+func hasEmergencyNumbers(collection *phonenumbers.PhoneMetadataCollection) bool {
+	for _, metadata := range collection.GetMetadata() {
+		pattern := metadata.GetEmergency().GetNationalNumberPattern()
+		if pattern != "" && pattern != "NA" {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the collection with only the metadata of the regions, short number metadata not having
+// non-geographical entities, or any of the regions for that matter
+func filterRegions(collection *phonenumbers.PhoneMetadataCollection, regions map[string]bool, short bool) (*phonenumbers.PhoneMetadataCollection, error) {
+	filtered := &phonenumbers.PhoneMetadataCollection{}
+	found := make(map[string]bool, len(regions))
+	for _, metadata := range collection.GetMetadata() {
+		if regions[metadata.GetId()] {
+			filtered.Metadata = append(filtered.Metadata, metadata)
+			found[metadata.GetId()] = true
+		}
+	}
+
+	if !short {
+		for region := range regions {
+			if !found[region] {
+				return nil, fmt.Errorf("no metadata for region %s", region)
+			}
+		}
+	}
+	return filtered, nil
+}
+
+func buildRegionMetadata(metadata *phonenumbers.PhoneMetadataCollection, varName, dstFile, constraint string) error {
 	regionMap := phonenumbers.BuildCountryCodeToRegionMap(metadata)
 
 	// generate our map data
@@ -118,7 +285,7 @@ func buildRegionMetadata(metadata *phonenumbers.PhoneMetadataCollection, varName
 		return fmt.Errorf("error generating %s: %w", dstFile, err)
 	}
 
-	if err := os.WriteFile("gen/"+dstFile, generateBinFile(varName, data), os.FileMode(0664)); err != nil {
+	if err := os.WriteFile(dstFile, generateBinFile(varName, constraint, data), os.FileMode(0664)); err != nil {
 		return fmt.Errorf("error writing %s: %w", dstFile, err)
 	}
 
@@ -126,7 +293,7 @@ func buildRegionMetadata(metadata *phonenumbers.PhoneMetadataCollection, varName
 }
 
 func buildTimezoneMetadata(srcFile, varName, dstFile string) error {
-	body, err := os.ReadFile("_build/" + srcFile)
+	body, err := os.ReadFile(srcFile)
 	if err != nil {
 		return fmt.Errorf("error reading %s: %w", srcFile, err)
 	}
@@ -166,7 +333,7 @@ func buildTimezoneMetadata(srcFile, varName, dstFile string) error {
 		return fmt.Errorf("error generating %s: %w", dstFile, err)
 	}
 
-	if err := os.WriteFile("gen/"+dstFile, generateBinFile(varName, data), os.FileMode(0664)); err != nil {
+	if err := os.WriteFile(dstFile, generateBinFile(varName, "", data), os.FileMode(0664)); err != nil {
 		return fmt.Errorf("error writing %s: %w", dstFile, err)
 	}
 
@@ -175,7 +342,7 @@ func buildTimezoneMetadata(srcFile, varName, dstFile string) error {
 
 func buildPrefixMetadata(srcDir, varName, dstFile string) error {
 	// get our top level language directories
-	dirs, err := filepath.Glob(fmt.Sprintf("_build/%s/*", srcDir))
+	dirs, err := filepath.Glob(filepath.Join(srcDir, "*"))
 	if err != nil {
 		return err
 	}
@@ -288,7 +455,47 @@ func buildPrefixMetadata(srcDir, varName, dstFile string) error {
 
 	output.WriteString("}")
 
-	if err := os.WriteFile("gen/"+dstFile, output.Bytes(), os.FileMode(0664)); err != nil {
+	if err := os.WriteFile(dstFile, output.Bytes(), os.FileMode(0664)); err != nil {
+		return fmt.Errorf("error writing %s: %w", dstFile, err)
+	}
+
+	return nil
+}
+
+// builds the mapping from carrier prefixes to networks, matching the English carrier names to the
+// networks we know of, with the hand curated overrides in overridesFile taking precedence
+func buildCarrierNetworkMetadata(srcDir, overridesFile, varName, dstFile string) error {
+	carriers, err := readMappingsForDir(srcDir)
+	if err != nil {
+		return fmt.Errorf("error reading mappings for %s: %w", srcDir, err)
+	}
+
+	body, err := os.ReadFile(overridesFile)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", overridesFile, err)
+	}
+	var overrides []phonenumbers.CarrierNetworkOverride
+	if err := json.Unmarshal(body, &overrides); err != nil {
+		return fmt.Errorf("error parsing %s: %w", overridesFile, err)
+	}
+
+	if err := phonenumbers.LoadNetworks(); err != nil {
+		return fmt.Errorf("error loading networks: %w", err)
+	}
+
+	prefixMap, err := phonenumbers.BuildCarrierNetworkMap(carriers, phonenumbers.Networks(), overrides)
+	if err != nil {
+		return fmt.Errorf("error building carrier network map: %w", err)
+	}
+	fmt.Printf(" > mapped %d of %d carrier prefixes to networks\n", len(prefixMap), len(carriers))
+
+	// generate our map data
+	data, err := renderMap(prefixMap)
+	if err != nil {
+		return fmt.Errorf("error generating %s: %w", dstFile, err)
+	}
+
+	if err := os.WriteFile(dstFile, generateBinFile(varName, "", data), os.FileMode(0664)); err != nil {
 		return fmt.Errorf("error writing %s: %w", dstFile, err)
 	}
 
@@ -369,8 +576,9 @@ func renderMap(prefixMap map[int][]string) ([]byte, error) {
 	return data.Bytes(), nil
 }
 
-// generates the file contents for a data file
-func generateBinFile(varName string, data []byte) []byte {
+// generates the file contents for a data file, only compiled in when the build constraint, if
+// any, is satisfied
+func generateBinFile(varName, constraint string, data []byte) []byte {
 	var compressed bytes.Buffer
 	w := gzip.NewWriter(&compressed)
 	w.Write(data)
@@ -381,6 +589,9 @@ func generateBinFile(varName string, data []byte) []byte {
 	output := &bytes.Buffer{}
 
 	// write our header
+	if constraint != "" {
+		output.WriteString("//go:build " + constraint + "\n\n")
+	}
 	output.WriteString("package gen\n\nvar ")
 	output.WriteString(varName)
 	output.WriteString(" = ")
@@ -0,0 +1,154 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// the file every libphonenumber source tree has, used to find the root of one
+const metadataSourceFile = "resources/PhoneNumberMetadata.xml"
+
+// returns the directory of the libphonenumber source to build from, cloning the upstream repo if
+// src is empty and extracting src if it is a tarball, and a function which removes anything we
+// extracted once we are done with it
+func prepareSource(src string) (string, func(), error) {
+	noCleanup := func() {}
+
+	if src == "" {
+		fmt.Print("Cloning upstream repo... ")
+
+		if err := cloneUpstreamRepo("https://github.com/google/libphonenumber.git"); err != nil {
+			return "", nil, err
+		}
+		return "_build", noCleanup, nil
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return "", nil, fmt.Errorf("error reading source: %w", err)
+	}
+
+	if info.IsDir() {
+		fmt.Printf("Using source in %s... ", src)
+
+		dir, err := findSourceRoot(src)
+		return dir, noCleanup, err
+	}
+
+	if !strings.HasSuffix(src, ".tar.gz") && !strings.HasSuffix(src, ".tgz") {
+		return "", nil, fmt.Errorf("source %s must be a directory or a .tar.gz", src)
+	}
+
+	fmt.Printf("Extracting %s... ", src)
+
+	tmpDir, err := os.MkdirTemp("", "buildmetadata")
+	if err != nil {
+		return "", nil, err
+	}
+	cleanup := func() { os.RemoveAll(tmpDir) }
+
+	if err := extractTarball(src, tmpDir); err != nil {
+		cleanup()
+		return "", nil, fmt.Errorf("error extracting %s: %w", src, err)
+	}
+	dir, err := findSourceRoot(tmpDir)
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+	return dir, cleanup, nil
+}
+
+func cloneUpstreamRepo(url string) error {
+	os.RemoveAll("_build")
+
+	cmd := exec.Command("git", "clone", "--depth=1", url, "_build")
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("error cloning upstream repo: %w", err)
+	}
+
+	return nil
+}
+
+// returns dir if it is a libphonenumber source tree or its only subdirectory if that is, as
+// release tarballs put everything in a directory named after the release
+func findSourceRoot(dir string) (string, error) {
+	if _, err := os.Stat(filepath.Join(dir, filepath.FromSlash(metadataSourceFile))); err == nil {
+		return dir, nil
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	if len(entries) == 1 && entries[0].IsDir() {
+		sub := filepath.Join(dir, entries[0].Name())
+		if _, err := os.Stat(filepath.Join(sub, filepath.FromSlash(metadataSourceFile))); err == nil {
+			return sub, nil
+		}
+	}
+	return "", fmt.Errorf("%s is not a libphonenumber source tree, it has no %s", dir, metadataSourceFile)
+}
+
+// extracts the directories and regular files of the gzipped tarball into dir
+func extractTarball(path, dir string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	zipReader, err := gzip.NewReader(file)
+	if err != nil {
+		return err
+	}
+	reader := tar.NewReader(zipReader)
+
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// never write outside of dir, whatever the names in the tarball
+		target := filepath.Join(dir, filepath.FromSlash(header.Name))
+		if target != dir && !strings.HasPrefix(target, dir+string(os.PathSeparator)) {
+			return fmt.Errorf("invalid path in tarball: %s", header.Name)
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(target, 0775); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err := os.MkdirAll(filepath.Dir(target), 0775); err != nil {
+				return err
+			}
+			if err := writeFile(target, reader); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func writeFile(path string, r io.Reader) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(file, r); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -1,99 +1,298 @@
 package main
 
 import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
 	"fmt"
 	"io"
 	"os"
-	"regexp"
+	"path/filepath"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/oarkflow/pkg/str"
+
+	"github.com/nyaruka/phonenumbers"
 )
 
+// record is a network as listed by https://github.com/pbakondy/mcc-mnc-list, which fetch.js
+// scrapes from Wikipedia. Bands and notes aren't used by the library but are kept in the
+// embedded data.
+type record struct {
+	Type        string `json:"type"`
+	CountryName string `json:"countryName"`
+	CountryCode string `json:"countryCode"`
+	Mcc         string `json:"mcc"`
+	Mnc         string `json:"mnc"`
+	Brand       string `json:"brand"`
+	Operator    string `json:"operator"`
+	Status      string `json:"status"`
+	Bands       string `json:"bands"`
+	Notes       string `json:"notes"`
+}
+
+// the CSV columns we need to build a record
+var requiredColumns = []string{"type", "countryName", "countryCode", "mcc", "mnc", "brand", "operator", "status"}
+
 func main() {
-	fmt.Println(os.Getwd())
-	jsonFile, err := os.Open("./mcc-mnc-list.json")
+	input := flag.String("input", "mcc-mnc-list.json", "the network list to embed")
+	format := flag.String("format", "", "the format of the network list, json or csv, by default its file extension")
+	output := flag.String("output", "../../networks.go", "the Go file to embed the network list in")
+	dryRun := flag.Bool("dry-run", false, "check the network list and print the diff without writing the output")
+	flag.Parse()
+
+	if err := updateNetworks(*input, *format, *output, *dryRun); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func updateNetworks(input, format, output string, dryRun bool) error {
+	if format == "" {
+		format = strings.TrimPrefix(strings.ToLower(filepath.Ext(input)), ".")
+	}
+
+	file, err := os.Open(input)
+	if err != nil {
+		return fmt.Errorf("error opening %s: %w", input, err)
+	}
+	defer file.Close()
+
+	var records []record
+	switch format {
+	case "json":
+		records, err = readJSON(file)
+	case "csv":
+		records, err = readCSV(file)
+	default:
+		return fmt.Errorf("unknown format %q, must be json or csv", format)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading %s: %w", input, err)
+	}
+
+	problems, warnings := checkSchema(records)
+	for _, warning := range warnings {
+		fmt.Println("warning:", warning)
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Println("error:", problem)
+		}
+		return fmt.Errorf("%s has %d invalid networks", input, len(problems))
+	}
+
+	if err := phonenumbers.LoadNetworks(); err != nil {
+		return fmt.Errorf("error loading embedded networks: %w", err)
+	}
+	printDiff(os.Stdout, phonenumbers.Networks(), records)
+
+	if dryRun {
+		return nil
+	}
+
+	data, err := json.Marshal(records)
 	if err != nil {
-		panic(err)
+		return fmt.Errorf("error marshaling networks: %w", err)
+	}
+	if err := os.WriteFile(output, str.GenerateBinaryContent("phonenumbers", "networkMap", data), os.FileMode(0664)); err != nil {
+		return fmt.Errorf("error writing %s: %w", output, err)
 	}
-	// read our opened jsonFile as a byte array.
-	byteValue, _ := io.ReadAll(jsonFile)
-	str.GenerateBinaryContent("phonenumbers", "networkMap", byteValue, "networks.go")
+	fmt.Printf("wrote %d networks to %s\n", len(records), output)
+	return nil
+}
 
+func readJSON(r io.Reader) ([]record, error) {
+	var records []record
+	if err := json.NewDecoder(r).Decode(&records); err != nil {
+		return nil, err
+	}
+	return records, nil
 }
 
-func verify() {
-	start := time.Now()
-	var phoneList []string
-	str := `+84 8 825 6551 / 827 2601,848 820 2199,+84 8 910 7227,+84 8 910 6888,+84 8 847 6300,+84 8 829 8249,+1 408 1888 x 220,+84 8 914 0065,+84 8 843 9999,+65 6235 0077,+84 8 824 3562,+84 8 866 9308,+84 8 810 8789,+84 650 740 100,+84 8 829 9319,+84 8 822 4884,+84 613 514 190,+84 8 822 1199,08 8233597," +84 8 824 3833,"," +84 8 821 8632,"," +84 8 829 8430,"," +84 8 821 0121,"," +84 8 899 9375,",+84 8 821 9888,"  +84 8 744 4551,",+84 8 742 1604/ 6," +84 8 812 2196,"," +84 8 823 4999,"," +84 8 822 7737,"," +84 8 931 4700,"," +84 8 822 3203,"," +84 8 824 6125,"," +84 8 823 7993,"," +84 8 824 4115,"," +84 8 823 3412,",+84 8 824 2118,+84 8 822 6494,+84 8 825 7714," +84 8 829 9000,",+84 650 756 312,+84 8 822 7381," +84 8 824 3192,",+84 8 845 5528,+84 8 825 1723,+84 8 822 0566,+84 8 823 4326,+84 8 413 5050," +84 8 411 3333,",+84 8 930 2929," +84 8 821 9402,","  +84 8 837 4124,"," +84 8 437 1199,",+84 8 821 7841," +84 8 914 3747,"," +84 8 823 4151,"," +84 8 821 6141,"," +84 8 845 8518,",+84 8 829 2288,"  +84 8 824 1474,",+84 8 822 1612," +84 8 827 8888,"," +84 8 910 4855,"," +84 8 910 4855,"," +84 8 736 6200,"," +84 8 823 3372,"," +84 8 820 8496,",," +84 8 821 4812,"," +84 8 823 6466,"," +84 8 821 9266,",+84 8 822 2098," +84 8 824 3252,"," +84 8 827 3161,",+84 8 930 0394,+84 8 823 5266," +84 8 827 8008,"," +84 8 827 8000,",+84 8 821 9180," +84 8 829 8172,",+84 8 829 5368 / 822 6111," +84 8 829 5368,","  +84 8 822 6111 ext 1,"," +84 8 910 0492,","  +84 8 821 9437 / 8,"," +84 8 910 5120,"," +84 8 823 1333,"," +84 8 911 1508,"," +84 8 863 3634/ 862 0039,"," +84 8 411 9999,","  +1 800 577 755,"," +84 8 823 0796,"," +84 8 822 5678,"," +84 8 910 1660,"," +84 8 844 0611,"," +84 8 776 1349,"," +84 8 810 1888,"," +84 650 742 203,"," +84 8 824 6337,"," +84 8 822 0002,"," +84 8 413 0901,"," +84 8 823 9888,"," +84 8 865 7249,"," +84 8 829 8526,"," +84 8 836 8735,"," +84 8 823 9205,"," +84 8 822 9666,"," +84 8 824 6400,",+84 8 935 1920," +84 8 920 2020,",+84 8 822 8899," +84 8 829 8335/ 910 6898,"," +84 8 848 8888,",+84 8 825 7100,+84 650 742 137,+84 8 825 8980,"  +84 8 825 0490,"," +84 8 822 9433,",+84 8 413 5686," +84 8 824 2882,",+84 8 932 6598,+84 8 837 3031," +84 8 989 0390,"," +84 8 844 6667,"," +84 8 822 2755,"," +84 8 829 3649,"," +84 650 743 898,"," +84 8 833 6688,",," +84 58 820 090,",+84 8 827 5755," +84 8 925 0339,"," +84 8 823 9532,",`
-	possiblePhones := strings.Split(str, `,`)
-	re := regexp.MustCompile(`"([^"]*)"`)
-	phones := re.FindAllString(str, -1)
-	for _, element := range phones {
-		element = strings.ReplaceAll(element, `"`, ``)
-		element = strings.Trim(element, `,`)
-		element = strings.TrimSpace(element)
-		for i, ele := range possiblePhones {
-			ele = strings.ReplaceAll(ele, `"`, ``)
-			ele = strings.TrimSpace(ele)
-			if strings.Contains(ele, element) {
-				possiblePhones = RemoveIndex(possiblePhones, i)
-				phoneList = append(phoneList, element)
-			}
+// reads a CSV with a header row naming its columns as the JSON keys of record, in any order
+func readCSV(r io.Reader) ([]record, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("error reading header: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.TrimSpace(name)] = i
+	}
+	for _, name := range requiredColumns {
+		if _, found := columns[name]; !found {
+			return nil, fmt.Errorf("missing column %s", name)
 		}
 	}
-	for _, element := range possiblePhones {
-		phoneList = append(phoneList, element)
+
+	value := func(row []string, name string) string {
+		if i, found := columns[name]; found && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+
+	var records []record
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, record{
+			Type:        value(row, "type"),
+			CountryName: value(row, "countryName"),
+			CountryCode: value(row, "countryCode"),
+			Mcc:         value(row, "mcc"),
+			Mnc:         value(row, "mnc"),
+			Brand:       value(row, "brand"),
+			Operator:    value(row, "operator"),
+			Status:      value(row, "status"),
+			Bands:       value(row, "bands"),
+			Notes:       value(row, "notes"),
+		})
 	}
-	phoneList = unique(phoneList)
-	var p []string
-	for i, element := range phoneList {
-		element = strings.ReplaceAll(element, `"`, ``)
-		element = strings.TrimSpace(element)
-		if element == "" {
-			phoneList = RemoveIndex(phoneList, i)
+	return records, nil
+}
+
+// checks each record has what the library needs, returning the problems which make a record
+// unusable and warnings for those that are suspicious but present in the upstream list
+func checkSchema(records []record) (problems []string, warnings []string) {
+	seen := make(map[string]int, len(records))
+	for i, r := range records {
+		describe := func(format string, args ...any) string {
+			return fmt.Sprintf("network %d (%s-%s %s): %s", i, r.Mcc, r.Mnc, r.CountryCode, fmt.Sprintf(format, args...))
+		}
+
+		if len(r.Mcc) != 3 || !isDigits(r.Mcc) {
+			problems = append(problems, describe("MCC must be 3 digits"))
+		}
+		if r.Mnc == "" {
+			problems = append(problems, describe("MNC is missing"))
+		} else if !isDigits(r.Mnc) {
+			warnings = append(warnings, describe("MNC is not numeric"))
+		}
+		if r.Type == "" {
+			problems = append(problems, describe("type is missing"))
+		}
+		if r.Status == "" {
+			problems = append(problems, describe("status is missing"))
+		}
+		if r.CountryCode == "" && r.Type != "International" && r.Type != "Test" {
+			problems = append(problems, describe("country code is missing for a %s network", r.Type))
+		}
+
+		key := networkKey(r.Mcc, r.Mnc, r.CountryCode)
+		if first, found := seen[key]; found {
+			warnings = append(warnings, describe("duplicates network %d", first))
 		} else {
-			if strings.Contains(element, `/`) {
-				s := strings.Split(element, `/`)
-				s[0] = strings.TrimSpace(s[0])
-				for t, str := range s {
-					str = strings.TrimSpace(str)
-					if t != 0 {
-						rt := len(s[t]) - 1
-						str = s[0][:len(s[0])-rt] + str
-					}
-					p = append(p, str)
+			seen[key] = i
+		}
+	}
+	return problems, warnings
+}
+
+func networkKey(mcc, mnc, countryCode string) string {
+	return fmt.Sprintf("%s-%s %s", mcc, mnc, countryCode)
+}
+
+// the fields of a network the library uses, in the order we print them
+type fields [][2]string
+
+func embeddedFields(n phonenumbers.Network) fields {
+	return fields{
+		{"type", n.Type}, {"countryName", n.CountryName}, {"brand", n.Brand}, {"operator", n.Operator}, {"status", n.Status},
+	}
+}
+
+func recordFields(r record) fields {
+	return fields{
+		{"type", r.Type}, {"countryName", r.CountryName}, {"brand", r.Brand}, {"operator", r.Operator}, {"status", r.Status},
+	}
+}
+
+func (f fields) String() string {
+	values := make([]string, len(f))
+	for i, field := range f {
+		values[i] = fmt.Sprintf("%s=%q", field[0], field[1])
+	}
+	return strings.Join(values, " ")
+}
+
+// prints the networks added, removed and changed by the new records compared to the embedded
+// networks, identifying networks by their MCC, MNC and country
+func printDiff(w io.Writer, embedded []phonenumbers.Network, records []record) {
+	// networks listed more than once are told apart by the order they are listed in
+	before := make(map[string]fields)
+	for _, n := range embedded {
+		before[uniqueKey(before, networkKey(n.Mcc, n.Mnc, n.CountryCode))] = embeddedFields(n)
+	}
+	after := make(map[string]fields)
+	for _, r := range records {
+		after[uniqueKey(after, networkKey(r.Mcc, r.Mnc, r.CountryCode))] = recordFields(r)
+	}
+
+	keys := make([]string, 0, len(before)+len(after))
+	for key := range before {
+		keys = append(keys, key)
+	}
+	for key := range after {
+		if _, found := before[key]; !found {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	var added, removed, changed int
+	for _, key := range keys {
+		old, hadOld := before[key]
+		updated, hasUpdated := after[key]
+		switch {
+		case !hadOld:
+			added++
+			fmt.Fprintf(w, "+ %s %s\n", key, updated)
+		case !hasUpdated:
+			removed++
+			fmt.Fprintf(w, "- %s %s\n", key, old)
+		default:
+			var changes []string
+			for i := range old {
+				if old[i][1] != updated[i][1] {
+					changes = append(changes, fmt.Sprintf("%s: %q -> %q", old[i][0], old[i][1], updated[i][1]))
 				}
-			} else {
-				p = append(p, element)
+			}
+			if len(changes) > 0 {
+				changed++
+				fmt.Fprintf(w, "~ %s %s\n", key, strings.Join(changes, ", "))
 			}
 		}
 	}
-	fmt.Println(fmt.Sprintf("%s", time.Since(start)))
-	/*
-		fmt.Println(number)
-
-		fmt.Println(phone.Verify("9856034616", "NP"))
-		fmt.Println(phone.VerifyList([]string{"9856034616", "9856034617"}, "NP"))
-		fmt.Println(phone.VerifyList([]string{"9856034616", "9856034617"}, "IN"))
-		fmt.Println(phone.StatsByCarrier([]string{"9856034616", "9856034617", "919851446878"}, "NP"))
-		fmt.Println(phone.StatsByCountry([]string{"9856034616", "9856034617", "919851446878"}, "NP"))
-		fmt.Println(phone.Clean([]string{"9856034616", "9856034617", "919851446878"}, "NP"))*/
+	fmt.Fprintf(w, "%d added, %d removed, %d changed\n", added, removed, changed)
 }
 
-func unique(intSlice []string) []string {
-	keys := make(map[string]bool)
-	var list []string
-	for _, entry := range intSlice {
-		if _, value := keys[entry]; !value {
-			keys[entry] = true
-			list = append(list, entry)
+// returns key, or key suffixed with its occurrence if it is already in m
+func uniqueKey(m map[string]fields, key string) string {
+	unique := key
+	for i := 2; ; i++ {
+		if _, found := m[unique]; !found {
+			return unique
 		}
+		unique = fmt.Sprintf("%s #%d", key, i)
 	}
-	return list
 }
 
-func RemoveIndex(s []string, index int) []string {
-	return append(s[:index], s[index+1:]...)
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
 }
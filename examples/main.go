@@ -12,15 +12,11 @@ import (
 )
 
 func main() {
-	err := phonenumbers.LoadNetworks()
-	if err != nil {
-		panic(err)
-	}
 	bulkPhoneFromCsv()
 }
 
 func singlePhone() {
-	fmt.Println(phonenumbers.Verify("9856034616", "NP"))
+	fmt.Println(phonenumbers.Verify("9856034616", phonenumbers.VerifyOptions{DefaultRegion: "NP"}))
 }
 
 func bulkPhoneFromCsv() {
@@ -29,7 +25,10 @@ func bulkPhoneFromCsv() {
 2 ,9805832689,"Anita", "Baniya"
 `
 	reader := strings.NewReader(data)
-	rs := services.ValidatePhoneReader(reader, "phone", ',', "NP")
+	rs, err := services.ValidatePhoneReader(reader, "phone", ',', "NP")
+	if err != nil {
+		log.Fatal(err)
+	}
 	fmt.Println(rs)
 }
 
@@ -1,3 +1,5 @@
+//go:build !phonenumbers_regions
+
 package gen
 
 var RegionData = "H4sIAAAAAAAA/zzMVdDsZgGH8f+T73Ba/uW0WIFixaXYKcU9yWbfZJM3my9vdvfbxd2KFHd3d3d3d3d3Ky4Hd79nhmG4eK6emd+xTDp69HTnpfOZ88r53Hlw3jjvnEfnS+ej8+R8cr5yvnF+4HznIndRuJi5qFzMXQQXtYvGxcJF5yK66F0sXey7GF0kF5OLjYuti53L3GXpcuZy7jK4rF02LluXncvosne5dDm6XLlcu9y4PHC5dbnzrPJs4VnrWfRs6dnOVemqchVc1a5GV8nV5Hnj+cLz1vPo+dLz0SF3KBxmDpXD3CE41A6NQ+cQHXqHwWHfYXSYHFYOG4et69Z173p0PbleuZm5qdx0bqKb3s3Szb6b0U1yM3lReRG9WHoxuK3cBre128ZtdNu7HdyObjdut2537nJ3hbvSXeOudTe6S+4mdyt3a3dbx9yxdJw5Vo5zx+BYO7aOnWN07B2XjoPjvuPomBwnx5Xj2nHjeOC4ddy5z92X7iv3c/fBfeO+c790P7gf3a/c77yMHnIPlYe5h+Ch9tB66DxED6OH5GHysPGw9X7usfK49Jg8rjxunHKnwql0mjlVTsGpdmqcFk6tU+cUnXqnpdPolJwmp7XTgdPWaecp91R6mnkKnmpPC0+tp85T9NR7WnoaPU2e1p42nnZe5V4Fr5JXW692Xudel15XXgevG697r1fezL1JPmi9rbydvMu9i95tzpbEKb/SOXRYJ+i8uoguocvq+rqBona6m+6rB+qhepaeq3fp6/q2fqrf6Q/6ow5nH9aDdISb6Dj+qT0OBK8WnF+wEJTKuLX2srvqt4IPCk5TxrnEoU6Dbq8zBdcTfFJkr9FnBe8QXEcZbxa8SHB1gQWnCq4iOCr4vTJeLPaO6HK6puA2grcJ3iD4lOB7Ool7CO6vjD8p42o6xE8EdxC8XXBI8HDB07XHnXUWXxHZ45QLbqg9fqSMxypjEnxR8BzB0wQrwZUEJwteLviu4BTB8wWPEHxesC94peA7gisIrio4Q/BVwUbQCq4ouLzgnIKbCu4oQPBxwScEHxHMBF8SXFdwT8EvBL8UXEjwEmX8S/BkkX1A/xD8W/BSwQsFjxRcSvBNwX10cvZpfUtQ6DAnChpB0hmMgvcIHi24reAuguMEzxNcS2SVThJcQCfwKMFjBDcWPEFwvODSgkzweMEXlHFM8CHBEwV/U8YtBZ8RnEcZT9WpXFvwOWU8TJEguIxgK/ia4BaCVwlOF7xJ8E7BrbS/t9aF9STBxQQ3E9xIsBS8T/BswZcFvxbZNXRxBX6gjFcILip4neCtgh8LPib4s2AueK/gL4IrC16vjL8K7i14meBngrcIvi+oBU/RmUjHI53G/ZRxc8EzlXEvwYN14v9exvkE+n97/FwZUs0LBA8R3F3wDcGdBA8QfFTwd8EblfFuwZ7gdoILCt4vuKTgGYLX/tc5wtmCHwrOLegFZynjN/pPAAAA///4AeDXlAYAAA=="
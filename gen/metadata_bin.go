@@ -1,3 +1,5 @@
+//go:build !phonenumbers_lite && !phonenumbers_regions
+
 package gen
 
 var NumberData = "H4sIAAAAAAAA/+z9C7Cl2VUfhtc593HuPbd7Hnt6untO98z03G6N7nfnHvV+PxpJLfW8zrReR4MeqL85f/8R47jKkAqpVOKUZl8HRBxcmBQyVJmkEzv2FTZGGAoUTEDBdioVikeMgRQk4VEEpCDHMU6BLR6FjZPaa629v+8795z76BnxUlPF6Pb53nuvvfZ6/NZvDb+/N3ycXdi5eaPmwvgwe+XVWGs7q1559TW9P1mbrLOL7Kyt5VhbN3vl1dfUvlyz0vswWRs9zNY1nCfXNBdSTda2V1n/ve/chf9K+O8N+O8t+O/t/jufnf5u706f80/14Jf/Ef/nJ3vX2MWdmzd4LcZhFmt6E3wJuW605EJM1j+LZw8/3R9eZZd2bt4Q0eJZbj/WQplxuuo1sz9Zn2xMNtmjbLN2Hn+S605Ipc1kffQUe8QG/sqrr9n9WCtj8wmKTphsbo/YGeF5zeUMX2FDeJ6+UE82dtNdRcgXBbpo4dc+N/29fvrau70rwwd2YPQq+h+2dk1cuSZHA3rvu70RnqLxFN2c0hN3e9eGj3avz7fZgHOuXFOjnp0fVPifPGifWxk+x2SaaD1OMxlDzcfWhxmNn+dpSORe2I842/CJDmRgMkjjORlOtiZn2DZj+YRajmF47b7ckGkcrPOTjelg9Dh7wNRc6rH1eBe5aWD8jHWTze0r7BHNaQaa58pNGmRjdy+xM4HnCTD7cjNwni+Xl9gZlw6afNBxunCyOTcDz0//50GagY/2+Md6/G7vyeFDrQFMTy3DvGp59Hd7Zsh2Xnm1Pcy6M8wP1VLZWax1/v7tFX5N3O29Ca6ja+A607luDa6426twJuX+8kf0DNxzfjovsTOW81qWz7acS/rsPMvf0x8+xIa1hCl+ze9PNqcD5thOmndpZjUf+1mslU5/6Vm0NR+bWVVLXDl2X27SNAY+HYweZesObpOGmKZvOjh2kb8wnbXH/ImO7LcEew2W+93e+PghWYNPgmE5OOX5R66K/3t1+CR7bOfmDWl9rE2S1rQ0OMdVMRlOB0yxq9L6nZs39M7NG2nElJ9Fr6to0j8k6Uo5lNZrC8M0HYxeYJYusjq6pKtqBbpNpmdElVaHncV0MK/Dzn003mdbsKvpLpxHpaLW0ZhobXQuet+etqHPspDWztm0dloHQzlI8/UveuxXeiZpAwWSMdZ2HGY1x2uigf2Afk3/TtKS/q6qqOkC5+fPVzr9LWHdh1nromhbuh0vqWKAa1Blp4vGZhZ1qGgUopEgpelskZ8Dv+NgyFrIdC2NysIhMVll+FtPsAfSqPqai1lrlD3N1u3+O1+c9u6scCE+2hMfe3Cn1sbhbaprUXy8tyatT5JE9+G1De37cIv3+UxvRVq/UNK+rt8b/lQjbHqZsHF2Rdo0xjLIiAIn5CyGtJG1JERreKSZDkYT5ukKZfCKEJ2tYKRUnSYg+lqM9awCOZQhOtm+k1Tmvqy9gbKWteBLLYl6aKeWh0VKJ5G6xLak1U7qZkac1C150kvl6ZdXh29lfOfmDZf20EaU1H70aYvYc/sRR9DMGkspb+fTNfYfsq/UhhvQYngdnK6Mn9UC5wM+MmotOA1Wc0a6LR2mp7hxoF/S0yrcptqWwdp0fToYPc0upFlKIo1nB3y3tP1YR9Iz2dx+lG2gdaD35SAbYIPdi2gbCHi/V16V6wEOTdblRXbGc9864rmHIzcusbOukdNk0TmS08lGmbF3Tz/Xb29c1zrGgtprbV2rnsdAO/8zx1gM67qWY5vtBLNo8+pacmdbAxx1sS/au6hpW5BSGVfskJPYF/bYjTGJ199dJTM7aQ90DZJG4NE5nC2cUPaV7P07N2/AFsfHMr1yOh7Tipew3GdR1SIZHjAQsFzT7hwtLtM0YW5WpZPkTGapEWRwpEeMOLuW9IeKuhZKpzsYXIQx1CLtqyQ+Gw6tfLt9LstOule2K1F0ai6yzb8R6AiKDqjJco2AI2TbPMe85fBFzqfvAYWgUVs06ifWFixiMK6LZbBhuYT3ut1/53umv9ORsbd0DHu5SBzWah9mfHuVX0nz++alciBxBLfXdvg1USXxfaB1T9sSXhG1K6ddXXbaGlgsJxKVn+gNz7LNWoZsdbK3sKfkK6+iXwnK1aBGRiVMk7wpJa320WPsTKjF2ITsLwSpaCqPMzffN/1CD72sNx/vJa2mlzzSJPyZtw/32JUkziLWHjVJBZ8Vk+kPDk3YT85QUmbTDfYDm+yvbyrvk3Whsgsa02aHdp8ws+hFFdPeKkUS4WT4hSS6clZVyd3GS9LxtH8bBzuWSXtNVZU7gcxX0VtTVdnzaq7C5WZAWUcj8TJpo9ZV1E7RHuhmMspapiWpo4a7K+mqWpu5u3kNN+AySlXFIDneUINFG30t3SwGWUWjRfTOwxu57j3w+VZWMirJo3b4WVpGr+izlAxossjobPQh2dQa7tUaE5gKVCDW8aJaYJ/XZhYFnB5VrcZ2hvetuZ0ly1qbWRV10GgVaDgVHCA7g0s1XePxTjBGws1qPQYXz+NZAc+iV7YaXjnfSipD54NLQEeSrCuPB9AmkfhAXSWD3wa8sy8fAY+l78Cnpx0An4vKrXw1yJBUoD/TpQ7UVvQQy4ihltbRMAibVIIC1auiqZVO6gm+BwbGmfxqNo1F0mymFsb6GdpMXCXjR8O+DncycB5P323sDF7Cy6rShyTROBB5kMEkaCArcEsRpYppkht5ktEJHDpponJpVrSbwY+wgnx6K5cGAgbk0MOSkKZvc+nlRHpHA2Nqa/xcehFRp7tKE9PB5A3HYNMgpHmOVpoYFC0b5WuZtlSYaJ5UucYRgN/AOYjGJ+8ZfDqXJBp2ovQ8+pJaSA2S5nGcdC2TlxcdzDJ9mU3ja2BsURyFgpe3Hk9MIpJWKymP2qRtU9US3yPNYjTwUmkrVemP9C8Xg06DpfNgyaEQ2a+fDFFtjf7RJvuuzXBITYEseFq+h7QVHs2ajkw8mNh70FzhjVVd4Y3QXeENVl7WmWQONDrsS1B9kf4Kf+wVWPhD1WDhvgo7hQrbCo0OQw22fZEN0cZ3ex7DEnTG7hPsActrrk2OYsuhLUcnw4Vm5MtTmw3zH1zlN5MOEKJZq/JmhE+TSR4dGP9iDPMEnn16fanHviXNIk016M0iz+kuooZplmnk0TUC+a51oBVkaGnCVWn6XBqmNDIB12LSvjbdQcJS0yAjN0HyYfKSb0pREJWWZphFCTFHgadzmVbITXgQBIHRSXPyZpoqNbuZdQX6O8mdjyRO1Zw8QXAqeUPpfjq9kIPZH8MDLPlHLo0ByIFyYXYzjcm4JWgCQiwahEgqB3cS5FBZfEeH7+5BoG5W6ef0K+rKkJQorPrFsihoXuQ4TZSHl0FxrLnAa0go0yfC0xVOhB7jZ4ixQh3iaoWD7embAyySqqqEqW5+vLcSko92czjInkf/mhhxHgWNmoInQZTJgAzg62pY9xoGOAhRYuayEzMft2LmC4PqeIJsn6AX3sHP7vZ+dYDeku64nk2kYHxNjT6AWzPPyoOPYZd2MP6wtXAJ0wjiUktY06hRDEb6aEGA+Hp4tWr00z2JUaQZlyjQEDWoUSJwt5dZVYOsgn5KF1SdR8GZkIkiGcyil0UN5auWsOJQuEiGqtZr0SmhiADNfJ5tmuyqyjENGg1Bo1GN/pv+wi9K+7+OGLrtfhf+bT2OEyjT478Q3ixtN2iFVcd8pVr+kfiNaQeEO9lsISz5vqjg8rSlOxwWlydd18mAgA3LF5sg2Q4mGh2dJR0++ud/GCOUtm14Jz5OYhNwl6/w/mm7O2rA6PXwjrD38irm26kk0bDpHz2mYA0klaMwwOXTw17X+BodPUwV2t7Z5FAWJ7BqDXgZbYjZ8N7d3jOdYKBetMR7opy9tyh8QmeP8ezV2pZI41uOOP1KPl2q5m3+q43hYzlSeljrbF2TV4QZX1Pja3p0PpToYUDbGHaf0UeK0fQGK6Vq9PO9fO8/gZqpIut6gW76rv7S7/rjrZ9gSZCKwhWMCwPOxSVF737E58dwGi31hT+ssTpGU510EN8QnZWdmHm19YYN/onU1d2eaLTDYYXV0Q79ILrZjq4OMh2V1fN0Lm/uf1hnde7fC4Q0uG8j3beR7ttIf5ptpINT2UgHp7ORDk5rIx30/toxNhLlse7bSPdtpPs20peQjZQ0z5HmUUsx9IM4OIVllFTgkZZR69a9MJ87v8g2vOAUZPWiCbK24AHfl4FvBfRmvZ4Dvml2zXoN0XzZ4M8KyCMUmGU6C2ED08HoFpPWa5JH7SEaS5HnHKFWEAV0HAL9zT2cUvdhb18E2NuXd4GU0nZRb9brAif5TG/F+sXwtuGvnxn+vR77tp4A5JWQ+1Gm28BfWmGCjuO4ScL/qL2AZ+k9sx8xm4Jf5OFHux8D/OH2q2jgr3Q3DwmE9Fc66uEvWH9wedQ51l3hFWJ/sjqPiZ+cna6wX++zn+sLSPS98mqehPTuYr9KN1TCRm1V+tMIGE5VSXxvwUs+u2QHMCtgANyFyZFmU/YYxYZge6iFNnknF1mFJeUHW6/GhEtSWyXJApersc3hfIm3VCbdXNEGrI1r8kBwuIY30bXQeEPcbG2tHMmfgGzbGIPZQuUtHZ7jOs8BGBdu1RbfqnwbpEy0IbBrGho5FA0ofWV0i705PcxANB2SE5SNjrUr4CK8cNMijtrY7mxtb7MtzBxZPK+kjppzdt/Bqp2bN3xtMS/jZ5RU4QIMKkHpb7pDOHwH6dmTSbEIDoF+PyunRy89IaCKxmy/H2Wm3sX2TLOshYu1y98n4TYubZqtz3A+3+yQgN7uv/MD02fayLOHMUmhKUnRF/pu7xlIGhDcUcgGC3ZWECAKFhs51E91chCts1eMcHTO04ihzMC2vRZgbd3U3IXs/T+Cr2PpdVZr4Wd3ex8YPtyBYAreXA9mg4i6sjEtqI5M1KgVQep4VDIZCWnHLqHLh1obqGqXgTxAtU5kqBSM54UFqM29hXUb82fnbTrpncNnH5xq0A9OMOgHJx30gy/O8B6cdngPTjW882cfPbzHIRe/6ezwQ+wFATvlOGeLd27eAOwiPuRmdTP6vAvA8SrS9oywQEGP76y6M+zHV9gPruBOlQ1mPpa03yssxWkhSLEOrKKkplAIp6adG8H/kTY+mAdE5BKG1mFuEXYpsqJhIwBQOOz4r7wKZqWBTCQqMkUZXO3olQqY1bfAuRzAuaJY8/hcUXOA8qbt0Xh6A+3gCyQlO0FShJ+lvSD9LWtR3FGCy2J+P7okPACa1WknA8hlpGobQgSg3lP72a+g7XUf3ow+2OOI5KFMN57LVncso47xldwHwqqofbkp854z2ZxujF5jX6PxMS7EoCvYBLyCWiC0DMx+HnZFVgMMBtwazSioHLI0WRL38Pxi6cuVa3DMm7p5/PbT7JzwHO2d9MGS3nEomm1rMBnuPsEeEAE+1mb7TDTb0lDeYnsCQAhYedfId9RgSOJ4VlEospKFKlevTzaSTNPGZNjTQpN7Jmqe7I+YfKwaLREnSu3mptAOd7jJ5u3+Oz84fduddNMkCODRCcQiCY2orlo49PJsdJAU977iYEzyj23tCA8A5yryb+qtpp/v9rbnNF0Lot8XtkDn2xGe5n9a2qIv1N3epWVFmn0R0qOWlLGtCM9Hq8Jzebf3+Jzm03M32Vuk6OScomvefGG0aQ7QLHSpEXBNJcKhOBZUIiSX2GeE+O7GtWefvbIDIPDrnTj3wgedSQtNcdDzVdrYH+0+5/DjVoTiByeZooPTjcuSKNyycTm453E5OPW4fKr3JBsKq+Dt7b4cCAtuZrM1fF+vt3Qz+gf94WUohK6lwc0oaW1OpTeTAXuSnQOLPP0MyoyW2cCgvhr9GfYBqPHiybLGDQIdxxmPUqIzk7ZvVIOw1aBP6WruKHxnCTWox03J2cBgZeP2IwtqcnYfYRuh/Egqp4OxusweBmBm0rVclHtiicXt/js/PP0uKpR+ctkqW8fagSOh+395dfhV7P3oAob9qEx2BtNX8c5oJm/QQZGUEOjvCZ33ZdybDJ3oD5dAX2UPCE9oztdUmudN4XPgg04dOXZFB21JEiIFnHz7xm09X56w/XiGtWm4c1vJb0w2d59gZ2vrZljcAmcUaFs6vhDb9hXTz/eT4o0Bdz4BwS1Dny3AXYRsR9qdKOTC04aIzsI39ZqS/c/0+qIpcfyWHvt4T3L6nmh5UtD0Yrj5hpAnwaDFAUOWbCjJa4kFH6+8ijNkab9OnqFIZkBFxenkRYU0PekVk0tkiqvnsVwIxiCLwk+tD3fYtrIGsafwpZLwgNHytLFg7GlzOmAfY19NeMeZlj4qa0zNc/lOQZpLMmlskhEJLrc1uurUKWAU0+YihTTSYzuTsDuqKu/uIte2TAejt7Lr6Xm6qa4TdsbBgECzEACyPHn6oaLaGJ2jbNvn2MB7+tVnEdy9yLYC55JzMmbwH0KqhcJxZ/rZTo38zqKSJDlXkoQoND28vPDUfEWJHV6BsCSnferfx8vmtP2Syx7AQZVptKO2I9b6N4KS7eix1m8Upk3zF7UtZU7+NM9cx6oy2j7u6SsP/gi+8uDev/I4P+l71odvZk9adHhg28iegA4RqnKgIHCyOV1nn+yxb+3lwCeHnQxdC1FrdB+TrYiWrwZ/0IcccwIHQgfAapKKSmpCg/Wu6D61BEgyklBEXeMNoKoww5PBqKZbl0pUlfkSNqbrI8HeZLnGEkEMn6oIQE8LdQiu1KRuWEIeA+C45oXyokRbNnZH7EzAkn46FsoxCVehWoSrcoHrRncd3nrn9BtWlvMmqMMQT318KekZSyBgNy7BD3X0ZeNrarSFwGybTPFy1eWF0p8v7khWz5alcw+veHBPr3hwT684L/dvZW9xHKKnM3K3HJZ9QvRcwdaJLgqvSiWx0lhJ3FovP72ea/21XVbr/22weWprpKi5stmhlZgFKcWmjtxidJU4gr5lcdgpSMsjWXJGQxxV5cKNdKKH1JSZYRGLAG/bqoYFQOc46XQw+roe+1p8hRy1gEgyhcJphUJyjkq9aw4JPSqvMcmwtAHDzphJg60a6mbS60ny+NwsSp797fQK0vB7z8jsscs42MHZ2ORmiiPaStBMB1+iKZoRS8OsBNWEwd+FAePWrbnETb5FpivQtp24kdqWNXOH3SaZDWlvciSpVHTDBWLbQVYcVY2g6KAQc2Fn0XSlUdF7tVbUf/3g8IPsJciDBKzBxeAUxuT2oDyX/oV/U7Au1ip/SqwppoYcUWRws78yZP92MydhBSxULVUVjZQUa2nFSrxSsrbjxmyFkIqCxLmUmjIqEhLilr4qOb35VC0hsGFkiD49BOoppItGmgjbuvTRV1U0VkYrjcPxguVGCk9IyJSkB/pQRSlr48PM2Khk1NI6g2usxqR5utJCfFZpN0uKQBCriHWz6AzcI0jldBV9U4mnZGUs1VCl7drYKKEeJD3HqSoqUWo3ND4/B8/KoCThTfaKTKonKqmjlrIMEOToZS0xJtaUcZkaMk/BpBkw0uK6kUm8jamiR/dxrykBdZl6gMpqxtJTkFFigFJAumqsKFUEGlU4KqvCGjYhoRIP0k9QlZErxjDIKagGhDAxyFngMW5nc60Z+tYCmZVkDek3rOpL34SgAS5KCBD0IzgIIYMgaKdB5x/Ue9L7wos0hlBpI2sEVPgsaVyCRyRprJWsebIWjVQ4pC4aXVXRWsqsp1F1OAH4jVrWeMM0SZUv4wHaC3R1ejzqQReSAGl4FfgimTWYnkkEbkUlPUzSvPMkI1Zq5jC2aKrB4L9V1NJEnwWEKxUxj2pcclCVpFSlRBmRphQmpmkxUKuJJWYqfXIMkpBChkZJaY8DBeVaKl2HdVwodhAnEX6GIyR19NKSzpaqkFlAtRqsQ1/BPSCLqUFNJ22dlna5E72exiKxNMlpP6fbwPtljAKXUcLXKgimi7zKJVxHzAEO+R1wuESV7Fs9Ex3/U2HpmMlkLFjfl25ZiWwn15SJNEkdhpnILC1OgJk7eicTuEOj2oxW68YLp/pBqO8tG5KDWHSJZAy3L7Etz2uu8kHfqr1bQORwk10PuDlqrOXikENWtUozplW0tYU143wOkA+D5Tm1O7zdv/Xc9DfXu7Qa7aCj3rON9fyAEog2q3X6fDJvf743R/bimit+oAf2gaUFq9BHQS0KYBOI/cG4grSlyWtV6yUPXnpInANESGLCxFM2udTRpRlVxs8iTSSg6iRIN8ynx+mEZIuFDD6k/5OECAgv0awIBQZHQUh3I9KtkdjEOY5S0rlPDM/mOGnaUMuJPXk8k9pvrw4vsIc17b4iSwYQMc7Y+zzvUlbk/BG6gkQOo2bJfKSEPhXcCeSHyf5cYXop4rYxGrEtXZsii5valVz89iV21heyIDDXszBONnZfYB7Yk7KBD0LHAVaRBrPWY5Q7bgBcFnjLoCzUMZMNeYFtOu9C9ge8C+gPzDl4z0+vtGVUHE/9kqwSz2OocuTkxiGk1jLnfquWKtCo0sX+CP9oUXDANOyG6jQhkJ5eKC632VsdCr3BGkisy0RTSeHjovboXEGZOWyxzYg7LziOeMss/K7+8CF2BjfNLHLsq9mfkbSf6gAgGvAUccFwYcsK1rVBQiJwqugUMA8KmpC8Kk5Hs5m6IbmGaR4hWwxU2xLWhuf63SaGkEXyODabWy9MP0tsNvo00zWgEThyjf7i5vAG2+WcqqbRYgbTeC/9w6OZnExp2bWRz0xXp2vsq9mHZItXTFNBseNNKoLMslpZdBgJpJRmvCF7wqroNJY5hD2QJc84mGykh43exsb4DA56IARKWhQN4tHBCBTIlRvkNJj0xttPs/PgI3neifubjgKYnNl9lA2IC7YTsznPNlyhj3Llgrm5enH6TztkTg91MSw9cbc3Hp6nBXv0kskqeLws//Hg3HDT+TvDc/PQzXnYRL71exbn2Loa52pN0G2aObdg2kq9zon0l+PRF/0lFiXuurHlTfjQ9Gi6Rp8EHDIECoRQ83kitCMpc0NhFD3NLB2ccpYOTj5LB2/wLB3cwyzNX3OSWTq4t1laAlNeOEvHRag/vQIbATpYeSP4eI/9R+RsZPqPZF2k/8UALwIXa2CEi4ZztPiRLUHOMgoZVY33yUSzYZb+PwN1HAYCCKrlfFU2CEF6Y/Rn2UdKNBwjFBC5MAT4gEeqEu9KRmXanTSCrlTG6WA6t2GLzY9RlP7bfoyd9TWXFEhsB6h3n2AsaUwHDmsxoovCk4+wgdf5IrKr53TdZPpTA9yXnllGVM3S4DsEDZG7eNyk/XxveIE9AtEKhNsqkVMJbMQeIDS4qArJtMQhvQqc5TLALktRsLLVBgPkqMdutS9Nv56yzkdFjhdYRlLZY3bav9ofPszOAkGHb6TxOrtKZC+i5gqiFch6AUjdQou3IblEX+wZ9iTljQkXg9SCHcrDZIce+bkX2RlvHMag0QI2zmDi/dbt6a+TtWFOMwQb+cvmByEJksiCRGmTPCY/2R8+yS6ZTL2OTLXJui3MpJvsOts2gXgWx1TVRGjjWfQuo4pMIEbF0UfZB23AJUurUGJsUSG1NfGVANYI6VUCBKjRBRG2xGiLwZafYQMvC+tMcmchjP0apeSJUPLwaBu2E8gZpyC4sxn9G7Uz+Fr0jOAQVmFu92+9e/pPVhtj4riF8925pCLpieXE1VoDcA/yUhSi1pQADyXgqrVowv9fxp7RWgSO3kykGwA+hmLgya0ImaczXazc6wjc3y+lWFZKces9R0fktRbtiLzWYqm0/EYP9kZJmNCkXwfsBruOmIZZE6orUHryejJ6kWZ7QK84ugowJSlhYSE8s/hpA3eMq/MoGxp8ANzT4D1v92+9d/q31hb1S9Adtlv4iCO173+6OnwK641y3wr0AChYBmnqAfuFHvvxXo5PluUBeGtDJK62lDZISFaE9NqZyUrpWVQhWplL2DBzkPQa7PBN6M5ELSFm6WbR2xgwegkyJV2G3mpw1rWnaCEwH9kswxqrpvL7acq9O9nYAbLklaeD0QW2ieoMPCOXo28b25chHiLQbgEdlP7pEKF5xF75vulPr94Z8qSaxSuvVjeTlhrwHfg72dpbJXDUzNWQWMO0ne0O+LVnnwUC28voJPkC9LeufXi8yF6ds5V9Of9IMfi3veF5MHzSVImc0TdJ9p+FyI8SYDDNosacCPzteM5RQchN1twQZotylQMnYPscvcxehJuAM4rBDKjGQZ4sYQnu7ErZCOJ4PG7iBrqs5HsqDKAea7O8f/q/rd5po7HWIK+zeAD+z+HwDpvuIINzyVmZw+BnUpfd4F2A9ii+ZNRwxWJIbzKcbLEpezsiyDCsPEaIt9RYgESVSFAwiYkTBZiOihLCGDZu81JujD7Cbt3LHZP/0pgRckuIYEvAebI13Zhubl/KqDq75+YrfnbfzM6r3JCkNmHWOlG1T5S3oIWKysFthahvAmdHYJp0PO8KqkQQNOcEEZgM5yb05am78zSuKyzNlpRpUoIIzJLdZ2IIAMaDurcYeAX8dMddkdaO4FCCVt38eK9/Td7t3RluNpHgtAJfwni/NBiNUzn3xbHqKTmZkMsykeD4HlFpoUH8KymiFfxu7+3LWMceVpxn7jg5i8pVI4b5q/Q3gheS4n9p2Q3Wkb5ttEMVcYS2p3pa/AexE0I0qrrbc+iStn3ow8rkLCIc0trlJUxBvXjMobfohbu9f+94woAb97ou3Gx7deeaqHaH/EqDzn6x80Sz6IkseY3FaIEpPHyng9c7Pwf3PKYHf+jDdvCGDdtxtvgPNFgfucwW/y977C/3pJYlNw5k4vToUmMKyhjrl4FA1KNnEsiOqaK2IhqquUcQbEymCaa1kzPJo6NEFSS4sUqdUozetzAWhUtzMPrhHvu7rXczNaLogIzVZBtalXcVWGkEOXWPdoqHcAoQpppCFqsgM6uhZj6zOSIRsE3ShGBf6QCd5Ft1jlQSJTMaewaR6dB5dxPI47jJrqd/E/o8ntL7mA7u+x/L/Y+5Um6Vm30VRJDsIoJkWSGPsS2ppZSmmTKJ8K7WsvnMyvAyO1cLNyvJiOKcTDam6+x5Rhl0IFJIH0j5FejAVWhLERLgS3aw5adMBtP10ZNQfCBgWeTWFXJDuGwuH2tzfWD60xT6WgjJvEKQTAetrOZ7gRxq7UHrO730aXM89A0Hp3zMwb095kjb+vdXhx56z3DPOaZ8ktbh2U62ufWMpRpEsryTBcR+oMf+Tva8ku7TmNoeS9B13Limnp1nZWNaEdCk7JKuoYZcihhmMbtXQB8qYz50zXW2DLCoDG2XUCAkaD+ZWmiMXtomzzeQmhrPjK6yR4H2W1ARfhOwc6II01UIZ8KogL2ZAQu8BI0mw91zlHUyUC+Dvw46sqfZ0y5Q5Bq7VuU60Jh9dnIdNlygCruN2/1bH57+FYppXupQyLZKgPuBz7cR0Z0CXU28xNiX8M3HQ3hX0+SXJoVHFUthW51Hu49ecJo/Uvj+9WD4jT32F7CoA/xn0GuBy9zMsKlAzQlLyFaAV4O7DaBMkFRmXMrq06WINshJ0QZiaKir0n67GGi6xv77HvvbvYzRyxwMSXVrrEaVuINjjxvkylGGsqPwm8NyV5lnG1HksPc1G22uq20u5oW/qZb5AknM1DwTMXMFKevcb0UYqQ0PQkw2qRcT9gYEwnMcCHjV9DRquKOrpjmTDDoIAZdvj9mT6E+pvTLgwI/Nix82zD10rNu9wh5KbrLgzSylnVYu5uS+wDakDvmpmGdPHtjt/q2PTH+3f2fFC/7Rnv/YWlpkN7+pt+r/YvI+tpep5xXP+faqv4K1/xcWQBnmElXtCz7dW0QC2EWjC0RoCguYq2yhujxrAkwhChkhef3og+1LMD81VrOokVoj06tj10CHBiKy48OMLrvt9pq/Ai7Mu06E2CDCwwuCiKSiq9HQBJ04K3cLJ6orobutAQN6ufZ6Z8AhJXjYZVgF+gAY8ePM7R/pDy+BJsbkPtoNVTYcttiH2Eul3h61g4219TNeIb5u1hyhn13rXEk/FvWPcbXJYHSJPWAzhTfpbpsPbo/YZoFVyC1O4QUh1WTrCMvizvS3VxZZFnN83n52dwFHk+5UNozL8B+dPBh+22B4hY1wc/bN5uz3o8pjOGS/2GM/lndorgGlZaGjRAAkjCK8qoW2DDpajJX5At6MAnJaSTP5tJWA68ajox4T3EZRp71W++hC0rk6a8f0NOFnKqoQtYtOkvuC/UFkNCo6DxnGwuFuZy6KEKWJTlUxQIvUOdvWFnDidDD60/xx2+rUKZjJcPfxI5ygopp/t8c+/yfTDaKd+QhPKFopl7hDk+Gtx9lZ2x0ey7nM1tzt/rPvbHlKPfGJXm9+BT7KNpTIFdJUdTFpVzd8x9rwXextHR4S3yk7mCcgEbpNP5JMnA75yLf32F/qdQk/ZFSCMn8IV/DQJ1RCk5x8lkAUu6RzaulnEoQ70JbDo9VNlxofAbBdyzDz0SaX3XGI+gcTCpeGD8KinrzPpfGGcWk8++zr4NJ4YKfOnfaqa5F/vLfirwki1Thy8/h8f3iZna9FkxUQ1OMbNo5N9jhjQuaO7OM8eAOagtFb2FPetyB8yEsaai7yjEHvv5Drm49xzZ99bvqvem0E3u4iqo+ub9H3mRD66jIPaQ1e/RQNNcUp+DoKT9KRA/1LveEl7Msvqe9+9M5ZYoLYYOfZULaKSqXAhMfoMXbWkflfkKcoeDSWj7LNdKMMBXHOHmJryIP7wvQ3CBwiTxM/WAPOzCO/7oeA6EJKWSIFHDK0BQdymT0oZbJBKf/UbRb5MnueS4JwjKlLUubCiByDyGOCPECMgUMFDkYZXNO4TG5y25W0DPWQLahHJxbYHaEXp/+SRuipYyhGen6JJz1vBnN59NB96+rwImNpDQnRlLn5/cnm5Ay7xVRu3IQUqALTyoKKUBD/BkUkaRhEXnAttqPRJXbG1aoFp3eF2mJz+0Km/+gyU2xiw/7cWdV2CfHkZXbWg1Pqy7DqcvRGuqfz+UB+2mSzjPJkWi3HzS9cZJs+7RmzWBgHvuw0dfGbVEju87J+2/Dq4csO3+SB1k2uXDOZX/VTvcvsrOt+v2u+vxU0HZpkHdKwm2bYP9sbsQ1vOYQv5BlveZM2PQPYxz70XwUXBpqTso8y4KWvhSHt4UrTubQ9C8qiyZr6LuV2cmZWrF9RY4HG2M5cCSEMi6iE0WNsi9cC21r7fTls3upYvf3S9Nd6GU342CGdUvTxHPo2Oe/LFZE+dMHRjtDfnwPF6P3JGrsGHY+pXRZgzzTEc8hOWIPPHz3ChnW5Sq65k6Tun33X9FNddIs8FKc4Ebrl+x8Yvpk9KWTJWCdlHoAQsqMOhpMt9m/67DeAVV4EL6FYMSolNNrQTblmjj0Jy2OwuQMj6E9FZjrCM5uArETT3BS7y4O9VkXLeRWttsnCgNaEuR03WO8qc9PlHy3lHNv8bLnN2dg3F1JwC9/BoFvhuvfO/DIEBAS6OnQVGhJXomVpwXDJR2kXLMpI9VfJbmqCw2NFRViQepwRBROUYBlfjBdZYlqbo/vD/0c5/NtPQdBRSBWT6Xt41yqxmcsseXSlk2C7V+Bki5bxeTbQOleY6fKQ2/1n3z31d2Ac0a2iyjZJTd4wY+2hiapLOySVEfpZVfFP9Hp3eztdJtbHBA0mlAlKUcXkXvHofDp1ATABIpxShNGqFMFi/nqe9W6htdHXupOyWmi5DCAUaGf5vk8fR6YWiJ7kI8e/AG9N9MIpbsUAICqdX6LqxhQXVxusJmd3SVpi3oC/27veCZgerpfosvxYfnDyyTg44WQcnHIyDk46GQdfxMk4OMVkHJxsMg5OPRnHxYy/tz98jD2CiZLktoITaveoPvUJ9qDMxPMl11HwlJujHUJ3UB1hq4k90K+5RuMk7yrfWm54vxQmno2C90z/BRlC10/jY/W9T0btlcMVS8fYpAPooOyPzK+lAfvfv2wY2Z8XtUD302du9dCOdWQHFDJAtvhyAlqbEj8t6OV0NeSIx+Zw/ctrdi/st/n1pmvTdfb5dfYT6xBwau8+r7wKAdQ5SYQ9Swqquz98sDCDELzf5PpFYHRADjdgEAERd0ioFo3IddDEPwf8Bt5UIrYuhUNYfRBMlnvCUucqfEXFQZQR0mSYi0h8pgZRtVFCEh0rj4xAjBOl5HJCqFZhJpAjIzmzgeLETsTgq4qGinyIKBpsYuYzVjhvmQWlKn/b/Xla3DSbpqE/afMhV1Um+choHWWiDlC97TJwMGBsqCEuIrsFuS2IZDFzCGGEzyFwWrUy+Ig5UkD6wOlkQcAPLv0MKkhyMLoqmTSICnCp8D1kBkGJiHgTcHw8lXuH3EkbyxsgmGZrqfAjsL8A5YwltpTJbYwhzC1LHBLMMEUtqjG5WxrxYoiOStlr5cgwog61ycPozJ5dOHuyPXu6NXvm8OwZmr09s19Vclg6c/h29nq6Pvoge0443apvQetNEeWTgShimBXLE/SfK5TTaHn5fbklVOOVbm1XEL0i4kZR+WyCzfNBTM7sPsa2hCVeNbMvN4T1ubL+3/TZ/9EHFUDR8xhsWWQ6lzEHk223WEr5CywUHVQS0I5MFlEQOCeGIG5ZGluSCBTWs7JEUSCzIIIEipOKH2nARgBFWwLhKuuXSVx6Ogid0PPS5k8pbWUg5VA389GRjbm96r3TD925QM2MCXjhAlCLY3Dm/M7hI0DWDPvbecQdm4I7XhPJ27nb+/peh6zDZIqKZEmdK/0TaLuoRZiNLs//iv8O0D1n9CaSF/p3cxqqavoZQjO767ns4Pt6ywAxyHU5UoIgqRYJpeKCd0B4dIOLHtV5Dc8fiW1IR/N6lMwQ85ZW+0pb4QsUrtzDhZjruJzu9n5ps8Nq0hnab+6X5bFsceSlsXRBLFkOJ14Hr2MVnEb2u5Ifc7OraELaznF+P9nP7vGfUoXxevUFrLwf6Xtg06AFZood8Kd11F63mu2opn/YHr7743fi8VuitD95ZpkGJKU9bfQvKtYcMjpWD8+p66x0Rz+8duJbWgreSayiybdsXACuc5gI4CTKzDhmtoGQLVPDIaYEie0o04vxtMLwZVwmfwhQcZHDd1JTLyhuyW7W5E5wVVqN8GKYQ9sMIp5T9BCXL4QAQMakJGkC/h6DFyKQEt8TcteITcScPCV4Q0OTp4n/xxOTLwEWMZxpW+BDXCyhKpzs9627U/kSc9bIF1B2SXAXSD51fqnagtsQ4n/xRfiPg+wuXtmEY3p9Et0xF8u84BEBrXdB3gVHk+T+1nBSf/lIg3kJKkMfosDRyXLld3t/s3d0pVo6/2KaS3o+yi5KS3XEkRsiN0U6fNy1RERUhyOt7X2P9+72frN3/Df9A6QtVACxyVGZjMWz1NKvCcJ0Qy41tdGEEEs7vkJg2bHUrgmfIGyvFTeh1SbGWAXYCoYAP6ELHWsc67ONAbikI2LJQ998uePLFILCK1SWqY+PLPc9P3TXsKgaYS7oTq2VD10rjr92HRHcjc2ykKF/UbvVK9f0aDWtvNIb+o+Bo3xw36W871Ledyn/yPe9+y7lF8WlPLq//yFb4eBPgK1w8CVoKxwcbSsc3JutcPA6bIV5TsWT2ApLuvmcxFY4Ej/22bXhM+xq0xItCC6akl2RNEZFMMLJ1nTA/iz7cssFN5k3BpkSsaVoOtD+GVuVYKbGIhIXsMi1bEIUVamhEO2qnG/tsa/vKaUUFzkXpfZj+iGXbkD6ibo5NHAhhal5kUMCnCP3P/Hqt75OUVWomUVB9SMKe2zNqIWR25dDJUVJTm2P2KZoyrpEK3G1tftmdl40LaF1ZieHE0PrxIV5//dNw53HgPYF9bGe6WhsFWvgtPhoj3/sIt+psW9q5wykW2rnKlqMSz0CnHT4lJacu4UFpm4Wg2id//Sh0nLqq4sFZhnrfnCKl5jnSz3uJQ7aaOruSxSIyHH4hZ9bGV7HBt7tCjcsr6W+fUDQYzKifjJklu1IwamLeJIu0r0Ips+6OXM0Frqt0W32ViAF4kBebjgIRIPuM8D6ZJHKwqHQ+bpN4ehVKR+/mAHWh3jzLyPC2hTK/ZaMdatnn2GPQ8MAjohDjW9iBC8tizTVok82bveffXn6+1CE+LGrO6I0sgCVLrC3BKc6qRCqqgjU4ZjrGShNpU9rHJ7rR+w+JFNQE5GvOFJ3/ezK8O2MU00EslYTkVz626qixkz0mZKuVEYNgcX632EfQoJ9iBP5TFyNlwGFUrJwcFuVZhad71AayIKpBzq3TCq2Z1s8a0Bg/Th7ML1HEokMKtowDRHb8olGsBAcd+V4wRjN8Uc9+8GpubMiRMjo+PHizgew2Ojd05CVvnhXGs5/u9dexa6c8uRCdrfVVnOwJ5fqAn98qcsn+6XUpbSDbDc9vcWcpIFHM2JMrFTICwo1DYA7EbiyREOwOZBEpIp8hcoirg2aTRf2hkEISKC6sLfpYejWU0AtjvXWSVqQyA3upBDWf7v/7Iem/0/vTqsi6ahuigNqCXHkKP0XK8OrsK7RALIAR8qsZw27Bovsa0PuZUQFklASZl95tYqGU09smYxvIvnCFnpo/oMqdi4iuTpaZqpWaORl9o00yKaU0UAvBK2QEk+yp4NRSFgBaA2sccdyFFu3qCQ3gllAefcYO2NL/U2aBoLiTgZzYv9h4sE7smMswKYXbWmHQJJpT/hU7zzbCMaU98MKjMnG9/V6n+mt1jYsodn7wTe4dvO7euzb52o3RVQcYgueajdd1DY6g/F7KaMyVa7i5FANVco3BXRo9NSWPZT6ZiGjxFto7BEVXBWtbpqmC2zFAo1JjO9Uc+r71ZxvcDXnV/xRVHP+zf5wBMqslugn18bPSi9PdoFtSWLwIfZtYn99ij0a0AGtdUOeAouG6um2z2VdSh0XUJleYFtpHnJJX+mhIi9C3V0BswF1ORy5cW5RJ4aiBj4y/fX+opoa26mpAWU1PxJIL254ixPKuQ5jdbIjP786rICEu9QJRUuuQtK9r/k9IfZLY2jDnm6qhyQ12Kozy3Sh7Z4vuXs7u+645QWZajlSq+FCRFC2xFBG6SFsM7fKUVV513CiktmI3Ii2WlCeN2JbHkoEcyPh4gBt3hixLcdJn0PhWnPdrRHbCkku85OD4A1Ry7N3pt9B3BYnKNh7AEnkQs2FASKt60e4vgt67tr50rDDD+uWhoVywaEzl1wwLz9X2blABp5rdybaDK4gxz/boxlQyKdqIRgh+H4lt0IDl0yC9pfOD3+2xz7dS9JKrEVC70cdaMvn0YZk04LffRN/Vy7pdgvNclo2jdhTdGHhVVBACEYcWNh4RRBcUsRaNf090YdVeyLfArg+MVaogGI0GQIYdIObhP3J6nyD9MnZyQOTB6f96Qr7+Cr7hRUlsUJNpHvmsnDB96PKFcA6ZCJqVbDi6Ztr62eheaNSwyjwa7Bfb2henr4PaZsyPVL7FBda52DosA1PThNZiM4xKDmmFqylIxB2sooQ2QCbqFaeUue1gyR6oftoCqsUUkpDRRNm2Slu6po7isx24fAmgC+GzkSFd3WMvKtQmZhZE6m1MoULOz3sNX6FybTelvRKcz/4hhw+tfSymvYC+pj2KOhMjab2hISWyiTrS0Vgujp6nklB0SOqnYBOD7TTxDb5MrlsewAoNi124MnW9g7bIpcJnn228Zp84O3H7mq2nSZVUeOOwDlFsnGNVA3JSavOS95kV4XHtvpJVMEkcCSuyScpjT18pmzrfu+Nx/Jeld7eNW832cob1nPPT0W7lvn68OG20kpC3jCxKaBi1bQKyNX6lz28pJTZCNlc8lV5dSiKgktoRSmQQVU3C2IcZtCXTsPyrmLgFp3b0pQbmNFcJmJNb4DLRYy+pvsQ2WYiW3xnrERWM7BmJDbKO+HT6Kv/h1X86szBBZTG+atfzZR1ZbEXHhrqL57bgmssvoODYI/RymstpJxPwDgQrmjI5v3QSusxioZAY89RIXO/UMKtuLL9e3JRgRmctI1EUdSQW8GYt9K2qBcapTGQb2OuBQlvISjjoY+Nb3EXap+fjSpLqPwCFHNV9AIqV5GQL63hneAJ1Nq06TYJApOelwxOQQMnVKMshNSYbhUQtweSAetC1mzwwgACglbPAe9WnWrMsTMK3NnhuGuXzC/q5AMTAc07dXLsNWS9gQxUYaN5hckrkqGnljmKK0JlSpBtFDNTxIy3YhmKTrrWWYGmI4srorAGqLbZQ9EYuF9j9mxBYyJIb+f1/ablmYs1IObsvmk5r60Gevlz3tKpULSd1zSjVeENH62l//KFTbMWM1m4MgztQKBrw899mjc67ellp20IkxZOaNoTLnnZTWFqDjI/epD2EWyuKyq6tNsuojVkfZGHwi2i/cPhbfGmtreifPPri+j/uvHogTB160sWxny6T+oLQ5Gxt7PLwmKLh9cEVvEj54kAwZIb5G6bxXZWsUcdGwmPCRzawBo2gEpuwSznMHP7Bp/tfWOP/Qfw4RBpUFEaE1UIqCqyCSiAf1VVCmykmTHQ9iMgyxOU3sDUUOmy9TPOoxCqSqbjK68ak7b2ENL2izWWbl+eEc4Vqp/0MskO/rHe8BGInAKZlCvO6DY7L6lzOOgqbZpQvFCWc65Gj7CBc8WX80pwLo6jZXju9vQPetlPOUUF5moy0470rr+lP3yADZtG5NgACl1Jh0WQQtoxUW5q20oIqMx0c9oLth9hA8/n2389sqDJ4cKxeNd0nIYCqtJPy/mzwMWeT8r8RG5aVEjSnXVzROnPMuVsJisB8rq8Z2KWLTekiYa3ko1y6KzTkvoQjV5iobkJbPc+zEx01GkaOgAnu1aQPQwORftWUhpxv6XRG04p/tyhlkauQynurGtTijvrlsrSj68TC+gSwv3P99nP9wnyOwv0WulLQyEHxWxD8m70OJOsou8oFfW1oMBl4bEghD90Mif2jAw1pvbdBUkDkGTfIJWpsZIYI5AFefTBi0V+/GzrNWj+zMbri6sn0KgVxJldI6MQ8DwhTIILshnT4ywZojrjwH3BVYyRnVg1hrIhBxKjCcBKS1H+UgNbGPg9DzSx08HoKfZwGrFmkGk5NKdsP8+w8S/ADXUjgplieNGiynxD99fVSdbV+zoElGUFnUkucqTpWbqU/t+VzAiL6UGT6VnyetqYbLK3MxmsCE0Db/w6qlAvWTmUu3CoTffo3extFBcHHR5Kq29IMMBCMq0Wl9Ap3XV4Fow5AQ/ZE+xBz2t8B2ptv+l54RSTlzDQLFsHSxyVNsMR2woAgMvxvJKe37zdf+7O9Oc7jIPudNskMCN3emqfjJOsF4ode4pO3Gt16eF9pKnyI6vDHXZZFB6pbHO0ejPmsuABe5I9CAcb7T3XfOxZpoPVOYtnMAuoAnaB9p2ED5q6JXIamhmu2PmCvIki2Zp5vjswnOFCfuVL7Cy8nQ+Uzt2QPhTEw/PPTn92tT2HR5Ivu9nd3t4RGcTxNUXnlYT5SSgjQ3GPLhyGoh3qDiwOem9a9g7j9jscnOLhByd9+HF23W+tD9/CnvbUM0wTM5rLnB9+QQsIBZkSVUsMcmg4reTxsdluTqpLiraNPt1nf6uPMXeg4yfaLJ7/n0cgYwbGj8xFhVBJIr6zgPyqSh0Z4slyBqvKNPytG+p8h5CbpVf0Swa3Efc+pbYz5DHTnGDOBxGO1AwDUuhVhW+pM5VDVXqz7RQycthpEFZGlFo0lgY1pkZMkSGezJyibWAmg8nG9jNA1I/3pS6pFbKmZwqypiX67jZ0r9C8bVBpaFJBafjJBi20ETvjeLPG213S8670/PPTL1B27tuXltfzWtlGAHKUeFa+PVpw9RqxGL17wRVy1gwQDE9z+aJxau52tzfBgItuCz+92yNAi+izaEHHkNHF1o8Fd6uh0OjaIvbZ+ejJyfpkHEvQ8/mV4XV2tTDx7qWVR9KIvLzKtNv1TdenA/Y17MNClZ0NxNpEgz42NfWX2K9CRSCpg4ZbHEKEuXDP14gNC2npUioJ8pklEL8x2YTWEpfZGcAPmMwPKVpq+wj800W2EcqRpSC3LGMvTs+1VflTDZypEztbTZ9NKvetHYhUB/V0GVsqQNLl0KceRfK7qJtBBxslD8WmCEl53ER/B4YRzNhnZkf2GDtjpK8balwjPZo4oyl7liI5HZY9SbXJqJ2Eddk4lRkAS/yBJb1saNxL92PXUOLSjOyeYwMfctI4792dWRLsWtLXIXf6kbMYFNAS+tDuGbtpgszYqecn05/rHeqE/JnegL55sQnzf/WGD7Eh6X9go56us8D2MrVi7mJYSz3j4HAkbyqp5nbrWq8cV1ZO10eI8hKEKnOn6W+bRfPl6Sf7XXLqo7s2rcHbH2+tfXqNJKJwfbLP9Njf6wUbOKUdO+nEbEUTT6PNIE/07gpJllPtLYa6KBaYtacMGmY8Zcl4IkVjroUltYNVsAWpR+kAgmRnMcpogdH72XMgJLwWFqw8ek38mBAFTy8XeObveuXVSE12IWXRcD9mMme/fZFtJVE57CIQw7OyLXEm815eBHRKIdXeDNk1uHGODRzPdMhZ/Ms8f/l0G3c51iVyXAnc3O2dwx8L603twizMR9oXysM6fkIJxZ3c5g+NHXiCBxzcywPmJfQcGyBhWlrMGUDSUmN/fUDxGmhgTDxwme97OmA/uMb+zpoQ1jpCm2C6Hxrr5AUMeTE5JmZr4NY0mMjGdB4EiFVU8M9oagQ8gC8OWT9LIR2MJUKVABqfQpb+YNBmnjABlpATmlLpCjGMPlO2YWMQ6HAIoUhqK099g+HqEHXuWkdU5Wh2Qg/Vsc+dfODNM8OQpOy/euXVqDUl36zNBVyGzqVspMJ0WjnRGMR7WqiGgswnrj3IBnqeu1XjXTimQol31Zgqs9RC7Q2W6uua4w25oC6U0Hcel7cxxHdqLY5QehqUWWF7NnhMwI/CrlSKJi+9sYJL4ZXRvLCWR+8pE0n5ByGilFQTrwotM16NF2PIAYcGwr7SwYsZ+DAIolTYoZmKywS2epOtCIai1psagxjwaRyH0aSvSrqnYPfS/yURnw5GE/Zljrewa5HeoWlnCVVsEgI5NoZQJa+65fSKEzY7eP4D03/d64IHjvX0BhBQPEnDgX++Mfwge7YEJVHvu9I/ODa1PG2ESdVtGW32/P58koq9h1nqZIV5XYREmpzjJ3WPFrbPEDe5l8amUMo1Nx05dkUHbemcSIaFpx7dr+k930GJlnfZfjx3gNZ73agRnLD7BDtbW4dbBsD5N207YbZoRl54afr5/h3FeQwEldKWyCTSGhTERmGgvQ/E8Yg3osIpJGa0Ee4QhnaIdWdKKVdpl54PbkiOMHk6/HB3d+kLaNb1UMedb9m3j1GlHUGrKh6TE+fG5X56rnygZa+eI2ACD9juCPb6bja6mNSdvHlOGE8a21zvheaEZxrxiDVYaRWKiTpM60CBiyNH5eB1jMDBvY3AwUlG4OCNGwFayoBMfwD4Vl029cry/pYe+3hPcvqIaLNpk4QbkxIhwEGzj50CwywPVszjWYwtbimPkfY2ERUtU3QaBY8yJKUQKJdmcoVeWowbguyoljXwo/3hm9iT2hSNWXq5KVPSmtDNTbGnLVdNtV/eRpGE0tdE/d0NFGF0KaOatcHMCKJnOMJU8kUuY7mWtHDDWr+lSvmF29PvXLuzCdGiaGRFS/rqsnDHkL4wanMyZuqjexj8J5RidqWBwTm2BVmM3JsANOiIsc0aTHf4zZykX8EL75p+AQC8R77AP+oPH8M0nMqlO7Ip3Zmxl1WuSXCzKLmPiodiwmOORhk3Ixm3XEbPdQwcy9i9RHJXWwJ0rymYaJia0Rt1ezd3e/R9jx2g90z/27UjuvchD5k6rrDnuzswAbM/WWfbUK0kORW3RV+L3N5GrtO3P80uo4hTwtGg02ab87Co6SJ7wHOCwOP3rXtImu2O2dVAuEhIW8KCwvxTify/8qpcRzeoMwKPQ5gQw/g5VJueacmFf+F900+B6/uxR3YEz/X/csahB3nZ1BqfSI5PWgCIvq9o+b4vs+fTaNmcYjUhtyhvqvZqoUo6LqPycy6v8R+b1iZvZdebMApVjOYkVwBkbBVdqxTZdrzPHDIxh0MmX8k+4FV20pVtO+Co44j7AGmtJFFEqcZbpySZz2np4k5nO1KO2RVqcCuQGstYJKSaRa/L6bkDD83oI2w9YJhuMwdy/O3+Cy9Pn2pbnA93Hdy+AOtlSdPVnij9rU/mWPb89iqHmtGb98Bsvo5J8LKL38ODD173g+dlFyWh8GknSbCHaky+d2V4jT0BskZtPnLFepNig9q+i2yYJlVQ0o0L7EMBJchvpwg/+sfUxrVp8JEcW8HBuJg1LOjtFB63GAg5Tu29+M7pb4Eb8jHJd4SgFjS5FU3+Nz0o/Y3vgsv9470+WpmPLR3mw8CiEnk9qvvP4Unl821rj+17LmIBWB6phP7Xc8Pr7EnskIBTG/YjtW7y2CYa2+FhyfF0fbrB/rstdncrxzJanVgyEEpBv5TCqzfOCJOAmFjHcwkCzjNUz6H6AMACZCAaREomacjJKpmlgzAMEtk8FNmcuhRUmIx+wIJ1R8AY1Ky4J+RMiyvB5VnDB8+pSw04eSbfSyGDSeYKKfUJDjU1Al2wwIqarGZqEYFoGQw4yqK8ucTAAT3JYqVCU6FBG0CsoSFZbNXBgB2Y7xFm2fLNdObUSYfi/pk2K68iiogU9gi8FdZ/CJX3FQzD5LAIpgBzzUUo3IYNhSGOBVVuEOuNptcqYJNY9rfOm5YqyYalntdNiyDjWsNPNKTwh6FwLDQot4V6H8ck3S7tM4XfEOuTScQz32OgMJxrgfghyuXotkV6MpsjzjHmPkuuFUleiBCIZka4WdMcCMeMcpW0S4pcCZTcf5vjdpmbRhsCRDnblOPlopuMYKcyaMh2AaiUkO8YTnNF+j12RaOwGdAceQShaoc0SR6A6YQis1D44BpMEdfY6D1KjrdXM6jjQpkC4ghg1cGODlSfAy2to9CcptTI9HkCMjQg4rLUeGOvOijvdjbfN1lDKDXeUj8nTiDjpI9xqchgqI+BIUNTw6DoaEUVDdZucxi2JNFO48AZQ1QW+PlUUWDBIGwk1BErmpbRgoEYDacRV5B1t34mo0tvB8Pu0D+JysFjYYB9LRVItYYZKjxP9P80Wt5U0cj05cp4hVFHOhRKsNeLNAwQ/FXUF7GKwVhRlZrhVkVm0duD6cbo13rsZ3rprbRxxQ5zyPIRgxDAe0QbqsvRBVDepMrbaDMkuDJNlgaHrCiUVk1Y9vqzns1Lc9yQZBGFFjrOsw7CjwrOo2hUMEVJCUXmdFMBvb3LLieLNeM2MrYq/QGhzlaGdvc285QP0hmMja3HKAaGrwtR8xwj8Npoq195VQ5Duw1FzuheoPSKT6/VYgW6dYENjKUDxjYHbvdfvDXda1umN5cWd3jOR6vp9Udr8DWjdfyo0YA+joyLdxxP1LDitRmtpo8ZrcEnjdbxyxZWmNjuS9A530wkjrlr1F6r7+x5MAx8NNhoKERnY9DVaFvg9q18tKFyqAB0VCEteR+DttWI47VO0VEZVaixuA+SaS6dWtVQSRO0LdUNtgN/6NA5nheEWskISrA18oX18cxnjyCBCsRGLG1RoyfaP+7ktnHJIjF2VlXdrLk+NJQlmviW49Pw67VQTbfdP8c+DPuBtjpaI/OKzQay9PklsOCeY9UR1BURYo2iuBAFDLVQDZeVs6Wh5/D7er1WkfAjRJQG9zJVFmbTCHPLE/jGNQLIa6fiEmDzx9if15CqlRQOk0lUcmILIBo2W5HYoN7kUlAdMGUGW6NCumUosYLpMD75h2nJx6CySz/UTkkbCFc/Ze/AZ+vsamCGg2xKCM0ZTzsUJdkU1XaJ1h01uhr34fVvLAz4xefm4fWhA6/XTrXh9dqp5Zjg9eGTSMoDzd/apDx2HwE+7KvYy2WEcfPW2ap0lBDVBSlc0raOrEW0c1QolH85NKEymBRQRN+/wj65QrA4sISMoQY0buZcNMZUFUq4Mw4KdslqI5BdlFIamUtFMDBoMhgdvBURofEb5XFNN1MTs78G+amCmhfp2wq8MM2QbwB82QDEegPOC+xGl19pmmvjZoYsIOTRKxY2DJ/xuKCxFMb7igygwGN2cRAUER3BI1pShELpZjwabiriyOZihg18uEvfbERVzHA5S3InzYwakCZ/Z8YjtDpT+J0cMsFJH/FopJRVw44iN01WatuPLkRnLwgpXAR2iQaL4XJ05Hb/xeennxssaO1+FJ6h73hJY50CW91Xki4Tp4karabZOzUgW+kTQHzSCvzPV4ZPskvJKg66xDE9b0GyN9kNNjZBZ22W7T1ERthCrTMjJD/irDTibkYVu2zp2rHEa1WJhqZTbaCCqkXxTL60s+Fb2FOBzJVg0SUBzwKQD+XmwRH94+3+iy9M/5cOHPutpxnRYY3FzbpEiezpJtGfIDue5uPrVoeGQaJOexFr1UTUKVjn58iDKAC0zp5iD6Zrct9IKGtJP0iiNpiuj66zJwns4LyIXoUKiDlFWr+5Esa5TMxySoP9JeZOYLAX+N8Xx15/sbHXH9ppDQUyFq2n8fjDNNLuxUIb/spq3hSlygSltCkawNlOB+wne+yHeop7mdeLJuJRvCw7cwIaxVg3I5/YUgSGEx4IV3H+STWec/4p10SqHNZqDpH1NXbNT55+ks1PgfjBOJBukJBtKl6c4OlgdIupUi2nGmsJQEBkFKF72oILQvNU1RRynJ9jgiKQ+BEB5sn0n3VwLm9a5tudgQGlGOQ8E5xZUA8/Ph4xswZzS1mE4+54cMo7Hqdj/mp/eJ4xNKgaqimof56wt0oiMSPJk8VokC2rRABUm7JHJQ+/LzckDf1owt5qpBHN1p1NIigvJJ9DmuQc8JJHsiQj6U7mhADYF1+a/grh/0dLB1IeNyb/qje8DPlQLMGgvrIuJ5nX2RhqpEVA67Kwh0CQJu+Wel+uKyk456NzbFhLKOBMErmOxub2w1Q3rSE/KhYzP55jQ1VncJRcVx6znS++e/rd9J3PLKoA6AYRNvA7jskKf3snK2z3JwP2F9jXZLpg2QowCYggQ2zd5l64hmZUZdaj5KSgnQW2qAA7TtZcmaQ8gL8jGZe+7GdqXw6MtZRqvoQPta5xl3wDoSAOoGPF4T3TX+4dmy8/dmT+cX/4OLvgMnMUEGjYdpkam7JbWeNKTe10NWUakIcNIq2eAhoCyEkgIi9aMO8NxWVOr11mZ3NXoww1btGvLfvuC2wjv6bcdE0/6dv9F987/VUaitPltNSpbVR7DEvBPwMz0xA/AMaK4pyZWbP3msAL8dYsps21UDRKAk6qnFJXlOrBTA/RjnE/ay3GTRM4ARk+yj6IiHSsTaIQfE56l3wQEhrJtFGGHOVOqqkUL+tZRAmvGgOWzNTTGbCG7RQDFuPjyXYVWIalnVlqx06n/2R1QWnoiVHVNsyVhp44e92FhC1Vpf+wP9xmT8j20knL2UAZJm97FUo1nKXI/JrtmOQ0wjQ2PNlyUynFIUY0eoI9DPhbGY1poBGlmfr2JfZA0rMtqseWg3iJPRDmDpb6n4Ua5f3T36RltHO8d4j78N3e48uitOALHLlYfmd9KNmu4dxwTpyjvlDbYa+E1u7k9wkB+3t99k/7EkMhaW/NvE2Yv1XEpxcpT2QK4RMQP/qQCyxUCaYIyBZR3w6Vm9E0vHYIDQ+5WYRuXUgLFSms1TjTPxENFSy43I68lvBoXeD2GjH4hrJotpxn4USbGbDan0IJoWSNEzUz7kD5O/DmtvP1lAoDujbCIxFuu7kKwmfwcgGvKIkN2WKNG+2xy5Z4DApc0QJTLdn5NjR2/vbFDBF2e6FTlrZ7CWtDWuTroalLe4ZdIn4GO4tCAoOfITwylrc1LQWWO09ZqF+ePoEy/ebjI+yrUkR3t/fly6LlYLtLTHC6hg+NGnkYStQSO5qlMgkNKaolJSrzEXaZ9HspY26XEGdL76iqxsvsTHs1QVDToG7uBMa/sz+8yh73vN2mIq0+CCuWrX+LPc4erKWlfhOlNp2kYWO0gzQHKqMrfMMhY7r07JfZlvBUieJzzbkQUko52dq9yAZAhRByr4d8ZKGW+sD0d1Y6ds+ifqVy7GcnQ4QejU35zsE8x451Yi6F8IUe+1zPOoHJ8eQ75L4ZCgtrIVFgkmzYvAHzaGwpmy3BczI14VTI2PrcCagADnK0XCWd5iGna1q1iNqFmQOYmnVI3DbrsI9nyfXR1jqnN2VSTdHWxOYpkeiOyEqSJZdzDdZlqubpYPSl+dn3UyxvaIrlg0enWKwT18Qner0my2KdWGJM9Ibn2UO1JiRr1AU2xi6wDa1VZjzXhHeebI6eZhcy6bAFg7Fhr94Mhmp2j3BJzrGBpljMQGfk++3+ix+e/jYZUpeWuWZ93WxJR5lZq+mLjlRRv98njx6UHirywLlHv3MyYP8x+3dzTRyGsQs2ItsuBvFrluhiXCiVfTSnST50LjqGC6DFoaGeKFik5lp9ItAZUWwHePd5unVhcxBEgAxA2pBX2cCiDbD9DLuSHu1DpgqwtfQzyCE1J0sskN49x5JN68nFTn/Og6sfYRtGGLrMIAnu7f6LH5n+zOqdFc7F63Ofv2U4fBu77htYCpb+YyWI61K/UCzAtWu6ttg399hfzFHBFngls5vDPMAxZGIlLxEnwjaIsGRupNN9U8FVF0IuATgn3y6jk+2GE7Ls1KPP9dnP9SkwonXTVAD9Q6Q5zagdbMKTCwUVykIgEHgBTmH1H9UzVtFx4gEmymTgSNUFgI2aOZRQbIB4mQnokvoiAjG/I17oqeMdAQwx/pYssjbgj2jBXBm7riIUiijMcrm1KYg7WBGO1yLtGD5TLoVOXSIxXivTFHM3DvScPbSUcMmyx4leud3kIi3A10SyAuVWaBEhZznKhtJ72TsIq44sveQdYWEBLe5CW9xsDxDbK8JQ0iKFeWTyrunPrd+5wAu8jsNwAWD2Zq7RudYpEpNtIM5K4Hy0GjhXyYtfYrM9kfsdoRfSwWz5Fh3IUcqy5+d56A/x1x/mof/P+ux3AZAmch0B9mqicl8o3QX4EAilLU2+pfKUIhSYfO+S3Pk84JBDAdILPEG7vEEjuwLVMhdULNaNSKpmgMwImTmS0h1tu0blCG6bNoBTvJsEnQCYeMdkOjW2zYYrs10UnGTXFG+PvxlnggtolRGakJ6wGNJr+RY/tjocsXOeKOlr5P5rXIof7bMf7MvCPI/l24VbIfc0kcizjp4ruMCAmjbEmQLF1C0sKwW1qFIc2y6qOkOIMZ5mMgV0oZ1XTRNIRUUd4METk5rQiOSF4K4vvdQRmiHA2rTUiJG0onK+AMip/UKBHyJMyNa66cwSqH5uFoPA6XSBKlHICCU4cKOnmy5pj7GtuhlauREa5TJiSaVIqrxr7NAjK+8m753+3kqn90d3iY6x9wc8cn4tLiY29gfH3+nIvfUP1obPMsyrwVRQSt3lrh+R2OUdVEy7rCstAe/yHsueZ0KUaAXl/UoIhmdgtUE8MO3gDdVeIeD5c+xDIeP+aWFI4xsyEEclJy1MCtk66CU4FzEAWkzq4LOt2WzAk83ty2n+6MsOF1bvspGlhvu5OIT6Q+2ZfbluYT3jt9M8X2RDhxybSOWrD+n3l6dfv9Ithb+wIFK6NxcAhAdTiPWZRXzQ81eUxGGrhZReqMczilR2XmQJo9pGcimTkui+y9EXFcY4fpKz1xFRvpDWe8lOtDBD+RR72MpsJDpKZmxY2RTGtzTpJ0oxqIQEEQSXSwZzlz0hCY6P/Vyjx9RLu6miNkpxPnoCs6C44ed7yA1F4JVtxtY96RG/PJI/RihKbaGhhiaSI9Nt/7YRiBrwdn/ygekfkErZPZ7K60Rt2z6xOnyKXSACqS5ZAmarAEl3h01KLDU3S1VoXjSRBAL60w4KkbqsdiUZ0x3KzckGaIFrNCPGAZiLWlMCZRdvsvTX0CHTfsbTZuJ5LZtpKUTSF9kwNM2ACpd0Wbg47OfZQIrS5qg843Z/8sHpNVi1fW4/1ud2ESXSfLBre7DD7RWgVfz/L8q1d6VYHz9+hweu9QhxkrWFXtb2Glw0P/vn2UCRaG4qXz6+nYU5O7zDXiDFTJrZF+gesLbkylWwuMEqyQWvSBDfcIp32OCna9N19rMr7O+vSMIqIjM78rZ6hAwIJOE1nFdRtvK9WLtnqCuQxF4aFGK3TXMdCy9beIkVdhCSZtbkFizlMjDNClVSuXkMfSqZP0jHl0xXoXBPk2j1+Vnu4Qv3UrjXOd/uVaE0pTpKyqJUfUEUwOeeGCFnfxuu5VxpQyYSGp/w6rkYLptBEjbN3JdEZZRrx0JtOmLQt2Dbcj7OvasluXuS+HhqYRqrEI0lA7QlUngsPm/mNc3p6Gn2sG8An9hCSm76dsFKkoDtl9h1DphPD5QJxM7gXAPeBeODc4En2C73XkuUdoGfL5QERivbAUd0OaKb1EbHPnvpuenb76xzyNzmbXr7GAa0vjDJH2oTcJg2T8YZlGuYCFHoVq92HDnTZvtoFV7szJ3l250iQTeUG6rFyQzV4VvdLDNSqj/aD7B7h6n+Cj7kqJTKiue6wFEfa0470i3M3crn7RrdrjJpNXH2xZY4RQ80DD51AQuHMxVzRbgHJ5n0g9NO+sGJJv3g5JN+cE+TfnCCST841aQf3MOkH5xq0uc3rAoQjcJwHj13oo0Agr7DxZYvMMgf3hi+lb2lGCwm2x57OYi9F5A6imqh/V4oGxVsUd/bY3+jl9O5Yo5+FBU2OrwafVuXPWBNrdt9O/lMXP0h/4B5lqpQy0hFHdRb+4/Jutgi1acjuCjX2ToakOsK+vcae9QjQ1pNOrjwKJgSCtt+rDSLt90epMPd6+xJYYjZAUqjx37G0/MaqvShMLJpSXqZnRG+NmHW3K48aXjjQm40Nt+S8TwbOJuJCm3L9Hrp+emvdcgo396hJW3Fvp4oc+Ci8bBrkbGEcp+V5JuWQfrW0jTxct7u8FwR5L3Fjnejyt9xEvvrHMocbtQZXdB63inqBBZyuc6d7JvX2zse7blau9L66CREv2IeNLh4iHR5B476QR7SD4eV7+IFf4FteOELC2fhxv9s7//H3uu9FIXMjuhIaizRN5gyxyiBKvUtFDXo5uZyCHnoG9b+YTJ+f3IwdKwSLai8SmrqJpTCFP8Incc5+5Y9x7gwKrMdy9Dl3JMUw1a6zSnfyhSke0wHo0/02Nf1jNGCN+lAQtGWdo06kBNBFp+vFeIMUA+J0mZRYd44dzOmJh4lgCmxbVoJjhW9JDdNA7Te3mMjSEobA4gE0aorWNDN2LOnhRSyVQjPeSyN+2dRhAKFEUGE4qkPZVJQrlFQjeLIptvXsq90UEZKtVEYOPeeGoErigp5URV/BeLQPmJdu0JAtnZAAOIJbC5jKGzjoLBcWzG9e/qPB3fOIoEWZXmyjrrcicapJhq3IqS523t6UWMu2dnzVoQUR9D301kDild0lcLCVU5XQO1P6di2fJXT6X0h57/Gtr/GhLyul9theKvxNT1aFzUGNcXwidZ5Yu/wG+N1V/C6vjCH+Wk2hQEaONyBcgeGtNk/zoYCKF3TcoNunOkY55ynlZQW8y8CcY2wsilQqhV1SQ4FAjddZ7tslM6CzZhH4rIuhFvDdMwBRme6PnoHk85KbWqeYYdUe28hP5ieFa3UOTlWqmOCJE9k+zG25bkXVhIaAv9BpTBfBvlGzWsdZtxGJ2UI+U0yz7E20XHoRwjwjAo/UAMMAt7VB04Rj0cXorluPbqwEOZ2/6X3zJfB+G4ZjJW6VaJ5zsF3pyVmIzAkVFKXyfsge1GVLykfAKCW9MGRWPO0sVKjN5v+SB9cVQDPTCJCH9aUu2Qr75dvDQ0g6znnnvNYWihRLFvktoOdCMT6dMB+a5P94qZ0wu00rcuCabKg0omCC/ZY/FJlJiJqLuPQ+JMt3YzhGxCrpCWxG2bhnZHYRdF6FV3IPY6xSAZv5SSRahgjSw9kwpIJwIDktEl+FbRIlZbROh2d9xVVaAZsfdJqCJFfO1fZaIIvS1OabCbZRWLemgudG3S2ogCR2tsZV1J4GlPJ6R/YhVeATvXR18hmGJqmrtISChQhR/AUnxM3GhtxmgIGTfsaICk1VuUajFNgpEfmjA+QS+AbAiYzwE+uxFt4+j4wiu2sxm+VkMbPqPqcJARMZHqWSI9xuUmpp9JYIdO7IM5UATIVi7rSa9XWzSjZJCzgDPA8Sa1OwXjnDnLzlJmqGlqrZlYURYRU6QtL+UpIuRMpo83sjK4mqh9P1Hl5ejIpsqqxrRZVMpu6bP5Nv1nkVgJEpcsZ1ZJx5SKKmhqoEmSW+i2I5lZYseBqkZuClc61OItCUwbR5JfK5EMa+zYrA5X+HmGa8Aq+gRvYWe4oJpEdGScAwbiaGJa0pd65+Hyk8dEF/0vtX5PTgJIrEVssc7UFUQVBVtRR0XdOiwpqP001KYKYIPJ0qFpaV8J31CstNyzDqL5sJBQFtHCjwJtRUFHm2eeqoZAiYAomT9PLZUYq2+7aC53dq1debRSUHDotOGYisLHGdGP0BwP2G4OdmzeskC7A93ifc6bQ/kiAYuJEDAQGVvo3vEI6WyfJhSt823k9evUQY5JrdI/wlGQ2Oe0PpRoiF8WPTQOVMmQvl+OB6ilKT3SBJFoyYN8mFdIiawmQKZdK+pRQdwizQL9gF5AS38X1i7JFTIq85sDA7j01ss8DUSrjiYIo/5yuoG/SWdMFQYTuVaPawZoVqFCOUyKgQ1TVaHmEQ8hxYfcplJC50hpXuqy5ovXHM9cGvJEB2hPKybsG4OnIvCZJd/lSD92+DdZaIu9SlkmaPdQjjZDz3PFPFPSPrMvqKdKOyVQAHLXlnG6KgKnCIRZamzx98T0t9LK8c6pZZmpx2NioEQ+JLoXNIbOAIiiR1b/UvupMElfEE14AhBP58jOIzDZdT8pmJTEfEai3HIonqhRZm0KSlmaAAC4KqlwdJ75579PGQnKBrm2rzmD7bew62klkHAFncqnJxvkGzmewysJ+VbX9ut0n2RnhLRE0h315VngrmvuflSO2KbzN5MTpT6XBZJtszYXd3zut23Ee6knhck8K48zd3jf08FdPv14Ct7Y4cVzRvptcv1FFB6ktOG1fyG1eRZssU+GiT6funvRUX/FecuAeOhSvbBpU89FqGqF04sLqnrkIqtBw6q/2jo+mbAsBa2/W2H1kNCbLEsylkV90DoYRMV4IS5BTspsaOySZHn3F0itbbepxZxB5F6KFAEECCxvEgjuDv5m+8Re2jh+Pb1g50hq1pUn9PVmebZtzfE9G5wJzkzbkU9ubeCso5eyYm8utzY6daUWJcJzUwgQsp4omRGcqMFOx8UfSJiFaF52s0sY7+tXWLBzlEpQWOYYQhR2j88iZOc4luLfpWeoT3OskzTkFTufuHtnyxyhaOopVnlbUYIomm6CYAskvBPus7DtBYGyuWPohWp5eD1wqK5K/d0If5LSGApiEox9dvT/Fy/2+3PeQ+E+r6DTWbHWnvz3xSjjRmv0CSPeymheCbFUUhRwE1neVX6rocLHjJelqXPUntwVlS+EfJ2QL1A4q9mzxtOXNE0lVQ3GclPtPrx+fNfgbPZFraLBMJnuWWI502DHLgBhzctdsgWPmYZwOOWSIVUdmGZ6+0I3+dv/kL4h4SVgVGno3qrL1GVPdw/s25uRCYxKRsI096U5gUL4Oc3KBMQljlARq9EvdcSKYdq5px5KK9qBJchXTKBmTRDQ6JVxINywGhCJBLZrvjfDRM3PE6Wz1k4ztG2+szy8pSlWaBalKm1P56bxbnXa4cnG28gzY9rT4R2db/+Jg1L7lCEjFAnxEIXQ4tN4X5/yF572DPx4G/MFJDfiDkxvwB18CBvzBfQP+vgH/J926u2/A/6mf4vsG/D0Y8Af3Dfj7Bvx9A/6NNOAPTmjAH7wRBvzBPdjj86iai2xD6NzBXmgeFDfS8g6jzV/rDYdsXWVyP8YGymU+PceD4HzEqJQEfvMn6kn30vumv9NbRLnYqlvuqSMrlj7fH1bscZBF18oEURc+KCghKO/6dMBuM9suVURGKWxjgzWm2ACVK1Qx9qh6xUtsK/dUQzxNm5rpuA9///R/6tBFP318ZRHCt66ftOTHHllNN3d3d3wXqe9ZH15nT2UQY6A+dZmAE2qW02tMVqlqfjjdYJ/rsR/vkUbAbn0qc+9p44vVYmoBHYwsMFI56gztIbnvZtX/x96bQEt25ndhp+ot9apeqyV9anW3qtXq7qdu6d2nftK3f9/VLBpJo5mallqqkVoaT5cKmLEGMIYkBHMAzX1mmGQMTEzsg4mBhgB5xjgeiGMcA8kcCMaOjbMc45XYTjweJt4wOTiBY7CNGefc//J999ar5bVGHju2zOGM+tWtutu3/JffQsqtjmEu6JL0UUOITAt4TB3Lxh8Jq3lQPFE8UVQlauNIgAfCXvjqa5WR7NODIZFWFcIOJDN+lFJVPS+STk1DqgvvcH28Md4a/tmO+BMdpq4SO91h3zA5WjQc/YAEW5CPMODCiNBaxyQSEavQWYM0gZGSdYqsUHFAgddG4P5sAq+WzQ7fqgH44vjDzfF3dtDPOTGY5ZWexs/vnXGYbaxnoy/43dZxUIYytokBq5il+6sz7w0YoXMH9w0xKn0iQumJSjuKjKAY6Sr0NSF7ColixSgvS8YV9fZXTgu9Xnqp6pHfWDG/d2NwH4o1x1dfqya26RPbF9/YEX++QxIbKgmMVKSDPDGBOO4I/CAuGBLtPetf+2TtLl0Fci/UpHfkT4XClhZljzHSplZ3EgOBvZUceoJm9RfirY56w6/viE920EQKudhk2qaQVEZa0PUKSjglMF2ncMKRLIhHcdt609onXU208JhIEAYkIdgk++xKtFwi68imnkLPo5V50siMJOrYo+70qLc3Ec+XyS6BhAHAWJKgC4pADJbNQx3Y87LBW0gPKEyLLHyDPz7jK3qXLSmUYHHJnkWy+ah3rfu+l8af6968T5KFQcNYtKiUlKzssUQfx85RA5gPzD+yS97LjDh8OCVe1Oc6j4pLdQhB8j8BxDVAYNuBchc/5khfqIfy37lr8Jq4gZR5JRFLidROEuOIieYHOjz1pptqFi7JS1YYHZDkfD2rn5jxXv/0mvira9L7ErEjgBL2B5XMgSALyICPbL13K/SwIwVGz0TjKbBFSd4nfU8nfUg9rerbxeqaJ3FBVKRkN5p0LkNsAYtKGZnTCSAzVSQXjXQOh0Evy0S2iDv7NAVNJnQSFoYURWJSXcu/qKcsF42KiHy7eNGGMGCIlort75p9Wg5IhQMiZ5K2LGdulD6AmdGwd8MaIby0OkSU2Xqs8fqGj4r7TaL6Ei+3fj2GtStN3p/6o8HOZXFPgpqYg8rQXGsIMmyO+nuV+D2wJAfUoKpimTzypJ0mz7I6tGfAL+nrFslARZHpyQSRq/AT4FuFa2CRTWnLdGa4K70n7sOsX7LyF1scwJXamK/08QfF3QocEeqtvUychpBZ1WQ54OZo9l/rvu/G+GEMhcPsXgxBv2Qg4/AM/TNrD0+Al1vv4i2j4r7CHE0XtzoXZ3bxhurmhpxoP73V+SMzNvSNQ65ApgOjlNZ1gHOZeqSEBHQauhWHkbpn/eIm1k3pNd1iA/yUCDXO/EDyfA4AZEaoWZyg8WQxy1NoWamV9lbnajtenp9j9eAJVO5W523HiUBO04CagDN7vVsD7ftWJ84nZc7YjB15SMomzFA7mGnrkfJ7OpYP+6zG6SzLMu0bh6uHxuFv2NA4/AKGxuHyoXF4e0Pj8AsaGodvfGgcHn9oHB5vaBwed2gs1ui+JE4alkMsgd6/bQwGjU4qJtx8fh0IN86sItxcEnfXR2XzcjSdccZa5tk8KUxItlpOVlEXZVWawIUiKfFZl2ESGsS1QQglEbh2CnEONxyA2TrYumPD6S1KGYwD4s1/LF5jck3j/0tXWQ3udUEairytAq0CQslKqSulpS+KCkNQ76FLo6KSVSAjK+eKlFKWsv6ovkmKLO8T20EqB4pKFlk6TqmlLJ1rz2SWjthtPUTm6TgDPJ2PfFHsatJg+aPi9zHDZ95jT4yfN/qIV5KCvnvziLqwizPqwv+yI36iE4P30svESvIg5qhzdAvFQdI0nJgUaUR4dk4Dc4G1Do0jn0FCNrPsGmClOdxMQolsjQwP1DYMValICtEXpnxkHeWyhMd+pBMFpmnDpWpwhM6jLAbvNPhejHvDT3XEX+zgPerS0S3otk5jWyUTWKoNJ7ikG4hpG4yoCXFJQ3aKZkMf6P+wmlxDgqROpUH1r35erYvV6i014F8HNeBr1xtqwJ3M2+vVsyIGv9CJ4W/2BlfEBWK3ITcnYA3L6MoZ1swa9cU/XxM/vhZpoiIlcZrZipoA+k2BXNmQoCYjcuQqYaICFdmStU3pQcL8kthM4+o+26Qrevaw0RRFZXho+6RFiAqQEk/IpjMGUoiAZywqZ5qFN0MXwtUJW9I5kIalIB9EUWyFXgZppPu0kLiprDS7FdYDOpnHQwtXYdtR1/M82bQnA3kmWUlaOmhJ0snaSk1xgdAoeWgSw8KCDmbD4Z2vG6sdxKogB3lwsK7XNaAiMhAhCwNveTJmGm0NCzGEPBUFGYmWkbUmQpm1Ju4lvyLfNtU6JTZL1ldMf9X1wY4PZpHorcdPE3c1zIhI8OB+YfydrZK6XFFS71MrN4YkVnB5Rn9l1m2DCo8XW2IUDSmXrNRwVIFvQcX9grgroAc4aWO7+vYsehm5UT/NyQfEXdBDlqy+BVJnkSp1TYXQr1ODkdAy6SL4q8oeVMQMTLV7cAhtcGR9ixk7Ojm6c3TX6G7xn3fF5zusw8XMNI0tbzMhBVAoddR7LDLuPI5kNLhnJYGqka6ih0iZSK+kQ0+cn3323k/MLdwv4WOCNehcJiFzvspMWLlZN/RbSaYXKxPAWiRZLVIHzbsRWLa/+hqG7m2DOJOlq4YXxV0QYcosjNfyHRzsfJl4VcoQWIybDGiwFAEavyGEWMWoWQ4M2g8SXRGLhi8qCgIHWN4jdqKUOdB9xcore2fEVlmyskqZJxztc6dFj0k4fZ/l854CH4vm9TvZLEVcG4/fX+8TMJUe3JX1buqycDxrwZngYyGfKOoQs4s2pgvkSNekDMP1+qEMN+DRDPvwP/XjudVxS8qbpEhwByIYXKiXIp5jP7oGk4zON0f24IZCyIqZYLnXeeSaYj/CIDerLFFe21dlKKpgPYpHQDxjQ+UUDo8o64Hsp8XwGzv4swEW8jDRFtNcsOSy3sPPkRqNTUYLwJmNfPoCTjzR0BrJpy/r80e8gPqcJMXhi8qG+lImOg3XKDVqCJH5eoGWudZVLhZ1GDb89l+f6yyrMrCE44LLZQjIG7lserd7q0Utup4HwpcuWmzpyHsBh0QWlMQVnxbDh/Ofqb4m+baIq1hHDMnj/VfvWH1NP9TFkpuDYICdmT3jrjT1FwC1hZpAdTQBxhzkoQTIiThRpGoNQtccdbJRIO7tWCiBryKEAts9JUhhIq4BCiqO+MPGJ/hMJHVtOgHfsk5Bb53/gc8ecDDhkTkq5vgpN2oUNabMhJo/ZeVU5Uk7EW6DogqVF9lcTCc0mCGZRHSEwgo+0YJRWacY/icb9FRRj15hNKMg1TjyiLF9Sug2zKdmHzd2rk2OibCNV3mNO0r9FhCPlpir0L0EvJzNhGXSAq9zsECpAdsMUFilsAG86IVZguYdeW3ww9bhqWAALHuBx3599AbQYoCbdBQUgnQIvVp8ho76j4aFwK1PAuwAwpIFv3MW1J9WxlNkjAkuRe8gp1zMjIw3MCTwwnDQIaJWKgzzYamzVTShYJu9+jbqHcMUTA0H1aKJhEuwWlfRxAK2aFgJcXCUycPc4/AFHm05Hf7b2xmFaQiuGHiIDwOJE0gU4I58dt75og7FRWOQelQR21hUuEDAVvGbYmiq2YHJDTSy0YB68NzBmsR1HQE3CGNoUm9OwfIQYroTLK7UQQUMYRjcC8Z2a0QvHsrM+jeIX2uO6ahLWwcAOGzmj2htqujLsmAFlKWjPFZRlyBxZaYFzrv5gz+mkU/736gVpul5299FqAsCFgfjbTK+CSwzQj/1aBv4NlNKZzGsFDQu0M46cnhcrljKB2aR1ceWIEiy2BYMkJTXnZmDtpu58kb3xc4vsS880DVTz2UHevofUBJtHnj4BuPpw7fi6d+y8fTh7cTTh1+UePrwrXj6rXj6rXj6rXj6rXj6rXj6t2E8ffjmxdPLJdtno97D2456P9U5I3qaZUN1o1TcFGl2gevQLsgs0py6Ej+9NniHgCGlAnEGsA+PwBBdRVlI1lYHtCSJtDPEULwm3g9jQBOunICV9XgJhK52JMxukVjnwMff6ywPVscFsvI+8Tr6mpz9HZ5n+Lh4ZDd71XnSKpsm5p4sMtEE2lxKpzbXJXFXlBJbGBTFA55R0wGjwd55cbLEQ5qm8bpdt29B9Z99Zvz5zs012SSLtN0HQssmwGbqx85i73gVZpKx5bim3P5aRhKp3/Nf2xicF/dE7DORuBsifVhP/6u74pc6BriajoG+vCgmtz0mqhctpVimvlrP7i2YVSCvi/YKCNOQ4eYBT0/rt64cKtR5AInyodh2LvGvng/ELrFnqDoeyVYxMLVBsoBb+Vqzz0r9FWr5lxVIhWr07ObmnskDBtT6/5B4jR4GIEeDaffLJdJJKs3IVmhbv/pa5SUvy4Fsp1CQkHZBDciKZher0TTdOcPW8b5tgraYMfLse8c/1eqvqla3p8EKuccg99f6eu0CbDzX0M1qJPv2hEUGY0wuZeeOfIvggEcdJ+eryLeoTP/b+uCCuEfREFUZstxgiv0XHfGxjjYESyI6IY2yifNZEdoeVNqQPGtD3R2lFxgETl/fB7cnB5Qm3KCpQw+ZdNLmZYe9Qm9pE8gNnlhn/1VHfG2HNQ41TgIknqKGsOdojYYGInV84leg9igZSxRNg3qMfULiqeB9mKX3YSO7kjUN9h4WZ1SE5qUiGFHJxIK2z8RD4rQqmwc2jivzcXOH42j8v2/e3JJyApv0fGe9hfQ4lSs6x3I9WDqcvn59oMVVADcGBvGH5lMEvXaYo5JMozdGW+KT9QijLYn8nEiEE0A+iPpXGsaG1hBIQI1FEqNbByQ4KCmrJd+wU2z2A1EBYHQRY1wEsW9Ab3v4mY74JwAK0xIwOxVKkRJqiX4euuX1CSQBunTiK+FZGsbzJdpXq8RQI2CdSV6OOBgZY6Vp8S8SZ4PAVYrgAxRQ6inTwwEghUEA9fOZSJTNVxNYZSEfbl9cIDNWMIqrT6hRZFtLZBDoLSOlAiH5rWvdZ983/paNvAwuHRj/qDO4W2xPjAVsA1C/xuvirNgOE5vkeXsETx2vD0+JPnoI1st1z+A2QVd+N1oI+sx0mjsrro//TBdZBMW88FLPuC7CpS29h29fH5xDSz6GULbhk08LE31Je5UuK+OLykgNO67nqDrIonJ+wowqQPn5UhuPKL9hFLv4IzbCqHUO+d0eHNMBE9L6ZvBOlyq8hQ98c/GBzz7fwAfeuZt1yYvLlfp4ZyP6sqH2vxZ9uTAK/Gx3cEWcIxgXbDAlUIzoPYKZJG607xEs2Vu6She4ElL1AjMelLYAQFGdn0Y3a5YCG+P94oRCNQJmsmfX15V02GfH4x/cvLkhZVWWvJUUq/0bkzHZKmPIjSwQcDxCtlodxXz9HYPXxe/KMK94VSliZWuIJj7KfvgVUsSatG2EFEv+nD62Vx1jw0KBCR+wuVvAsPHaeH3cE18mrlNJFFLpifXkvohuqUVGn5EzwYLjFMVxDffldEo81fBLxfshH3MzeGxQQ9FIrS748jlzI9l15Y9Y/mPMO1BSS/w/oKO9W+yTAXtMBrJgIByulshmlNL4mB5aHTRnZhc9m71z4oRvri19n41zaOCdE30n2aekhbwabT91Zq5Bx2hwrfvsi+MXbxr0rmfNfVidrJ86EIkKNPdDBbRLDxozBdjTnJG7WOqizAnMkYriiVsdj5QxlyhjFD+q4SD9pxpu5/9mt79nWkkohlgE97p/2euGr+/15OWnn96vf2e4CDTWAUvBhW5c3PDSsz/ojuGH42UVZ7/3rqV0IG6ckeMA3s3yU8/9gWzF0/geWfi4JUvNmpRmuC6lkXU+1DyNm/tY3Ow5qH3olhKQ6nWPFAFduzmbjz7imPjmjITDZSPh8A2MhMM3OBIOv/CRMHvq446Ewzfyaj/VOSf6ylEKC8Z8DZpt2jt+hxjXSyOXPVpxQVWWXJ4NUwmf1PM9ZjMtDLDIZT2jwZWz9gj++MfXBzvifhVTNowGeK++VlmVJBC2xCtipKlKDXGl5fo8LtjWso0i4NmR9kxm3CZxtbNzP6uzDL+pK/5Ct44lVWKsEPweXRDQasT5JP0B9R7tqCAN9wilFMcEbb6KLGzAVaT6F/HpKIUSF0WlJ9pP633R+KmpbD3ubeqAYuZChSOobEcK9ZIBPyLwS75sSwjmOJGcnFeKZLIAmQ/3UK/79U8yt2AiDWtDSKgspCflEtB+55ToKVbsUZHlExYHSR8Yf3cPk4vYYmWmKX/xsh6enyhfssyWcSzehTmcnlU7aIkjaeuWJiP14Po/NojSZayrFuQkn+iIP2ys46REoyAoDviiMjD2LZb6TGViZSmjNhKaBlRXxipKSONfQYtnYuOUZFkUcOSyf2ednBjrNMYx497wK8RreBlGU6WSDEVQ1s4H1JdCMIWn7D/4ovK2rCLm8SUdilYZ1K2GY0vZPKvR5g1QpnDS7O2J+/GJlsFXOTniAhdmSHjsb/MM6YOzGVLZypCMdfWCfJ/YNtZFW+b3Ey2UyD7dWTPWLRzdH9scPAJEK2O81mhHe0BVwQlWEcqD0WB0crwBydNPronvXUuHs2CaTMsqM5tcWXmD0JDGB7Cc0QJCf67/abLCAo1NZgzVn9pmyYoWNKq/lOR8q3M5y+J663h/0fuWFvF6jtVBq2aXTh24DMaeV/BKXVkUyQk+XbwlzTpjc48YrwgtmDwITSIJ27RPbFieki8z35trP5+ID45UXmfuyuJXyCoG12igeOqQ2Sftd0CtAvwdkr6rby+TlRsKTviGh1HshkQSA0WgAAVGhLfAXzAhDkB2VlLKsizjaLDzILoA1yuXjI1ssOmhvsQ3Hec5fB7T50nLYvDUWbEVnOITO5U+udZ99ua4d3MtKvnhTny9Ez/ZWY9fqWQqHKwbU4WFU+C/XxtI8RA+uo+SAU2kMMKgAWGOI6heL6y4TIJu0wpbN9A5tQqoyj5OGyUDpaPXo63x5jCIh4HmRr9NnSdUEYhQxkptvoGW2nA9elU14bknxz+62WyVlKtLCXfjxRvs+Nr95Mr7tpaBuZ6NytvheN9wkEHf9vO+vUgdcL1+6nMLD+fFyfTLzInK2S9XJL5/bXBenJnowMSixCXmmO/D4gMBqsxoD4Ue1vtc9cfahCXhJ936F7AMsRKM0KxcZ+YIBq9neFM8H0qS/eGtwTZIqPVyiZ6wjGqgoj2uZViBLCdKJxGBLbYVpxd/VgxK/BqMqUS7Qupi5jnyn2dGx1Pjf9RqpL24wnF+H8o33NLBez/6BPlxtItMy4bcJr6ppUWmf7k+y6APRxj0z4m3B+TLGwsLq5FsU7fPnl118BfQ8Be6AFVwReToIrhoDYggjHvDj4iX6dcitmZNtKmaXP+aTe0ABa9Kyypalo6FM2a746KKSjdOo6PF07xVOH4zw6Lnnp4Ni2IrLAouNgvHwcWFy/+3bgwuiwcm0GDN635TM8Shgt6HxA3ukKtK1dEDq+AzjRT219JzAGCn8K5DPdGhuR8n0hLr2ecQvseZZG/4YfFydpRL1FEJxvwFCeHBW4HEdKLq3Nkk+RGcroaUvayunOdz9D2XBXcK8UA9xCaaphcqlDiW1CRlO6213rPiYRQV0ehWrSiBQXluj2LS2LLqlfidGcE6fl3vG3/TGq9AW7tKSlVU8lbnA6tbRvdgrMNufvWiVAzPNP8IF1jVkVGxt3n56acvNpqsSxXs4qyi49zKeNeX+WeP4Yff8Xz40T3trhgkLDRVDPTcYpA6Kj3qfa7zsDjrSzBzrl8dhd/pBZYhQvdq1B/8QndwlxikMvzBqD/uiT8ifi+gMHBF8lKDtDEjXaDdDagx65oGkGDlgA5+BuEkdqIc2ReAmLLH8KbAfYD24uS9N+4Nd8W5QK1SjpbSBkh4JTx2ZRTz7Ph3NLepuTvKXFZ7IlYsezG9idr3i0BFp0VflcEQYqb+T7hq3pX+0trAYsddo1VkPa9hncrivii66bPo5taoL06JTU2CA9lpf/iieDJJC0FtrJCVcw7U2+lXkz2zlVC+Yc9ThISQT3y2ve/Tw70s7jUoQKErbO3yADJG494xH/T13Ivjn+00n/5jK4KEgYV6Qn09x48AqGQ6S4SZ+257RNJf3Zj6rs5gKE5T3Y9XNV66t4RovAN6CcN7RZ+eZIASVbJGvyMC+Zy81UE8Ar6zZNi+NP4ktcAvLNL/2gT/4+WRz79aGzwghrCEWwxBnYZFQHLuIXYF1bctKpiQ4QoKK9UhIOsGDIXY9HRzLNy0c0ZsR1nvWKzlQFnZ3kVxilR/tQGhEw5DGRKjT3FWht/DrOzxM2I7SKxzYtRKhz91RmzXkZJU6Rt4Dde6z90Y/6suDrP4+vpERrDpX97L7DlEfexs7sb9ywpchOS8ncPMtEABULoeL6J7kW+JaPgmfCxQLdixBmXRPNfu4gKi2feNy5p9vfh8fMjPJzRXlTr6+Jr+4CXxpHE51bFXIwKy4kFlHAmXEpKUVTtNisjg8zKLYFPjVLwmvgTEr1KSIUkbQ04Q8/JRRYqZucjHHUwL6zxwWPaTzn0+l97StKQPrbiCRRZfTieIp0cHj4AY6ah4E/A6Jijg6XowyZlBONraG4oToN+TxlNDogW+0xhOrNGCM9GJc5pAXbBGaoJ1flRdDQBqI8ht+zFd6z738vizMBpfl7uKav8AwAW5VuOmjvyNPGjypdbFq68Vtzofao0nk4fFu3RLTkuzhpcLHMboLHvFylp0KDzoHG98eWuZnBsgvYlns/PO1p5Ufc2nyl973+CBhQUDdVXPJv0CxYlIAB9UafNvlavn9cmIgDEaK/m74XYKF10t32BYd6vz0uDKwhO17vtk44wXL7vlt/67lz5GN3sDj/E75ml65MUmlDuMgEVR6Ww+9A+6gzMgIz7RvpEEedpJ065CW83w94pXAbWqswCUAYODyCUQzXwNqvFXJsm1IZaymWyaFhDbNuKmnXvm1Dr27hE91qNJdRFdH6n4SIpVZzbtV8b/mjbtR1eHLduwwgEJZ2W/6GNrEJzrVnD+2Y74/k7CV4MqMkFiAsbZ7ERC1WNEp4JcFCXqwGEArWfjyNLE5u8gMcnYdJBFPg/6TYAgHvNhFADVoRbAVS3UZka6ErkGIGaX+UqaS9m6PqdNAG/dSAPOiu2SbgrAKuWxw/4Pjn+8FXk+OE/tah8bdvvHIAUM/uTG4KQYTDAbgVcgvqoj/pjLCosov071dZKDk1nQFW3nuZIPLv1YPMHciiSjIvN1yolhwSk7kT6Qo0cDgu+YxDIci6eTTf5+arTua5ut75EvRcUUPSULgIj1pbShNmJWFKZPyVmCCe2dEr1YMniIX0jrHSioY7tS47XXpytNUYcLsdWQ67tSo9zmte71J8c/3HphoWXpeHSzaq1bG0CtpTTgK+ZB+utXfdkxVQu5giScTTsaFEx1MZTplWqw5IkTtmFa8A067YVFIyzh6x6cR2TBMegYWLdYrTYtBt+5Tp4MEwM34tkvBpLDh8TZ3Scej0GigiYGdyn2ZmnwraERD1kCXhEegYvQgHPLv6r7SaV154K4G+jasipl41dTjXjxhLz+9PiXu833e2Xe9tiOQroxHC8BtIlisXizPjpmJiaW01udxwcXExNj0Rdn99yOJxba8a/u8I1c3eEXcnVLl7O/tTa4H0YK+Vm9+loF2VXaliOUJoieXpJyP1YMAQxgAG4ScnuBEANuWIjzzus0lDxuSD5RMvSW17h/7pyaF7XvncWYHfPlY6SMONBOi4EhhXvIWlN6eP3d419sDb2dRblXUx7x6uo3u651ZRL/bGWMuQkGHfO3Grgxk27MzKZ037Y2eEg8gHKWE4NrPBXcIhOgkF0jfr94FY5DFUOTCAca63HkGGYpigcjGzXR4GNDUCWd/ohSnnhUetFGcs1pvDl8TFzyiaODWaQm+1XtuKu15YPXupRqtLXzoDgTJW+YISaxTRoBUUqp9x4SZ5kp6zEDDDH9WGmdqv/faKu10JwTdwQISsqUGkclY4jAeLj+zPjz3XYp6pgWVAuCg6E4EQKXS+FsQYd60CUU2eAnuoML4pyjUBLmQllFyXcc6hjiKeFcSdSNACmVnRq0BkGfF7S9AcocQNlC3jwl5szDD4uXgXcgkfnRzr9detmxRHlWT+Y1nvBXPnUEEqgrF4glTr0UELgcEPB0PbrmO7FLyC9TwtYG9AcVUSozuETdqc9RBhJ1vNa9/p7x960fj5ZST4pv6Q7uFH1qslNQfEM8o2XQE80tIcjhNcItAoEtoAKt0SsHmfQaNSmgkE3hleauGLikgVK8TT1h3TcphVgUip4SPU01wj7XCMO17vX3jj+RRuJduxNKYbDPJD/eWdcre95HdovFPe/Wov9/dwYXxf1Gl7lRjVXJyjpuSokL4hSUiEPldKy8dqmlZHG4PSIu0s5gKmcLVxldVhbIptl6RxvQMV/4cO4RW944Otjjwde610fjb99oiDsvsBXiuN0vL35+28bglDiRZFkhQgLA0Q90xHcQ/VBjIz+EQlbGWgsjtE6mrJUN1q5lIn6QskVshQCamqCwnFGDlxSS1QT9xEDPQu8j9RZsIpN+S+MDPNZhXbceog6tazSqE0WW30k7Lk4cgNh8TUd8vIPi9Fj0t8GSykooAt1Ku6fCJDVFxD689DCRyoXYgBd52fhj2cIhpS9MG3Q1Chbnb+5CbLpUIadt/bI4G49Kss/UeWfiyWfHn1hrrudPrij43q8rg0uAJZJjqBxaWdtirhfb3D1/Y1InxIkIfN/uDJd34QR1x2g61Iva318b3Cm206pQj1nxongPJPloB1wpTTxZmsTUmlEwPMppFuS2hFstG2OGVrXhu0XU2c8rlEWlQuICI6ldTVFGGP3L6o01BXCUKM6UTxZuCPzSnhv/JDllPo+MDUuMjbt9IGRwFSwIjAyv+pDasxNXTsktEHWJ6A71FFEPRf0ubiO63sTne/iGvrV0zfl3JwfXxNsVqziUmTuGOJCyWWX3XC5PathX40GuJY83xE/3xD/pEWwwoe6g1BPBFNYn9WmNIie6IoBKNEXls/qRlGjEToMDaGx+2jraKbSDYJkYtB6z4GjNztmSzhYkfsUXlYU/OhskQTlYtCXWN4Wy0BZaeghEq7QNuvFr9fdIhQsWFRt08rk2WMlAoSBYmX2YygwDyVcKVPPKBmpFWSQ7mfpUfD+qiqCZJovKaU3IB7wGsATO/wIlMFSWwUusf5Vwj3zNBZ2FrlTaIPFMjm8Krk7q+h9V6VNUhQGaZKZXfpr4g+Sj3rorVEwPiNbCpTyhRfEQgGfLynJabuj+82uEx8z2BHk0OVXMYS0kDn1R70JmWuRHqsiUlgedRQ0LyC6AHQMBpiMhPUeYWEpG8hYIX3Oo98ZlR00zBK3OsbBYTuvbAtY2OpE7jSYFBYta1MOGfm+iHOU5CJeHAmXki46EPo/YhtH5jdTvLD1HBjuwVDzqP9B9mQl1R/GaPf5cYFPoiLIudSBJZzag4eYyRKKOd7hpTzN9vDH8xx3xbSBQH7ANkaOLpNhAk4kSOhCpoz+hlyYC/izGYMgEqKxkR7mCXzOhfRyCfQyl9DGiLIfnAqM9QOUqrHjjru+ZsxjLnIzpBBeANWtnV5yFNC7v55qHJODQ2OFyMGebuCxOK2PIUGei9NT6yBwdYwxydK51r18f/842pkPM7L8zebryFWMEPrS6HVWiChamwgar6qxb5pILP4GnabCiY0YygD01i3I4IkSjNB8djpOI3oG7R0TpL/rmU0fOY1lxk752rmSpGUQUIyMZ0FzFcT0e9G0QfrnSqSAwKub3v9ots1IfvRA398fL1QnOX9kYXBanEaIElFce/lQdJbfjTfG1HfGV9WGaudY8+4l5DNuFDvWkMAi8Zocukj0jfZ9AvqNKR15M6OvoLIa2EljC2LckDUFe3I66XXhJw+fFu3afeDwaXAA0UqhSFc1NJAAJJPeMkDtbNgnBaKVBpdiFmVcUewHUL9jtlUCnTOsiJ4qEGnb8i9e6158ff3/v5pqUiqfe/rzpdESnRE/Z+PuBRWCWDXhRtzp7i81LBvmF0a/9gRaBq3Xwg/QadKgWvonh7jEOggQvqazc1RyeV1ulQ57RSwfoj3UHO+J+GZtZZKxwZW2wCpV4ELHDiD1GTB2r0tG+BdF81ARaGH65mHqQ7aL3ag6IqwIu3hTtYAUnm161si70WwMyFwiTlNPkQIqmOT6NrdOin+5B9+r/dFItY91df2H8I5stj3x7BOLQkUvHh16FdfrbQKpDiDdpBPhQlSjIRijv18Xv8wiXhCmKtTUPmXWd3ALFzUTMEY2BkJntqY2unIONsn6S3oIZdB2qxgnqWkbo2MWqjAx6HfggczPzN/DcbwHF30yg+PXxcv6cD/Ky+tpOJ2PFfZDzx+xfXcMSsV9SIn6nUK7EIrvBTo2CzUCjsnLMipeZhKL7rvTYAhlqcQXKw9Qfbr4NXwczOuRyr9dvoNz7NvEomBGXATliNgR8EWDK50kJz/jMdO6XgVaTa93r7x//8Pob7/xOnC9zPeY2vnkMjbWPdTuD/zfjQKHoNYMDRfSkRo1GA7mfQ1I3d0to/xw+IAT8RH4FyOWiKbWgXLZ4RX1x/BmqpdxuEWPfrlhJ/9wRvoz3doYv85A47b1l538zrcpkQVr/HRvN495Qix3vLS0RtqwQz1FUlmSjm9+hcvtbq9Wbulq91FitTu4mbS5erLxtslq8twunwv+wNrgiLhkHwi+pfxDKo7PiSeGg72LY1V66RI8jJ0Ms9BpHKpApolE48ofXAc4ORRklq0lgs1KsP9M5PSgGUooPigqxrMqQdQJKX8I6g21HmTDs2ObC+KWebNDZ2HuveJvjmiiallmTMmxAVICeBJQeMMmQiHOiH3JSmvqXWvP1XtFPj6xeDhwjXm6Mf4rQYpcWRT1bdfiG9LZ7RT8oFbjIrhRccrOB7BKW1RGWtfHuvmF9sAt9GxBWjozCCQcVSRdngsGAW8g0wD3GhaqhQgrsI0/hIoYvDsvqlBmpovI4KlBiwEyUbsDvnLVQ1q/PNzwQ/6EjhUoMgcjVEaBiRA9u1KXhMEplDQFUGVxMBpqsjx6y+6XBKiYPM6cd60dcFvdGiX26CvY8KiAmibpRbzTYOyV6hhbnnmGp3dZ7Pi/uwqImb94W5O/oJ651r788/pnOzVMwuFBRdMoSF5/srEkNkrhzml31OLgDhUMqrMXe6pxfNGBAjiXF2O4IwqETly78n1gbPCKuMMSHq4B5qBjS8cChMhDPwyQ1rceMjoeedo96YWTTT2pjBfxbLof5QA9peAUgKdZPQHU1tNBiLDE46u2c5a2yzQMfDfbOiq0yfVI2Ppm7hb4y/o7ezT6+EVUWn+zAbLy4qOXYo/u/1Xl4dS0EsCSzD/uCEJZlGklHs74zywON38NnuoPLAPdAMXAQyCRnzXBQKX4BfXEZtmlmjOKalHJ3rjMOHxMXlVIpxITfwg4CFSQAW5lo8/1V6MrrHxj/6RaY4pEVvJ5NNTnK6l2KjOaqUHk7IV2Palur8/Bvvmvw9R3xn3VU4ktZWwXgX0bQBsKN27ss6EmULNXkZLOGp5tmSQW202XqA1A1cV2KZOBbIh8kxQXkIT/aHvfGW+J/XRN/d827QGUs3xRRVswLhmI98nVITF3lcyCuZoKYHkObI6BLtHXJLwRxWehTqhJORMH3uRIUphn9FaHiTDVIJnFgwxFiIUQSgOUBQsW4hk4d8H1LPwbiR7Qo8MlQCamKsJ9TGZvRw5aMVitS13ATJrmnxxm40M+vBZXl0Mc4cZp8/R8+eRXgU1LaBiYxgN4zTaV9iw0L0pqrr4McKPByNV4AIZhYlUI31pz6bQ7/z03xvZuzb1Nx4yo/3S/C67Szr/OL/ioBSJa++YW/RPXGXuLEkzdu6z0WTQWWt+bXb8r5ta10glLV02vngriTUkXmzR47Ijgrtkz6xDQ+efxsHUzL5CqcP0kb4JeM7c0NwCZ8uCvV63dLViy10JusFMUSd7eFKbvOJJrhXFU95oYAvBaMQYBmuLc63kCoYn1wmZsv887Bm+VdimG8eJ4CT7SgcdP+bkfJzuHt38jh7dzI4RdwI4e3cyOrKkCfGAweFucUiZ1WBEuYcLEtNAX3P98VP9flxjvb56OMhyd3Gs0+9tizxkAh4JDHyRGTjH0D3UGpDYN/aZnIy4NmU/16isVUwMjJFLm7IOqB8aHK0FrENAAwCwkpjIkOkl7XNCoPSeNvn+8J7sOlaqRrWJfbRu/fccMUGudsBoXL6YTtYZhOWOepytAqWT9wYDtgZscYTaNNbDsLfG1XfB6iOhVjnMAjs7ZeHdDWLtZLXJGkBAiNwdpJPikN4ENAaCxnkwq3sFY7xTUBa7kvTI8ykF8PML3gFkIKFIlUwnB6Va9y3JJBH7yJNp6uxyNmw/pqQpQevrhIXkStx6Ka2pk758UdalIvxpKQWgNlGvYF94m+8vkjv8Cx4PeLVxWn6Z5vkvpHGnpKSC9SFXYwbT1sIklNYg1IT8lTxGh6xDFjjQfKWQICjwbXutc/OH778gwj64kiuB4sOihr+KNLe+r0lfsVYSE8zBqWZqqcKao4lDOfpg+rrEEMd0c4mbiqn05n7ZjU959doRrt020WSt2/bId3wKUAwTPKYvluQGfpKjdXQGH+Ja3W6a7Xwb/QHZwSJ5twNaIcGXFFp0iChr6ektgVkr9SdS/xj86JE7FeCxKgOuqcf57hBN83bV1G/SUp6c3xxxP38hiAir6u6PTH1HVZmk7+3AZ4JGAZjT1YxC90xee63lrtcz+W0flkRWiIJghIJe1sVU8NbQilqkNlFcu6Og3+LGAF5bPrGJg5FYwm8xkrKEkvnMwYQYKsQv0fUF8NlS9hHbEkPagCmvThOUrwOzD4dw0SZhGN2jxYeSEcDQw5LB8Er52WK7i5KZ+Dta7gKDCfzB94+sCBiqlufBL4K+D5ZkBbzk/SyXNnWmFQONwBXpyXyGF1qbYRVR5bpwmyGmaG1lnRj4FLqPCfeo6d1QNCRIwA6uXPJ5By5HbW80+O/1SrNjLXtNTMEN1igg4dY+wSv2vBQjD702GZkspc/M5y26Hu4DwUnaSTDUgCV9NHm+IRcQnjEZM5KIBDDlMK0u2B3sTC6HAXylzMPsnc1ZA6mZuIQNkRYks6ghxsSojK9+4WPePpMON9UGre2vD80+P/dgPXhnsxHjcUj2+iRccs26utifDoZf1ovWKgGhfAhm/r8OUCLd3BXeLERGa5wdGW+DIxZWy2lg0NJYMRFG6nCqU3A+WjZJlb/7l5DFVcQc04xsx/J/GK4RV4lxoUxWzAMCzpRW6VBFHauUf0JDm3bLF+5l79x5L/WLZ6be3n/8z4/6JW5ZVjTAYZb3XedTstzXsAkYjmCFO6keUg7Q4QhxQivw5Gm+MN8ajYYeFZXy+OOlamJJfY3CvaVNJ7WY43hueBCgutDZdoL5sGns14Y1UF9fn3jP8aDMnX79wFQkYk1PLlj3fWzFE6cVP2B/uttzrDwXYDMNcAIpnld/8zvcFL4gmd1RM+GpMur5JXlTnAKHPGWQyQbZYZ4dgpSm4aG+NN8Ysd8ZmOZmuHsqWty7Zy1ATgggTSq1tmXYxE5tCXWPF0EOUGphl2E0guo/OoPMFkd07U6mW0wrNiA5RtRuBECtlhCnUT/ESl2WVcg3yoorTcuUJ52+EfFB8CDHc6WRUVKGvmm8JGFJdN8Jwt9JWiHKNs/gka5bmo0TCCuSy2qQFyVakDXf9DBVfW/z+/lCWj773jnwczxnL+JjUXFtsNvEm9YyW+9TTGfTPvqkhyrnM2OXTByz9xAgFSsdk4eOw4Jo8k0bREwm1GCUpLaE62t8m5Jv2zzYm26eSsPX5qBIU436Vy1ib/yBdmp/GDYjs0XvsgNEpT/NYbAfvX8VatopTVhFhfJKFsZ1XMuFn0EfFBarM68kVDzUCiS0DiDxu6xJ1Gkqc5Fxmg1Q+DfoLCzrB4pkCJWZP3ir7CMWxxWsnlimjPv2/8y2s3mw26o93PHgAkVoBb/sfB4GXxDEmQ7IcGDy6btaVs2h9UaKRTHlSw1V1VEhtw9Wue0cf6tY74hU5qERmyaqh3bSQGKqz4BKAERKr7kHC5pNxJMy4FlFGAS2Cz/rfkGoUCoR/Y8nhx5c48LZ0Ki8BgpYylfoelaG1QyIfwK7DzVBPFFXGdSsYQcNCFp6IQWh43rWNVllAePgxIKag61+cKco6IxKg/2t45z86d9mpoddrhWe5dEidLSW4SdEgpffOQmdZ7nZZGRxgkiOxduqpr3eefG19ohuTDNvlsW020iejFd6tzfr4RTD2+usre6tyFX/b05Y6qo5omaNk2rWw3QFWBpv/FeRIh8MOeQ/r786YeG217/viF1RH/w2qiPA6OehdGlXZ4m4GHVXZdnrXhmbtCDlLLQy3r4Lp5cP3DYz6cw9UP53D5wzl80x/OrJHNsR7O4W08nE91Toue9zxDfJohaeX6kx3xR5WViLIzaOAMhj2G1gI078CE2+BEt2D5AsEpfvFYd1yFov4umzwWr76mN5SVWsIi1/TA/pU1MlOU3MDOW8rGaEs8JRjKY7m1GQhST37E2AikNlFL1p7FTB+EE1gqPTOipj7I4jrgRls796H2pJrVnhxt7dUfgRMrf6ST6t+uGEYlszM26TwnMJgiRdOtx0+LrRgTIjPyLzw1FCeiY3AsfOb4s2vd518YP4bb1OXVkiCd+AbQmyGllN/a6Xy6c2e9b1FIXT/NNHYseICTVF/kOrdE1LACI2Y0UMM73wAnuc916kenSFSBdmy6OXj56wNMGRWLO7dyBEwKoBXyx8UfIHAo7EdlCdfZoLSwIBi2ISjFTXtfGircfnXckrRUlVekIJxkYhQWvSWy/IdWXC6pWG0IdbTvSckdifTcFYw2h9a74kz9qLyXUlWwR6XgZVvBX3Gr2l4SqozHP9DSxr+U162GLtQmUilpSX1inpJofdgl9EjCLkpJPQjyX0IILf3CsIVbagpQlbMGwbq1KLEllzo8xpUefsFXerjsSpeGbt/TGVwS5+uFwdoKXa4wuqw8muHbg1FP3CO2rLUMrUJrr+EVUMF1DtMR733GQNaHObdcroLf7Ivjw42WLO9RrN4mqjQsvZG/2xkMQWaDaH0xpvrZOtxBfwLycPW8XA9S6dH68BKi0KHHGWPS6zJ1+BRjnZSuvPqXx39zo0XCOXr1Kwrdv7w5+B1izKi0eu47XCB3n3ick7gnqmyeQQR3BB1Dr5PbXhxa26u+FUeLkYjayrLM4hu45lUTSx1C4CSjJS8XIHl3MLyFbo17w7eLgqX5SJ3IM/sOC95gBJH0XlKNGImsD4tTLrtokspSbBWNMVh9QaCPOQT6yT/d0RYTp5WRGatNQhxl/rlsEd4ObU+TiWYA0/sUzaaXeXP8rpsDwi56VeBaQ7CDh2di2Ea01ItYAqFF4/lWRqrn5+InnZxI7AvEqpTDU05mzVEw16tKjnHfuUKH4z5tK3TVTy8PgC7pih4/TpJ/lyZOvC2qiYuNaHaZ6SF9eUsBYD9l5e+aX3Rvh2ynscXly0Z7vKjC3Dz9mnhn5MirRDI6FrMwY8b+Rx7DkFehFxo8/DodjwAyDrEtAvoYeP0oJ10F+kU4mJwkJGlFBJKrSSr+PrGtWUATQiyTGnBj8VT9QwlYHZpwUz8JfG2uXZZqV90aBPCtnaviYpQyvJoteJ2UFZASk9xTBtufZmDOjCrznIXrhevj7+3xstvOJnwL+xwz9vmoJHdHL+UXqrC8VF+/gu/dHASxD3ijWN9aCn39QcXdPwiHeCHIhVLxHWvi29a4xAwJukU/PJN6gRiWc1HFT6SLhEMIpEOJiCoTy1Q30IZlR8kV0mYPIHKwx9JBbB5E3BNjsoUaDEOkGMcUmaNaiMQuDniSEZsLV10qIiSHItLul6Ank+VboDLqEQUizZT0rx0yYUB2yFGBgeqnJLQpqUZaVhq6fjSL9rmGTNCCmOU5CJGh0B4FKySMwSFPRLA3kyh7Ds+YBjqd2bgm5AykrLg4MXyneJSm9VRVGo17qLcbk5llAJhNozWJC3dvtLWzJy5w1YMtqiPOkJTfcClkL4i93Scej+Ts6VCECejmnm5VVlGli4yeLnLe/Bk/Of53ay2qyVFw+xaP2gXsgn2YYT4u6pfPdhJXiretD86jiikQjxuMR4LyiG/piL/UYdsaY6mrVbB3NjaHDQzNmOrpCoQjY8kCDugyqdCYUqdRCQ1lGKqJfEXJCnkgoDgJYhpBwG6ikYChswvJVo4YIOG4V2yW6HqdhYqXSNPD3136OxNSFsrSY7oLssn0ESdm6T0/MzY3z0hZKQRbQqYfkAMtWwHCQgnMbpQ7G7vysirqxXI2GYBlVKUDrixaajfQ+42POw7AYmnkXw+Yf9MdXBL32Wa0WceakREX4LYudsUQGRaWqUAp1XNQPbBMmK8f5yRkImYMaSM7t5D9OheCclpsWYhX4QwlyuGOtq51x+8Z/+2NeY3PuaL7XWtvddztVAO2bIW3kPyuj0t9nX3Y94gep0ybFhtcmxzz/6drAw1isCpK7hNywaciMzeKGmzB1lZb4n3i7STixLgQ7UiAsX4t2FOrvMWwIrrEvUzcKQ6LhzuQKwVGPeaKUQJpbO3cK7ZUlCyws8QH+Io4q1kKASa28w3ZQgQbjHrXuuP3jr9h4+Z2PZusBHIhzZ0ri3KnEyqCTQTczlKSVqjzw7NiW5OmE51bYmdxfur1g73Bk1DRQfRQybyRKkvtQp8HYdBXS5oR3BkClZGe+FhHfIR1fc2MJhNWXjQiufW+o14Ftz/rXQ73I1SU0o2VE9FUsck+6uuUjG02tFd6ww+J99POpiD2KSeu0fvk/ZtCgSxPbNmITzE6XrGiP0K7XS7iXBLbihuk5YE+oTIkPOLjWJwlj0fjJ5rFmwfmttk7Oq1uP9ZpyZC0YtKnDNmblZX1rHMGUlYT46ZlfVN15OOLyllbRdKPQpkrb+u9Xg//EP6GrEwZKutVVmgzrkJjbqeKypQeP9DgkutKUCqsgp8oVuLSWlXWgjWzRok2UL/UCaqrofI2LYp0czdaTMLGIL7XWM8gtbK+maKK0QzPG+tL+nsJr9RI8IyrP7XpZ3dXqw7xoX51Db7RK27sOnMNOOaQ9JK4S7NCNj+qUbc6Eh016DCw5Jg5vr3ULkdT/bMTg7/REV/XUaAUW2cRGmVYAImhSHgr+fhEaD/qFHSzwajMHF3FU4KQx9zzrUqqhbAPD08eOmkCgsSWXlI9W8im+VZX/Bqrpqp6+tOKEaE/mBlyeqKxP5yLuY3CP4Iq0GbCg2pAoq9fDYnqZijZsQhxLOE+ClCZhazJWdau9nJiIWHRGK9FAkYSQUUbcmIwNpDiHPhflHQYroDU76SlzzhkvUAvuUjXV6fVeqBb4Gp8NOOtoRKXWGrbhmnDd7oO3W2RWCZpFRrs7AjBcSHGM+ageKIZIY62VyTJZ8QWDxzdV1pLW/9frhGNnx2/OkfzekZzHzpyTfxEo2M5nOelgFHgrc6PdlvJuG+29r6m85vsNQ6/oVPmXa+MxRd3qOml1xd4mKXF69VWBS80K3gkyk+TbF/fxixLP7+ziCDedTEddGFemR5ePmPYP9KKVldIvanbvuh0JY+3otsV5yGZVvry7PL70x3xA+yag9ezaADwdE4m7Rk2wVfNQ4PVPmB4EBoD62kWGq/GJ9sbjyKUxxgzqlo6amDQMK1bb2ullIraxQgRTiNx+mxv8DbxWL3Tt3UfFOc+jWyqDkcIE5kK8n++Iz7RsSEzoHG+aQp1UWcTQCiW5Ksp9/Kp91u/VtYvDBi7WTctGqCbehtC3AxuWA7xNqZxcUXRZLb0Rv3hc+IdWiXxOpekJjEati6j0FE3L0w0qEskqmDfNfL0c4xJAXm+JjZ8NNi7IO4K4B1OchholpnL8voMZ+0tt5xRP+WIPcOuOaZsYFPGz43lzblcQVXOlmsaYmlbSlVaVt7e6nxnZzV47/k39b2p4Qtv7u+VtzoPH6cR0a3v9/2rqwmPagUhd2sEWKrd0xjAkna92hS3OqN5C9mi9Pkc3TkmYu17udV56Dj9izoDf0D0vWV8U9/bGVXYhuPjVpQ2jSzLo7IxxX+2O7gkzk0sIRKgnC8jNK1IVXATxCLOIkScVi/HIFXI+g1m/cNHwb0JrauwSFtnKDLWv940btiklultFks4NLk+/tWWfvsySH2K1rPJ03FKSuHNVwQb/OLa4H5qxJKZVRViaOhiXQHkSoihKk3ZkmYKMWQxwOMddltKWPilBUpY+OFvbyWs8YsNJaxOS6GvR29iYfXxl7qDK+LcRNtYTnXWcajvnuQ7sMTRE9gk0Jr9QiqrQVA4arB2A6MRjdt4ka2TuI7cG94nBm7iEy3OJc+u/s59CTMLVM3YYHHiRKsPCPmAkA+YmX0vjf+X3rHoldm7yMbbEnBxy9LqmWOXT7k/tzHYEQ8oX5q0uE00DU0jk0ziS2iSQIB+w3oNlkEojimcPjGnmwoH2vnkLp5yvOHvE1OUTfRTyJ8Nqjth9QazBwn1zISjIg6D8vWWA8FcaRgMY8hI2kaZuLrZ0vBsw0q5xXvce49g/UYJTkiVnXiS+ea55dCxad+iY5PJfk199tfx+kFxGoS5kWCu2IAX+G7M6nn8vLgrRqAkkt8tfMwQqadYCAsCGQ5zrnXHN8Y/SfzL3dUFmw2QKkrywcsG0kB5YEIDkeqSOAUodJDvj2Vik/U9y0KkIaTFFRBbNciQdqWvcg05ZJnzOoqjyv/nOg+LIVOhIWacltqQYJ6pzyKlUlJKWa8I/w24O2HhLNmpWMcyiQBF+p3iBqUazlUhFHCAjRE5365eu2IgZL2unE1903pdC2RBAKt+8EX+Zd3TKIY1fEKQprv1VTQFrqMeJd3Izc1yMJy85d2B7rHQ5wrI0PgD4/964+amVPi+5rrxXEwumkun8ce3Bu8S0pVUIoXw3kE9K+1BSbaACvJXw0ETYD/eEB/vip/v4AIwVXXISVNco4pIIBFAnGsFu3AktR1cfvF/UJ7FZXUW4LrGeuVAK12NpqiKTbFQmJd6g8rgF23IOaFNhp5sjGrwekBbKmeFlrTjAWdB6OHgAJhYHxIdvqWrraWIrQLGm8OA9lpOATSfxWkoEk33Q6tI6RWXq3Yuiu2SISLhQMM/GknOXEzjeXEyBimJ8Q8TJoYU4F3rjj84/qGWfOtDraKQaVbDN1Hag3aGZ1rdw0YN4sqx3m4qDnzJDCSk8Uteo82XIe6knZh6L3AoT1cv3AEBpfBqJmTV6tJP/67j0HGtZp6lwX2pccnAba6CK4hJdvTq5zIi7VEfTDnPPLS+R9gbJo7IdACKbdsZLVtdN7BkfvwK+JE27Y64A99qymS0nMlkGmHUZ7oDJx5h3g+bScvMlJMyosMha6vg7N8W7xIaeOYKEl+tabe15ACC1nck3VGWrIK9ZW0CPN0ntifUTALGk0lYqJ3HxAPNa6ovAtntfGlNikp/Gfb3/U+Ov693c7GOIEDwNJSB4lI4Uv0IPtU5IwZwMK3ayWxjYZz6D9fIaVv7auJjFm8c9cW+uKh9coQxCYckmWdYT27ttQLQyvAj4ktAN1o3GFIeTYcQYDNlyr8hCWrLRmgsADWlRQ1tj0JWAO37MuseN/n4NHT27hd3xhL0vVJE348lAS70I+IBBtwQ8RdgsTb5iut+VG3fsLeLx1CkumSR6rIhUq1B+s/gRWeVakPN/GvdF58Zf1WL1h9vJ7PcnGgfy2M5/A6+eX1wRVxARRraEkvJNTudKMsoDA72ccqwQzbvdKoEtRn4c1lURlHd79XXGjjX4U3xbJBaZqdWdkqUCbyFSDJSeIZ6B/LzDb9UU2b76KC0XKngcX+dntY/zJjEBgD2aBWNP2hPshdfGO+2+xoLqmSb2Gwd9jU/jLTULWJXa1VWRi0zhDsiV2WSvcVcuml7xe1RMDu31HAZqgIGolQ54cZ7/SxM0HPqPn95ffAfiVdNzFXUq+UBQNLA/KTERTkeVCY2PE5CU6/ZkKZVypuS9gIEZ834a3RivD7eEB8VYI+iqICMLT+oqZmyyIwTTvULQiATwIqUSqG/T1MuTlPibq+WB3pLyQyaa556vDncE2dIxx4pL1TgwhKabCO4T3NB18CvJk31R6EOFiALqoeiZ354aDbZNudSDXkEvjT+963lwM5EPWUeUqdaD0hWTX7yw4O7mzFQ/cQb0RJGCvPdZ+8I9WoMj0xJyGIkZzFpePzaycH94lT96oHUb2yi9Y8Go5Pjnvh7HfHXO0nBF/XrsDrIzZlp5R1qHuKEh3otokIVkFsdNDSUYQ2zfdcKRFGgjAW6E2XV4KLF4mEOAnGfzx146S8ardOGsObwNGLtygM9KHNTYLDzEFtJSjutlMwI3bYw4N45SLYNl1kGUZZqRiv4LJtN4+fxiArgiy+PezfXopIf7sTXO/GTnfX4lUrOlkfapUzi2mziNLvV+Z86uI/YY+0jImSUImqHFkMdEuUYZBN0nbrUj5DEzpDqQIpi++VUF8XwmWN9ByXewFWQbYaKKiSCsN7ZjBd3L6Nw4Pd10QsTO4pLb+IevgnfuItRviI/JYSMgs/roBl0xclSjqSm8J/VJOtJWHAjH366wz8FJAUYoHYiQfsOfxLLNri1uaIyUJ6z+CcY+JS5xSRb4zidY128OoVkwVCFh4OIHcyOdE0GLp6vDBGrttKmoGEfSLnQuoJIGY0n+ugxULah+YUX5jVJj7wE0hQ7OzGWuiD1mhxQbyJOi+Yv+tbYPFrCm62e01cPf4uM6sO3RvWbPKoPb3dUH77po/rwjY7qFufWTCQ9jYmN5XRhOvaT0JhDUSFU160D81hOObEE0PKD0FGSHiq3rmEGpvuaqMV+eH+95WvTsCAPOgXOi+PtM4wgmg2150U1Hxj/SqcZ1Tx6O2jkzhHO8wJp92NWJo6n5P7V64NL4r5Sp/wHQomYVNWxKfMe4VSSL2bPUiYdo46pR4HS5PLIsKqMEBj3hlEULpSJc878lyQ41CK0t9AFiz0L6jekHb8hfuGYDJU6vTqdim7tl/bSk+Pv6bXVLRZhtsrjqcCp21CM4zbP1eN0vbtRLWvfrqKQ1I8Q8C7wCJVKza3GhPuF7uABCO+xJDppqtOBPkFPBASzo2AopdQISkIVBdTkNYHptRuIh98YfoX4MhubTR4S1o2sBUwa15ziqCSAp1sKu1kim5pQkgAESXYG7C4sZHw794qBmhhCceoNFeFi5lSg7hUDRyrz9YFOwYHXui89Nf7GDVCrVtNbHbOo6joMdCPcrYrpkpZOwK/qDi6K+5vls4m2M3QAcUacQKwUV7KsBgOb4VlxhybVHq5xOXixO2fECaAO8p33ooT/m3PnO+JMGVQ6v7dV6VKjw5Iw40tPj3+pc3MglazQ5YXg/Ku8K7aR+VNO3ApVuR/pDO4Q/YkiJO2oL3bEvfWq46Bm0l5V2Pxz+BA5eMAgKTFyaZhy1C/xOI2al949/uXOYjzFAvSzOs4K+4unBuhNiuCwOboP8YBNchO0jJuHnvkJjULCCfHza+JH17Lb6kQZjwsyRTUWoG71VCR+fykT4d1ebXgoEGmROyogpUd6ERhtYQUUhf0b/EjSnJfKEMMyNqWUHev6k4m+Yik5PFUiK5LcNXwJIjGbwGiROJ0kxrjPaics8U0ly5L9RRDZQIokipA2laZ40pCZP3LoDRVYlWktHklLFfN2sv21qENJBM9Ai1vzBrQNaeQlnX6KY7EipPfpGRvCdUyB7Up0R6JaFqlp1YtNQjsGLlKbjGlo4OZ2zou+Tr2DrRTR0GjZG4vHvS3JhLrEXj4M5AyZZjakKStrC1i4qPHUEvznas45cQL8CUNC8YUM1qs/dPwT8KE7wrx/6Znxo+2Z1mwSAWStnfDD1qdlqikuYGDe2byPqiwSQ3/ORG7/+EMrJhHNnsSRP5eSmatzA+r9WUjUrc5f6bTxefPv8isU8IUwG5loSxJDMM1AS5P+ZJPwvMuOOQTIJdQGjVocpoQXwn1iZkQWbR/t2Qs0rQu8ozVWEor5gQUvcO4zUap+1Elx1JOATeOpOzv3wfvVIMN8lnUlK5bulYvMDBa/rz++uj2Rv3Wd2qNz35pnqbykMXS897EMemeOXHeZtBgePPqto4/qZOPLFy+74Xq9N5G6zMNL5+PFNB8PO7uLOnSzU/Gw874ls/Dibc3Cw87+ygnYzD8PO39x+dy7+Bs49w5ZcWPBtLs4b9oddn7XcWbcxS9gxh121O0gemGyHXYeWTHP2q/l9dvpAL65U+xwKd/3aBGjPOy87dgT6+KRifWpTr2H2wZWX/eDTaWEFDaeEyeUbO6e3MiJo/7nOo+g1LRDjqMPmHdjKKlPaGdzHf9Encp99SbpnVDiHsmFX8rUDjU+toTHxEg87n1T2tiSDboEmA3GTyzUQ0HPfgZi+qxANxKPx9JlO8iKOKt4fxXdJTa265fFKgQzOqejrZ0dcScii2LBBYBtFVvSrXtD0Vdl/rQRtmzPZDqnGISUVa/AZjid71r3pfeOn7y5hY6Mr752q/PEoL+bkDEkeEnJaQjDS4prGHF6ROilKKqQwSdHcQpnJsZnMD09mHK6iPM/m+HPYk7s3IJROCY58/D2r/PweNd5eLzrnD1swXUebeb160FNLz/IxstvVDb+u87grBBowYNOYEkxbEM8KIaahzlJXXFR4tXX9IbWysXhKdFHE7GPWqgPaGNHi5XKhehpr+n7XisNtYTR+BD6ns1q6AZc0QJnjPXBw+I81JvJYpVifW5OZ/UvsQ/abuQKjBQLi8W6JLjRlPcai6c8Iu8CAQcd7rWqYRcLzi3sR2NKwPN5qNkmybA8U8+JfuSrAumNRi6yd1mcRUTKFLYs17gDvVUyxLb5BKfihTr7L7MRbn1BPnnVuiTjQ14d2PIkhUL40EDfOIvRbLkyS02+9L7xx9dunpKyAj1LrSvvwR+uXK4G5ltqYLJeCCn6WigLsu7KKst/r9Twv3fuY19lfDMHNgPA5gVMvtla++e6g4ti2ChDWarJllRq3xhtiXtFL7BnQSgTNmyJ3mgeHr8p9UZfuoZ6o4nK0A3lr58E6OC/3Bo8Ih4kAiOVfjQl5R7/u0Eg7IvXQQVUIyK+IRfLUv/QAqO9NKoSJEGkYgRrU2AiH1oonEQfBVAD/1V5wjclJfP3iFDKkotU7iDtbrGpMGGhoIIBWe67lEqv7q3siHsosCWN9DltlqHYRjmrJEwtZ0BNhRh6NCan2kqJiDz6MV+mL1zrvvTs+BtbtKkia/HrBUV3zUX397QTo0bw3Sp35ic6vLPxj/qZJ9TKyZldkJaJtVKWw/VSJjXAVZnrYzlG1beDWvWZjBJWB/r5LBtwQ0mo+bjP7vDNenaHt/9EDm/ziRy+4Sfyqc5p0a9fHhXc6/9sC9KcFr2S5arLFCw3EWn/vEPi0KlVWWGQ6g9GW+NNcUHcjdSoaYJ8IQEKptt4c2jFLmQJbBzkPQhF4raILYm8d5OLu19ZHX9u/HOt6vjDi5SrEjxv9Z4z+Ka1wXkUwna2rCYILU5t3cF4U1wVw/qzOnWotxZMOhLzZFB/FqM3IdRHD8+KgSewb31z3ntfr0KjLbq9+8R2oGAH96/kpta65/vEtpsolw5z9ZKllITd4vr4VyB+e/3E7oTpPcXlj3c262upp9M9bXl7UEi51dG30wjegEcx24hsKjHKwzfyi4fLfnHpm/puRkWDzV+dtpmEin6XMIY2RurCRFlUOmroACRbC4NURqWYMWVICdoNr4l3BNrOWIhFKwTtYllfgb+CrLSrJiZOTTVB44lM0eKIf+feuXvN3lDcEeMkN1UajCx9TpyMijz1F4CfS2h5GsA/64r619BXMZhOmqmsSl02kM/GEPL5pefHP9FhZ73bYtPODpsV0d7GxKyQ4Bz88PrgcSHJRJgbT9qHRuPJcSQCfsKt1pO4Ab4f3M0J1JxFpobF7pCbJD1hohoqJLZGEIvg5qzNINHhHxZfSkUKh3LFDqIKEoeswiTw4pUMjF59DWOh+nlDAgDGz0XjrJ6wIqW0nOKn6Q43s3LJe2H8q60lb7GhFN7arc6p9tTfgFu/1TFZAKsx484DzmLRc6wz9nnigWgfWcrCAqvqVket3ttOTtAZ2lahrEpZX1Azn3FN2ZmzqtIx60yD+OFqatrfT6ABKLL52IjncPgIIwoyNTB4twFkumzlI0xz1Etjajxi7IcPg+h4IKMZNCXM1aZeIC3C3sJ3eY/oOfa3c+h/snmt+9KL4x9ZXy0rSFDTrvOzxD2Tm04bcMsLmH14gF/lqPM9RxrfZ8RAJSEp3VdcDRvuwH6pNDJuw36j081tuJUD+6XxD83rdC8bQsfsdH9HZ3APFOu05m0a0AtC9LRmeAJZTUpxpZSSI3tz0CqdJd5NvVaUePcr7+vG+BdonT2/qC+xPtErhvJfWhs8Kh6aaB8yI6TOKTN5FBun9qB4AhaSbfGaeKVeggLegESUeDY1jvR3bdFeuqH/bEiLDEjU9ZoGqgD7WW6z4ehxRvQnPnGvGsKND4pTuOPFgyql17bFuNreuyRESQiTfP11hJoOmftIXxn/27WVjxQCnCVMLA0L1cOtUTZXtA5+aunbQW5P0MolwtvERV7pZUNGQolL9WFQlGALPEP+Wp7XmUF9iNUkKVGKgr5SRwPYvHdgF0MbkOJSGn9VyzcgM0ERyXyZiVYV7LepzMRLX9KQmdjexVddXK7UxzsbQau0Bn26sxa0Wqw13h1cFKfrDaeBsXHTtkbyB8VIq4Z2niLRKQgwEM8BmNIp8vcUYt0KaAJapngcgTqCmvH94o6yierR/dKywcrq6OOD4+9qgRLVcZi0G3CHsE7Lzq3OI8eRDaajl067W93BZfGAbGPUTJjmWUdA3PvFSWDU6aRms6URqGaRQBomPisGB58WsTOiL7ObneSla+sI/DaBO9vIPbR6QEnbRm3v5viruy27kaPr0yb6Rads66hS5wpTlS8fDMRmpFaCOMn/rddjWZZDOGbV277xZLsr8OlOJ84/2fevDy5AGzAte96WM0vfY+KCh5xZ1xOujhUoQHYymcV7Wwas0I17w3cJSV+g3hjJfqBvHipuwDznwU6/oI3CX3hLZudNWf8eEqfxPQRUwi/ZlIfel8Snfa174+nGCnn3Ltwom7LiOult2VwnvV2szPOvO4P7IKrUukLdnCoEntPiEXFBa0z89+sU3LFERkxC01uaMNfDhyBe92CqgK340GwFeNI7WTkd3j3+F52bG1JWylML5nZNvvyqTPhnO4OTYsAmIOAabcWubrrLa3BaAMsRV/kJ8PNCQ3IIixfo5hWQSV2i/3susJXEult5y+8d/xQFr7dZ2NGr7vTH1gYKYABSko8pgFNa5HZw5ATNa0SY3iHeKaigg7ARwDFqFlJ0Sec6JKmBGQO6J4X2LVqSpeNIadsCW9E3Y300ms+I/11kdUiUbKpUFhNoSTeN7ti7L3X/4cPcPhjMFPYGnvDQbbpr/1r3xmj81M2exPmfpTiWqzqlmrs8zu68iV5sb5auUz13/8Xa4D5xR+5aQ/Y4XiPB4ZcBdWxQKBzhsAAdMcRUxazagdxw0LhoWl8FENU5Qpzmnx3+QfFafSAiZSPhnVDcM6oEMyY5Cr42UDxA4xSNTqGSvW/rDxA+HJo+FrpfqmOmtDeujT+LTNIGh1RQwaXRomg82zVj1HDdGAUid3P4pjN+STbdJVx84MFBL3DOVzYNRGMpDFuGJN/ix7R0Jv/5zuAMqIWQt9lVD+AFqK9chPoLdgYqlFwqqM+u142Sangv8J9QhQb/HHQpVz7aZ8ffttEITeZf2TdCgJglTvkakapVZFODPYAoaKQ5G9xsQfEyFXVIXmHUw3BR7+dyfeAuatK8cJDm0hf2ToleyX/lNj/7uZyiEBHqRvxZusnnxn+FfCYeXJTnDup7gj3tCEWoGSuGpc/pT6/BrqNYb2K0Jf4Y2jySdAQ+EsLba4Srobq/SX/BSrplVWSlIyPbG0L1lZuwQY9CXFxynOIldijEJjWjtrw/5ky7Pv5ncznb4Vi7FtEMu0rvbO7GiyDgg3AGeuJLv0bpTfrmxYVOVX5nvT5m6bv4B93B3TCbXMjum2JPPBCVRtUDcs+DnkbIYYwhD8vhy+J9BncosKS3DbkwhBmVzIzC0rVhcgHaREGrWqXXwtD9nXtFP0rFuVCkNsnePaIXI3ut0D6pz4oTsT53PpxoXTOv7fnxj1J4MXc1MjOrkT7GavS32NYEPJhQQ4lLMaAfRBUunsLEHkPFNSJGcMG7TqVl/UwSywNdQNj4zBzoDU0ssuuwqzkHZFsCJ5YkvaHJqJsd1KHvwKYoDVmjXgjKQWt253TKPM2B7tX/qbWea3xyv7jTOQYZ0e84JFuN6qD8hfFfp0UktgZmo1p8Adfnhfc6u7KYZoduqXycW/W6/lR/YERhs3GkUleVzgY0s0W03mgwOjG6Q3xdR3w8633XuT1gcOJ0olGt27r6b5FtSq1nxzQ4OBIZOx2OGZlFGU9K1BR+jG4lIf00mHskAYuGccBgeEO823nA4yhVeZWQLsgCUg6py9JPK7RLxPwQlSzLifXJu9Y1HQWsuIhpLHzYsuQk3mTR5p6CE8qD0BmN9VYX8wNscWc4Dn1InHYge6kVxmBecYbnyjqjgKrH4KmzYiu6RHZ1OZ691r3x4vhmszB0BcOQxvLZnsxr1lrZudUpV+M073RKI7MKnJ9kKiR9+bE45JSSnHQEpKx3H1UMRevf9Tsphvcc+Zsq0tnecztnu2uiLYJEaO8k4AEqQMzh1PoZu4Zu5Ps8/AKe0eEX9RkdvlnP6PB2n9GnOmfFllOaZ09jTqZl595Z/2Br7ajXyFo+tcEYl/m1+98vXo0+0poDMjluf5ZpzOUazi10Fr8A4a7EJse8RIOXG8+16KPWVLsafrmY4NkgMMVyDTb3LW3/9cLkqSAEGl+yChGYlSTzpdJFzOg28cnKtwplvw6Nghs3GmUwsZs1UHMdLPrYrINFf6Suek5sRx+9KvPr8qrE1wW2eZ3BORQErAKN1cSF30Rmtk531IxTLsG3yKAsfUtvkr3d5pK2Nd/fK+NvbftN66Nhgb7VObcImdAtj3zVHTtX+cv9gYWHmpGqdYJXguQsB3UB6cnslwsckv9nTXxmLfnK1IFZkJVzxHZt1AdIele2hpNFL0oFjGDa16+yarUhyXhLJpTTSmkYZnhnFA0qurLWUdrWKwdToOuDec7X04bXFbS1DHEqK++Lynj0QgGoegoyVvwuq+UiUCVdrcdQqJxKQnuEJuE6F00L+AWT41pFFBmu4tf/xG/s6+wxDFfHsGCTzejilOEc2MUMzGpILmhsbUvvhpMeOyUUB3GjcUmjaDyjhIGi/CgILlopZYOIT1E/f6Np6fmwOA2eB1y1rpLBZ9sdfK8QZ7VsWnjVq0uS2pTJ4jA1hE6LXsk+IWVG/j71hNgPUknypEHEE+NQYrJlcAdVwFa6HoRGZDjavta98YHxv9m8eReOVo2PUzFTgB3ZaJrNCRzWtLzNaqF2MWn9vp6htgtMua+Q9A2NNgkzCZQE0GkY3+7wavuw2aPwLVahaPJujwUQsenwR1q3l1z93KxI0Tw4yQPiTid5p2EVmIwGbwQRf29tcE6cwTwblxmrktDnqC/Oim2dV6l6wHLZw4ndEPLOAooXvP+g7kHMsBrP45zl5eNReXmuO4UmdF1fECLC48yUGviabUMLT4meJU2WfmLwXeveuDn+tQ4UpKXz0/lQnbmVyqYl37E0LLSdts3K2DOrsVO4Yw4ChmkvLxxsDi6KUwRvKoGCkGrX7Agn3g4ti6y3ZUjmkLyutHX5HZmkbNinErUBDxZTYqZtmurXZdqBwB2ioaRwv7gzykS/RPnw/JLvF3dCGGayl10/ITaOKH6D0kJoKu/mVsPLT45/taF9+cnOmvxKeavz7Z3VT/htHjjS9bLffjqkx6GQwezr2Vho8MH0lXdF/ZKHL7S/7OCLYeX3+EwET/QFK/PQqPmnnUV6SS/zBWI1oN7woFrgWfTHZ+13LIkp7hvSh54FTsCfEoz/i+GHbvNncftwpL2G9kXF0lMkZsTK6XMnOWeWNK7om3OzqtkVPs/UpdPl368NCvY9J8iTroMIsCfN6tQ0b/5ER/wHsGOC84GsjKwnJdCCINQmwWkUZmlYbvAuGzB+x2AcCvPMqvFcWEuGDgnJnXAvOPuuiXcEjQSyaWP1qzdXehcE8paV8Vz/KyeZU9hnRZG4MxR34KQ009kAYe8+caJMH+F8VHNohBRNv/ze8ce6tKKG6QyR4ejgrffr4bqW2tI7dYsE5M+wxRxsJNaRalbR5gQeXVXX6we5+v3/2bsGZ8TdvF+VB3V2Wgfcdab8/RviH27Us1U6z7qN+1R1th5E+Y3URGTO1WkbNMxsJx2XeusDfVVGQ7yT3MLTFJ4b18xydWZ9mYmyyAOjJZokGzRp9mhPtsNJFEjlnmDW+nKexb4QcGfRRwIp5Lg4WKqTepbQJUAw/lC93lYaO50g1+BI4hEMIlysdwKLl+RIN8wm4SCKgDDvAA6jhjidb03BrQWyv7GB424QAHK+kRXn52T5QfHSo1BxAZSL6nzIB47j4VFFZK6W01xXwEU3S6KR5rMCzSE7kQaekA2cQHA+gE/csW2iBCBas1AiWRZJO9KsoMc0kaiw5EkxoX6B2V1aIXWg+eYc31zCr2d9DKXZNG7f8CPf92XDFBYsYaVyDnbicW/41pB+a0j/1hrSbxX93syi38svzZrQLSFfrBklZedWRy864D6q75hdtlKtFCtwLPQ/H+xeVgW2pcm4SXYOV13EIft6ze0u7Dd/bYXDRZ39Hq4P3i72pARfS30VtARRNZIRGCghGWUxqx4I9v3iCXC0VbtPPB6CRItlCbCfik0s0CE3l70Q3oWwXLDZ2y6zBa7eKi1/uLMnLjSvDJJgB9yETACjKI5iuFQsalAS5sZwHxz/0/Wbd0INplKUOLbFGu6fMRKw7RbqlYWmMhMozcVl2q1zsdNLuQ9K20WWpnrW0lQmc/wjKWA+fkYxeGnk+NkTgwcAkalh5UGPo3pQxFQl+dmu+LEutNMRTMxQFASWsB0tG00HoJEldIpJ4C6rEu7EYEbkYAkBcSZUHcL6LdYZ6+WSbb0tJnN+ooxjOez6/TrUUgX8CgANQd8J8ndCxCCaQqOWInZs0oUliSL0YyA7Bss4GkTakHV0tnNFG0CvCF1DeBzHhgrJvxrgNeyFCDLefDeOVH84NfK+lLANDL9rS/ydrYQMmcIbiZHkMik6MQf5DaDgpkKrdeMYJFG/JJRtp1xqirg6qAy7qoTMjSTIwWWEkCsVezsqKOeahhc3qJKSRGaBf1RQ8sXHCQxAT3VhWbmSfpF6YfAWXAoL6r08VlZVTldeUjGZ1XkBPQ7P1oHdHt1DoCq3Qj8TDAMsDiJ4IQE6roAOnISSR4lGcz8I7+qvcAEPR4cj5iCGVzFFIGDsU++T/NYkNgrxd8t0S0p7vByFTnMhV+EdYoHxuXpSRtUexVaL5l3Vb1SRaL6assk5YBPAEN8XVVDYrqzKmIRW4SfhoTkasBNuEmBxI9CMQv8pxFWiARM8CpuenK8nO/0G65JOK60qM6EwRjmu+ZuqxJoI065ZFBYmQMntTojIA0Ob0Ngf7gYBnBRewD2UsAw4Ek1lfxN2/MO+RoVmmlhayPfEdDl4tPQiTEAZaBgi3E9yJEQWeE0JUGDILEI6mdINpSANwvPl1BawDqCbodNYQmLrJlgeaFjWMy7wsCSlgipOcEg2x45GPJ6hUWFxnFQuQExNv64xaOLXBZotVZhoeNAxwE/Wi0hik6dyyIqO4cs3xz8zF573+O0QyXsg4V5OjwGi+6r1wcviGZmsKA1v5QH+WW8rwEuE5ZABNfageILdfw1AssLVOBOiiIfECel9CUglkKKFfyXWOB85LMQZ09BRNhw9gudNhif0R4Ody+IesG8lcJ/hzlRuOW2O+nuV+D0AagskVR7LjFezVHXE7phjbXQqkRXYV6MVvA5pJwbgUPATJtZTCUZrAlfHZE68Seq6e+K+iKeIrNCjkpJ6P9qYr/TxB8XdKhkxlQkbH5J2Xn80eOqM6NFb0AOXBfUH17qvPDl+uEU62oBHPPuiL4mThhsp0PzV28aQPoJU9buqA9Kv2ZglJgWwk2viOr5MfDDAzWnvYYZ4mnCk58U5MDwpIFLEaXL1dGQCS/1FU1TexPokiZAZrUYY67g3fF68E0/FGA6gLICNdMmq7LiV4yXQ7lcFLRu/h57ub+E23qwU7oK4M0TrVFaFhMfsMrPplSaz6c5dcsxr8D+jbfE/o12YIv1gd/CwuFTHhoTnI2l9gKU7gqVHHJnXBCMBlAbnF6RwW9Saq4c0y5goGKWwT2akn2axqwDO9GeQG4E2GbqJNrTpwJ3T8/0s9uq8SiYMQxNFOG/Bf+WZ8eNtw5FmehNyErrF9jOQs+xtXn766Tlr+1mx5aTK0Eg69ZjRW4OfYv6hjrZaAN96l5Aap7kuGaZeogdOCBaKN3VwiK0Wnm06Wq0JdjP8iHiZfsG6pCNvksaIr8NmY2m1IM9S+KPnqLZ5pG+fxsi3JvWvQ13mlffOchJdaGOxdHvu6iVz989s1sMMx9dHsYRgwJxU5mH2TR3x9R1jZarn1jsGFQKxageV0YDRr9GVtRSaIrPPVgEcjGPJ4SgwusnUSU9QHhkyrZAMCgIZlOACoSeOEoEq1rtdyX7qA2Olt8yv/f/Nlb41I97UGfG+2b2szdE1Vl5WX9vp5ClhrJwfZv/PWwMvrpI5DRT7qCmCsgY+yTcl/CNHyOJ3ozqJnCQnWp/yKkyTTJxmaifDATjdNFTrJosL6GPkbS8D3IdfClb9jkZt/TNlHfhieFWWbBWXejAGEfms2E3N+6ZrTmIBZtyYERcUyQRc9QeVokaOmVY6DWjVQpCNBnsXxDaxQ0FrtskPPepPekZs+aBZOpIdwkb9a91Xnh/faPu0L6gkbtYRe1kmPPvOIgBmhlXf6lxebMLe9fm3HsDDEqiqSXqCA9ztJHodn374GDpSvQk2QdJ3HmvVSOei0jb0xMZFihTzVaB1Arkf48Edrnoih2/wiRy+gSdyeNtP5PC2nshstLYvHkD55mlZVlGmdqgvk5OMKkstpaxXgwbv7Yq4MHGhCYCDKfvqa5VlUeyNUU+8Q8CaTRbkNmZFbPQsxn6JmaA4V0xELY0A6CsoCQabONrcJAq47rmStbvuFYMIRGKUyo5qkT/Xg+J02YLbq9RNKGWiYb3y8vhvbLQ0P+bN0Ln0tvMkDo8+CDKBXTcgDWxwXa8Q3LUKhEVtFTjMQfEE+IvfI3qwlNgDvYnc1dHm8Ly4u87ZNQgSJcGzSB/vnBMnABnrGjBDXoMW130+8J7xN9NN7y5RNEsyMfV+ettyhAsKQafFAIA+dCeW4O2Df9wdoOgbKgXgE6LHNeHQ8P/j7NpBLMnKMHV7prq7+op4HMfZ2+zOTu+wTo0zWHXeJcpioLS7LHtBQXfuFBhsqKkIcxoTV9HASAw6EEFcVDAVzMzE2MTITEPB3cRMOf/j1Km6de/cMZvuqa7669R5/I/v/z7K3T8zzGJeiYfiZcsJHZX60/pgOTLnCXZZEs7PpRS+R0pkB+nK+GKJKo5ah48vq4tbHHmpOPAwupflnrH92vr3N5/EHf8c+f1YBWBJdoGxcbaNC08jpI/1/f+PxP8HRrLIJ+1IClxPpDANMvA8Eq/C2mS1bKaVVv0mcV+eSOqZXr0kzjTDL4GsW0ki3hniU2LqZkKe20zIY1Dsm34/N3LffGv935HI92569Ay0OqsZP2XrlY/1C6GTpToAafX3RfUaqslxuy9SYrrcpVqX4lviHedZAUtfhdYN+yggLElzTCrjiTDDJ8YMu1Hwo8O2SJ6g7EgdX56sS5L8apFsFSW/Mi/oOUngd7+8/vOIO+qNDDw+D0W8g1wcWKSDMhu+Xz2uhu5lkpoHdI8G+LdxgO8Co97A8wBniQ1W1cgrf3kqjHggbcclCOv60JoEQySevCZ1AdgOmTxWXxVfsIr/zNEZAVEA5nEU9NxqJJ6gsoFmtf54K6uoYyARDAAnScrNbA/5Z8UrHbVcdEG7GjXwnB1Iik871Xmign336+sfZgti71h9cFxdiFtYdRyS6PpRl/QewL1/Kt7msFKpJmjVyDoY2XhXDz3smTY0AH4VMg5i0RABMIO/PTj1p6sPFuKnCxYz1l3S8NOdAWxOH5CdWTZYj4kRW4yk4NKWa5oeasi0KaLrIF0ftAtG17ozmaHxPXSHXdIdxjvU1WK5BmeHYqlHccj4Tyja+A3OFR+kJwhr26QgDwYCAm7poU5gSawJocEGceK1Rs1YMkgiJX8bf00f1CW4efoQF7fn5svl6cMHsJ94iwFWR4nPlP63aVHLT4sTbxNxsZ2S3H9b9J7eEYUvQmtolkuPK0MN5CvRI8FaneyHtUDCqYobFVHih0vV3mUY9SdfWp+Pg52xBMaE/nbYjWd7W6Y87+M/OYCm7sjbZt/xsE2Dl46T2a1rqk8zu3Wdi8pbJgeM34T5g09Hirm/OKrui1dyfLZsg1Vh47ocnl2Kc7GUba7uJHkircvVfehlcYjRgHpoFgFz3WYXu/X2vnRLHFvFLSxEu2XfXDx5e/2D0Yk8y2tL0BXwag47XX3/fLCzS/z6L3TPaRg4e8+9O+lHH6u+L74zlvd5ZqMbZTOhK+h5DiQUDF0ppKnroUNlSLE8HpRZwe9EWU6DSRnbZ72huEWvj9Y3xF9uiD/eoA2Okh+g1bFBjAyhNQj6EvdODfLy9INxcXUHr+GPoCJh+8RUCUh6rs1Tbkf18QV6FidhPAuzZAMoQQIQAlcDph5hw1Pw1C5aKLHhj+E7cJbCK1JRvQEYZwwmgrRho1wv+UmwPSuqsSG4KJ7adeIdgzQdkj889r0E3mkDqFkKMaNxCPXcAEwW/l8lQJrKvGCkqM5bRelMpDGNJxBWYRDH0ydAjKQOx3hoeq7z0mgA0wXFEVhUiMclG8D2U0chsFcw6XVQjkmtfVYVhififInRH+F+CHvYKlSBCT6OY40MexxTH7dYyo5zafUZccdlsk0qa6kd+4kPxLlvUkdskA0XU+mmiSB4ZgOx4nVvE8JKyhCdC0gIOxdNtElUCjumEz/Fk2+sfzTaYr47iYsyt58ykxqpyZxHUIbnIel6H1pHLToy/qRccKbGcCZejrAVA1TqNgZZdfx1Olembu8Ucpd3Lb66K1nAyabr4ufFrp3oodQABweUMgM8gNe9o7KzJVySXL0JL01rXMOCkg2cFrIOqpM41WQw0nB3Eq0QXh7pUcTjcJjSPIuhvl8c0pz0COFCiqDswBgIvmO0d0DStTLIrl49PvRqFS8nQ+7uFKNgEObvionbkc2dHxdtGKopkBGKtsAT6Wmc66ZIiwsNdDU4mallHD/d4GDy1DI8rxpwFxHVhIGElTX6VmRtd0hb78dll4Ttu2C4uUiPspijF61QzsXo2q9OpPHBaK+ef+r9qiDJjqZJnONM6glXEDXt3dmLBpawnXHmUdO061+WAMA+34WAXTx9b6+VPyuIEG1g4MqNyyPd2/l1g33bNv2mzEHhs6jc55El/amoXhKfdMCShK6ASx2qS7Lwc+ICsk2gZu6AKLQmmoSNHfqOVZIm2R+2g+n/LA+KtUu0aD9nc1EtxQnwgrXNVbJ6e1xzU+4MfyGXKRD0XYPm/QtH9v5otzFDNudQ6srqb0W1ErcseVq0gFMLMJl6b/4KeWoPhMOBzf8uuTe/nuSexvSL0CJ0Xby2dWgYKClkr2b3vtpPyuot8UVkCn9mE+bNXNVvBJJ4YCiKITKLOqebYl0BxsHhWLwjPq+0THGsUi7oLiumgTunHTB0gLvSpA5NdyWPFVZ4042XO0fuD4X4ddHSLVHNoA9A5YU6hNG3NNwSY5FcQtPWaYl9DUt4NjEEB8v4QNxWtQGfzeflwLaB3VwFb6JziARipidG4vgrTbc2LS4zueRsTZyh+LE/LKc6LdlGWrY2KL3Fl5IdPAvdXRcP5xLo4038uN2o6BtOs+2z5ZubwCR8PZHV3sHisGjtVCBy9rpVHC81DJPeAKxWB9MmUZN9UgsLpbeIGh7NruQSCQt3zPmXxVJpHx9O6qtK+6T4f1Z9eFRdAIsvZYDo49k4F7bm+P6t6f1CfI8zXUir0hK83FFFyLRP32vqlFY0LVO5xdgMwjysAburmpNi2F7HwtGQGGEJ7+gcIK61u5KnpqVgG+fZR7gRfmW+r8OPBvBe9H9mn2JaNOu6eP2AFIhpm+mnnXX0FnLIeug9U6A0bVzXiXB6tulkSwr7Jqgh7G9YLqpKlHh2Xp4d9HGz/fo/OLT35l50NDOnNnyKnynPhnOrzdyhT4izGKIPPMlzvsZqdJE8Tbdaz5U4wOK/HoPFGVn0/HjjffcN3f8CAAD//8USxETazAIA"
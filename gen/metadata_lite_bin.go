@@ -0,0 +1,5 @@
+//go:build phonenumbers_lite && !phonenumbers_regions
+
+package gen
+
+var NumberData = "H4sIAAAAAAAA/+y9e4xlyXkfhnP7fee5te/LJXe2Z3bZpznNrfc5Z7TkcGdfvUNSvKL4EPfMRSB5/rKNWHkgD07dIGKiWGAUSHGECJ7Eid1yZIlyYEYKBGkROXISw7aSiHZiOrEM2JZsKUjkGLJC2Y4DBcHvq6/qnHP79kz39IihyPljprvvrVOn6quvvvdj/OeL8XvF0zvXr7VSubqZ3bodWutn5a3bd+x8f21/XTwmzvlW71lfzW7dvmPm+2uTsVi3PGB7VYy+/dVd+l/T/9fo/xv0/83Rq69N/0nxzkjKLxX0yX8Tf/xS8Zx4Zuf6NdmqvWYWWn43v3b9V+KoX4s//o/447fjj38Wf3xxRD/GPzMaXxbv2bl+TQUfJ6jmoVXG7WEzd9x8f31/c39LnBNbbVWnjybPisd9I2/dvuPnoTXO57Fb2xfFWVXLVmp8Zuf7m7t4VuXplm7z9ek/HWGbd4tL4/M7BKiSf4i1K+rSFT3Z4FXdLSZxiJ2X/CMNKdTd4sr4yeHz/ENs0phLV8yk8IvQpL9OALW/uDJ+XWgcu93DuYamlXu+bmYMwFoCNvpqMw/x7Gn3FWHE/gYAuj/eP7N/VjwjRBrQ6j2Cr5/vb043JkKcd63Uds/X/OzW9pPicSsZ6t0rdi+Ks41MEHfz/S19UZyt8IlLnyyA+43pf78BcH9PIT9fyLvF8+OLPWhh0gzTVS9DfbdwY7Fz63YfpnYA04utNn4WWpt2sr0ir6i7xYtj0T2Df3fc4Lk1euJuUcZj0/OjX1E4mnPx7C6Ks17KVue9nuAc3x2NL4pxq+kQ79Tz/a3phrgqdnCy2s1auVfPQmssfrOz4Fu552Zlq+Pl8PPpxmQs1qtbt+/U8+nGfS/zm9NZH+rvG6B6D4/X6FrfLfbuD5Q1WjwB5uCE4097CX5+dfy8eHbn+jXt69A6oClugpTxEuyPpxvi/eKy9vXO9Wt25/o1gM/Us1DbMjj8oZlQTjcm14Tngd6GCvSoNUTaNOYNBlfBzwK+TFctPbv9oriMJ6UMxgRrg3PB+1BVoa57Z7V7QZzDNckf8BH9aiH+duFwmQ0d+571e82slXFccPhQ4o7y30AFjCjLYPmBql4cbyx+11g1dtE9FHyPbMdXlKHBPDKS3KAJyYJtSt5hcJpQEKNVeg99jqlk0K3SeJZ3v2TrN4Q4r31t61aqGUPt5ujVt6bFOytSqe8p1Ocv7LTW0S3w8/JKUF8o1rSvr6gvFfysbD2/d7rxbrGifX1ixPk3R0XCnZ/ocMcehTtXxCXtAWLdaAK1b5WehQZ8KB/+5BVR8yjj4qgmVL6kczMtYB7qVu3ZWUlopZtQ6Ueoc3zUSbTr7R6yXNxp9WFsscCWC+KM9rbSliFMqGJPgyp/aXX8ipA7169VUvaxxMxDDYp/tZoHAEHj1mQZJvHZ6Zr4Q+K7rZOAI4kkhocbV89aheO4dZsAFKxVkuHWjcC0/DW/pQJ5oE/wNjzgwLLXpuvTjcl7xNO44cDR+FQTV+TBv8+Kzci27Xx/I7FtkHrsa38dbLuWde+Ta4+Jc1WHeJCm8oF8bPr3Rn1ucmXAw83VHj9ZrWVomCF/4D6MfN22es8n9u2WcZShNHWuB6hg+bkXB6zNdaxtA6OrLB4ch+37U3GrHib90CrLuqAHJDATq5ChqviI6BTFZ8V37Fy/RjxI7mlcKHwfcM81XfJZMK3aq2eBYEX0HVw1eDo6ojfVrMQgPdOY2s0x8eQlcQVUyQTbKmPxnIsXLjStArvDWD/fHic8cfPd88CSVioWsfV54Ag2EP9mCeOaqD3uiAbiBdMSP7DxxnckJLSeZMzQ9JjvzdGrH5/+4wEqfXAgQ+tlp77W1s1Mbq/KS0CT9x953DpCYXttR15RJbD0fG9O341cVcFWedjlo4atkVjwsDDibxRjKCe6SeKfuCxe0LduA0ekIqZLkGyJSsab7OYTnEmr9hxL9feV+z4x/RopcQDUfbWT1VY3swfdW9rYz354fFVcAgqrgJMCOcHiaygkJqoUzRxKCKjWdFP8xJb4E1umriEimKT7BbA1cE3VKjcLtSoDBC2tgMCQxhogrp6VJZTe+Ai+Bxd2FfEmB65Slnkmwvgy1N6VZdJ4uqfoX+uAuzY4HR/TPlhbBlsZ5nbVTAfdaj8LxgZLsxtdla11C7PVliaQOmhThkbLOCGkBOtD3epqFhpdBmdVqKuaVlQNV4QfPnhd6mC0DLaK27I61Ia3ZXRD7NzrUPlQNxBuLc3VgwmAqCLR8JXM5ASrw7qDouHBtGbPg3TQRv0M4q51szLYxkb+b2koqSV+Ro9afqaW9CeW16pq1lpM2No6jmriKF6yt7TkNJU2jseTbM7fAPENHseD9HZNU+E0qlb6Js5c503Qa3kfNBskO34vsM/P864Jh7SBTqXwaEUkLdRkTAhNq33FYFAeJMQActYE1xoLIkb7IcBULi3NgyCD/rlWOV/PomAtDcQcS2ybZsILbCuxb+dntIhal6UdnDv+uYpQHovQQDTCFZpSBW2CtwxNQNLqUKkIOu2CqYJtja1m9CHdoDo4gKcsy9b0ESO9DEiKvVVYnMIaHUHXE80ODS9EtZhVu4AvofSGxgMIOOfgtQuN4Wtj6lY7QAPLkyD4NkKAPiNpP7gaOi3ue6iA0bjOBu/jnbRKWyCBB383eEzDyhEqwlbemQd8HWamkwRvo8X7GsdKYkGN28rEo3VglabVcR04xeCIbYF9GvyCv6rQWADLJmDtjyOpmvz0lvjJreYQaaLzr/nKHqJQ8dtE3VhqC82DUavm4ZKr5mHQq+YhEyxfOcjaHd36FiRZTLOab3ii1XxdqVbziGwdn2xForV9VoyjSF9dree7Qpz3spXWZcPweKng+MmpT3L5z67K6yBuSnXXUF+HqX4GVDF7FaSmVgGVXVTPsTJt9+oeoiqcIpHEjKpSXw+qpRPU4AXEvSPqtrbhy+H41tFTOJkKmIxNNyVhMgirx0pgscZCcBzXgT2OzgUaKJsvDG5dMwua7Hq0YGgqQH48oCEHeNazKn0dp2Bm1xMZwN5b7JUEODC4cgFVQO9JBcJ8ttUw0eNgYUm/Dn5HSlEFGBBnMlUzuw6Y7PVwSAGoGphsWm0qmkmxFkWLi0AF+SHN93oZfAtmiWtDhCaAMwGZlqKZ4nOBPQFzqIxpsNHh5QnfsEV6Ox0N9oG/iVdGmaZqad0AFGBNW9EWIpdy5fUvFCsNVLTr442ka4yuqImUQTHUDL2JLIsOq5VxuaADtoWBqgyNUtlcrQfm6r2euXqpPTsO0P0BdukM9exu8Xc3xk/2RvReFe0Ne1fM5FOE/sk8ZmAtBeZXBH8QFmykZuQ2rQYJZmJByOXThQBg6fSaWTn5SoFpgWlSR4SGbBXROFnZdKLChKskMeGBhJnxVXhUkkOHcTChXkK1SKNaTTcuIhfjUNlbFg9pMgrQNHU+bT7sskxmCYaGYmiUk/90tHRH4PuWSFW5sC8MkRo7c4lO3n+HgCH2Y4mT1eV9dmmO3iQ+ccTcaCafmP8R+wuGHge3xpNVC8mROVsL2QB7cnVm9xALXHAWxuFInie/+fWAEKRIgg9MScSFsMcyngA42b0AxsuLM2InXpYMbrlngNHEz+8NUzxsQXIM7rmHOaA8HXydDTUdFen9WZowPsRPewDP0CaTjSzuFh8YmPzssiteqDz66jKDCY/eiwRhtfXZnvjBewznyVdxXnn+/2hz/Gyyhx6mOmeu6EvK7V0xe1fs5KkmGwBxsMwAy8nnsjz0kIlSOflqkeb+fUiZYHFZjluTnxwdua9vbPqETxKJwtwlXwwaG0kWr/0e2w/NSajU175esLoPpTouEB8KzUr6ySLZemjAPxa5uluojjocJlgD6jBq1NCnMaRBbkCyiprHym7+4fhD8xfRgXPwSEZ6JCM9kpG+qWWkgxPJSAcnk5EOTiojHRT/4X1kJPZcPZKRHslIj2SkbyEZCZQnE4bDtGpAGEaNOjiBZAQSmKcejj80ddEsessRZ6MkG1ZPFhHwoykwLQel+douBKbtiCu+tkAxrbu4sRzI0XRRjV4g7I5R09agY8m+nOzQ8EjOQiVD/Sgs7QHC0r5zGMOo/TAqzdc2B4q8W6z42j5wSMVfPDv+s4X4DwoFVLyq9BwG7DuefrMANMAAcMUNAQcMAsAxyl51iJPnJAAbI9fsVfiW8PfVal4GR79hNnxZ0bwNQjboN8DT0ONgYXt0CHiFvarUfH91MbB8/9x0RfzNkfjrIwVjtkJeAgPP4IkSLj6jfLDe4FenCMFMSeFK5qqS2TmdfQPRJ+DgeeNYp44lg0LBXg7pqYHjKvFxlQgY+AcZJ2x0AEPWyt4TetzAFhGN+TpOaRx4qmH2C5dGdvDQ1y2txLYKtmoHpIkzmorRUpHvfw9fw4CeGDq9pxq8B18D64ODpITd5b2RwwQeTYa3nE9XJpV4P16Aa2DgxYFfgUK4QlvlUCEaPDyW7afFmegI8vRl/mLXiXLn+rUaYbYwv8K1Aowid4BppWKv9cJjek88D9KsEAcsVT3LY0Kta45N7Iazf+kj4qrrbq2qAjwqcc2anq3A+rpVHsKum6NXPzX9QD9m7LHoX7DsXxgpC1Pf+UzDgcU5iuuc4tAk4GTJuvALA/dBb/SKUxWPeWl8scdszNVeqNm6a2XFoWF3i8fjcjwvZ7VV8C98avxY7xWE5vl5YKtRwZY+4DYMDpfiiBllZDAaQlJb55d9cHyxx/tMP33iPOf+sIzBD3xg/HR/G+m5pfkOi6MTh71aLRt9cCKgHxwD6AfHBfrB7w14D04K3oMTgffgROB90Li8noTxd86OPyPexFm0ci/5f3euX6Oow7iO6yWceUzl6fsyMFcGQ5gHxSscXMyz4hdWxM+uRE7ExBusgtk8FK56EBQKgpjgjr/2XNUxbMjLeCpOR0dFcYQpyLWij3Atbt1mGZmUHwSNSEpduHWbpFaHrSI2VbIyBz5R8ZJyfCpzggaMQO6Bfqssq8f3KuR9QD6FNavmFVisOPowZARnwE0HrQdRRuBXUjY5Ahb6wq3bocL1hR2MMgMMJD3iiZ5zfmgnoIdmnrQGZp9zcpzyhjEqkv4EimrBFz0QiAYyFzQsDjIxyDzanHyv+MOAJNzrDcKfiPbXWLzh3DI3T7A2LAoQBGi+KDJhA8bzCcHI12ESbddULDlQRPpEPKFqOhxaRdC8mo39MYIUFEKP97TnvLSx9uKqAqHkLLQOY4PFEiAjgpYEZTgVcX8TmMlsZ1e8pCyrUArhD4gx0AYxOcSRVU5h3Lo5evXT0w+9Y6RUOENynNGz+DNGUiEwhaJhPIIkoZvWpSRRUH7+zI6qKfC4DPKLxSo+vltsL9Ax29GxkfJMbF8cmF66Hz1aMFLmbvGeo/IUR6rBq/rf9l60omo5WVW11HeL9y7QtcF6mrvF1WVkTC+QsW7lV48RW6xsDtGvukSARPMGY9ehq9Ypcnt388prr13aoeDslwcG6KUvOos7YiRR8fJu8dLgiaWvW1FGHhzniA5OBpeDk8Hl4IHhcnBiuHypeEqMlcdV0Vd9j5h/uShOw2F+ZjSO6cEIWydKC1IsObdlf0M8JZ7AFUQADnIVksI6+Yz4FD5uJKRgPEd8upVmJoPWRHk1goDwFbONmOhWIcoxOiM9h+5Z5iB3bC+fwc53x2Kz4d8HIU+Pi8fw7hrEUaqcmvDZ6U9yYvDzR12pdWzz9CHzv7My/gPiO6K21syDcUlvA1eQAxhCcUPSp4MqBa4BmsQslih8cDywHrLomPJ7XtUcUXnH4Nz5+8lVcck21jM6BDYH1YPZ8lzbIkWUQY+Mn+4+Ls61vpoRfB0+3tzfWhpY9l3T3xiBuIYGZ9ZKRcZKx1tRpK1BqII1Lhk2JPhVFPe/WHQp6e8WI9XlAn5/Ib5QaMnLDV6CCPNqCGNk0yTAuigQEBigAWuYMhzjK0HdMzuFYqaC4XSrmPClZNANQI4lQpNxzLHx4hOc+3+3Pt4R28bjvD2fpubIvIDk55pNP8gN/iPiD3GA4czqOhjvkKTN9yeHc2sWRTxYtwZrM97ZcpAMAAUL0cAcVosj2PMzTfzQ0DY90huVeBkvsV1amvIziatocT4Uq0W3LzQNHqrm21tio4bmV813z4kzjZSa8MHMl2LCO9NfG6SC7wyo5VL+l+K97Pi5pUP5R2elu0QGQMmM518YP9cbaO792PkIKA1QB+snovc3URrrJ8/2PmODKM4kWM9E+m5Rn+Sd67CnZu3jgXZ58P/DLg8efJcPQa35kfXx+8XzIF41W6uS4G6bQBkvkCA397em6+KPF+KPFck8KYlHRU1AIS2hYaEwStBIsbOYiU1AkF6DbUApKiZZIBvIpoMllWPAEcaWCisEmORr9iJVKQyYxGGeukviXJ+8X7zopcWFcXNif9IEirWEy8CGKqVzbp8X4xoB2bwxSueEdTQJy5uaRkTyh60PL96NV6f/1kr/4r1vmUDZj5604ycPn+6CeOE5vrZKJ3u3MPd+DEb3M4hlgurgsoXCDFBp+MMsohKnaB482BIPHmiJBw+0xEVE1+KDlQSzczMcuZmHCtoQW6xhdoM3By9N0tPmya7Fn1hPie7WH5Xo/keJZ1rvtGql8UnN1NHFkLM6K1ZWoxaE2A6fgqiBIGwalbAz49rBQUag5jwIDKzhUTJuFpPYFKTz4A2LadONyb8svje+lV+MxIY62Zz57kH9c5z/DJWcV2Q9LNhl8E2079YpJxtyE70iUJEFFa21WrLie3xvxyXxHJZlfVP50Pk9ks443fgWcn+cF2NtvYEdyFExhxs3Fhwhe83AEaKtz9iPnHzr8x34dnGTUa0Bc6kYwTj1BCYIhIHjL19x7gSthHEPqcnBdUh0svvx718Yf1q8Tb4EWnAzD3VnF7tK+ar8V/ydDWKhZdERBYjYbnXH9eRj8X1j8f9uJTemIvndIg3Uac1Wjp71ojZGk5E3yZ7Acmlw8YPWlr0SmlzKnjcLe20aajVZIJxuQo2XAIRSV8FpFzwwSNehLpEZqpGlV0Uw0k1iuoakhQoZAzPKyNK6RU0n52EOtdpXLl6fFtELzQxPerJCI8sQ11pxgQwYQytHczTaVLYMNZ6LaWpGl85zghEETeeDxvI13lOZMhiVsx9sfH8yUGWgAHfrWdAavvlgkBmpdQYQXm80coyBIV2Ok2vJe9M4nIDTnmiS1HDuOVciY6mnbumc2wfzJTmfIEezIU8TSwdeOqJvIEPkQ22lqjjniOgJHEKUhoGIA3hHcjpVNCQqzqLgqBKYHqGWkZnMszpL9i9MAtobdAt6yClvUI9A6ej1yeKGD5BlaCnLkMynzFCiLk42T1BxVSvAEMdO8KKDT5gmNak1mmFtNIxls+CQv4XZquCQxuU9O6EBVQAMWEN7tFgpJsQhlXWGB5YVAF56fSSDVTOrS5jToNuDdnO+GFQMTYOR+4yIBpUIGJ+R1pryUIkE014V7RWTxKnKYLULdUIQaQxs1wFBMTOFtG1290FsgovEMUGldQdHiYy0eG2w5dBojrVxDCVjwcM0EpMj5iGbJQIAU2IuGIRBoEKlbai1Z5KtTQrrwgXke1iXNAcs7ZjCQMmKVzvPxMvDN0j4KWPwB0/T6F6KGXLjaLeGDNYq3XKEu3BEhVZVMB3kVQmh1M7UQFcEynCUSsRgSn7DlKVKwm3Lrj/4mV0zi4xh4gQORLWc0h+8tZ0CzSl1uIJl5kIIcdi+IM6ggptJHywpbWDEyw0gIVuyHND9wAG0sFQj59HDTBQqvINl//HN0Y3Xp7+13hd62bXDUhssGVnsPW9UjMBqLTbEculXi4UyJ1UscwJB+b8sgIIwgISc6JsKB+HaVqFNkCIqh+PoZbBBldY1LgLuJ5QEgJivjlQ5twxnhIongY8GSigucDoh4EgrCQ5Ir20pNoeOtVR4JkFcISk0i+nlgjG4B4mteH5Bax77vvG5ZKJEOmEeWOhTaXOJJ/+t1fHT4jHLLFcllgsLk/hO8YlaDgs3JMcMGAp9w0myLrlG9jhPTRGBrZLmlfBic3JBnEFUQHrP1vZj4lydS+OQ1L37isBBdNI5IZuk3D4AFAFJeEcroUCWoZGdZLi/qc+JraquGv5zQRN7Y3qpj5NqmQVkqMRArqhlaMpk07h2KFrpKLX7TKsR4GVzhOWicULf+/l1ZAbWC2qaOdajhV2KHh8Rr1SArQSLBqeLuYlR2DHxdcGixBssTyj8sFfnukYn14h+DjX3ziIMM+auV/P9TfHd4p/TzCptg3tESdYOp9ug+E2+yrZ1wDWH885DiPPnUDtWfyR/yzxrsk31UIBUhgNQyLYKcpF1+vsUcrnx5vTXuJALW4X0sQC/wbs97cX8H7bG18SulJxJTPIwVn/HowgWiBv9Xsego540fHa6Ol0T3yM+o3sVsywn31ay8wGwANYaWDFACmNIDzCjq23kcSgKoE1mZpK68Y6JEXs4JdNKuvNNw96CTC1qUvxDk6yqm/tb28+Jp3A0cV+dnZ1CV87unhEbXGp0f1OfFZtUB+yOO3yP35r+b4MyRheHMSCoDro3forv6T0PjAkpxh/hdLiwAD0evzN+Is19VNhBmvrjy71YQ0JzueWoZT6Iaskp8HzHJFuVDHUplz7TPdp/hjaKV/Mz9jjBFWOqR9LAPnavrJjh8nKKy8lO6eCEp3Rw/FM6eMindPAAp7T4TPfo0ad08GCndESE7tJTelBa1uMEByvECVC6IYsY4l8T/wrrEansBWQI/IymVxLeFcpPwcYlJf0tyRIB4ZpjcSORqWvIagio812cCwjmrduBg6FQRYUZRCs+xzpuMkBAjIzCS3qxyXYqRAjOStQMpBWYFNxC5K5XpJRdoBfEuRrRLJ6NFrtPCAFqWAH8e0lG1vDdQPDy8wXatj/9H8lNk4Lq7thDyC4QKgnpqs563UM4pL9fjJ8WjwMomjhlMCrJbeKiOM9B0PzZ5Fkqhq0bYqtsxjomb317+n3s6b2XYfcQEYA73p+etf7gaPyYOIdQeF93yPiiuMxFTmCZJUME9HBDMaI214N7QTzPzlrSez2X2FPm/rtHOT9XRcMwFeO7cXP66yxjuJPAYTOtfRESQClFKHUCcPyF0fh58R6XSnxDWWoCJN5cX3NLXBHbruGig7htsIpwHO4s1BXfqs+KT3sypORbBxU5JQCxVCchu6SCQpAs+ZZHyS9aW7OoxjcKkJM0B61xCWx3xU7DGjQbpSufYmRRgoceTpEGNz42/eXVTnp4CDfnj6VUAtCII0z/7xeXrQUpQsIGfQ8R1rLnuWHSNN2YvCw+YK1qZFRhAj9EkShsw4ZW0aRqk49SCI6dQnDj4/e2nFurMrd7t1ixVp0GKb5WEM/THCsJOrohlHhZY4cpO4AuSQonZ3UmBfgxyZlQgI/WdHFgZOnU3SNpzVkxRiUqvMSB0nz79E+tLSu63+MpG2zReNDrkLb9A6vjF8gNxvG6ScxnQxeUlOmG+Eoh/nKRbIv5NlDIsuO6pT6H9msoOTDFZFsafg2mgc2QE7hoDrIA4XU9s5sLVkPNhMZbexjdHBt8UWSNfWoWgreGIIu8HQgiiDFmFLc0t0/rs+zsRnHppBdPNyaoLuqzmst2DRWFENg1tu7BED8x/crqO2MJCovAyOsgTBtyh35HlvmZJMOyCQyxUGOud2X9bHdDXnntNSrP+lxUhGpWhFZBSHtfc9l4M2AwC/JwncefFhV+aDR+imQfHJdKDnWHe3BN4HCMggCDbYAwol4TjPsyuZ5wcUBEHMdF4Wkzn7wt3qJnIVQhYrTmRBTYH4NVniOBOTxXQUhH1AzizZpWIiM3TXXk/UkH8x3T/2W115Pj3WKNqqY9MER+eTx+R0wBEZUoKqN+K5eR2KFhDj0mODabPo4XvMppH+J18WFMpdhODCjA7wACDaF5j/NTEf8NqOAnTGi4+TzTeLo5+Zi48SCzQOPsSQ0oOLs5RZMQDl6D5QLr3H1OPGVSbwv4qgbf6lqAJphklDYxDJoDlwMVSqxkYg0mGwj2xwtH98lp9c5L8UohmoT2QB4gZH4gfoTENxeapsR1g/VdIp4dYsR9n8C1URIxgSXqbo2u6LvFO+Otzp6Ly/c2kFohZJHQ2CSflIw5P9D0ycfkAoeiw5TtOFQdY+GhUMErebf48DJVAJbwx4yULFhJOJiqciLoT43fJTEV0P23j5pgHadfzSY7nO3FQeeRcbOKxCX1oOqW5d2iGj/dpyJXzTI6cg6PkvG8kdkKwf1c3KFVFM3d4o8MYkN6K+1iQ649KHpXs+1VhO7ujuWlLrz5rcEb3bI3Cih/WV6hIzw808Fpz+fggWF68HUH28FDA9tDkLx/qAu60UdJ3v9eIX6g0DaJuJIiL9PqcoolkV1cx4pIPBkEbIocgyhivQqOE85hR0VdVJR7lUQZrCOLefRIAbIN5LXsHaxrJlnTjcl/Xoif6K3HISMvVKAtoXFJqjZ5fWTFJtWIXNYQTbBKiDmpoCc8s3DIQW5GtCAzl+CRq4xyhcbOYoCtrig0iJ3EGMMidQrxIO2rbmadQrJtxMvaasOh3OEYusZ041tc2yBtYyFh2ezVA21DW93XNrTV+SKcE2e01VojUNnOT3Yjfmll/Jx4Ahkz2XGQ1Y/9zem6eEWwXxsaOO3VwZwIT4zvleNE/jpwObnvILZN1ydPUvC+glzZa6ZwX0HqU9OvsFFrafDjJQ5+rGYwxOesjN6gHhHknNm4wpP6Z3jlByd8TQ4OPtlrHpTCpcP8zdVxLSDDwGECT05LRSVkkoY5ih3KCqTb5EtBYNRY/FQhfjzpWCBzUG88kTtQJ+lyBRuVEvxwnTuzJugaqqJzQyfDlVSjoy6HZpgUmWFbaZOcQASUMgn2bLqHSZpyrUIIb91Kn112+xuTZ8WTmE0rzjLPysLmdrRKEghIlGSsG2d/EXBzgHa74qWqYeMyUuv4pmPDSfvm9LfNm6Mbn53+u2yP5FQvPtZe9uuokYu9L+wgN9VyNV0o7rn5g54fjV+rOMrcxM7eY2Qh8zAzYPILw+rTYtuvb4z/aCH+VaAZxTIzjWuk5lASDpDgKD3yLpIvgqL5or4NXCI0aDkhKT2Kgey1rPqRf46b+3QuTGgOa+LLhfjPCj5Cl8oLgIwTsefCvOyc5CIwxrErEw5LcMhbt2PCqOKSKilzp2OoKaW0exiuYi5xrtMDmksuy1RhWML+WaZg8i3uCBTbyWE6iheDlY5fwb1gbL62+1vbL4jnCZspsoVBiyunGcOr+e4T4iKUXCW7M1goH31WbGrb8JQ3Rzc+N/0no3dWaiW/p6g/vyZDLa9/sVit/w0lFzMVe5R3pZZye7UmC8EHh3Io/1jwJvUf+OligMF6ETUhDSocgGuVR6Bgm+TMKgFfkeQSI61gWnazcvLp/iMAH6jHLJB0o92s7HeOgwsGJnHAG915yiOn3V6rL5Ei8tFjRVNwQb6nFRc6QvapB24RLZvl2ZoBbzD3ng05NbZ79uUBwMk7yeDsAXyVcuTpiB6C0PyLo/F7iNqCpCJqTWe31P7G/hnxcfE24zF0FtwZH+AHkSVicV09677hj6veWM1jM2VHCfRUfJqJ9fY5sZVjG/bP3ENweGf6OyvLBIeeioWsCZR8OFxHyA5SBPbyERTy1KRyfXxJTLBrzX2WJBtrTILjWPxyIf5SYsDSQuuYeep50AQngzccNOoRRIfuOS1YcqhzBGVQgGRABaEavAXfOhkq7oIgfVCo246InaoBhbWJFuJtqp4Z2EhthT54bA5DaLDVwZlQ1SDCms2krfSzKqgGhfsRHdxQm82eVAsfxuSbbUPb7z+W62R/vPvYgjqTyfBvFuI3fn8qNMxX76HTBK/1AAw3HhPn/BAMN0evvdrTcwr1w0WxeLnOik1DRbpADk5Gqr6wNv6o+NCghEY9iOZP6nuqnaFsv3IGRJSeXHFW/GAhvr8Y1qqAT5t9cOS0VDXKzQWN4P4mjYJYo2dB8xhkIWrgfNUwr5Eodcv2MlxXioNGf686eKjWlcT1D41rHpWBOKIMxGuvnaIMxPmdNjVpK68E+YVipb6iuB7EaYn97yDL/qlWdZZ3BQ00Efot8YQQSrO6jaA5+mZyWbxQ171ouFgFE0GO6UTu31Dutden/1fR+arvFrsDzeKQtAVFYFSnmsOXj1JnIIP4hXgtPV9gnb0puQTp8SpP5Ho+p4X73y3G76Eok+ipQ9WSuqo8Y8ymOCvGmpPO/XxyQZyriGJyXTgG7RmxhYcOlyFIEH5z+g84CkMPxDh9hBjHKv4a1a8+7RbfBWo9o7XOyjy8HHUyX26Jx8QFjeSRPfb7uPnkLfGG1Bw1AVHaUzoTU3tcbTjAOMYAjMmiyIPbi9p/1fXEYgCl6AqG41IYvTX9bYbRC53ZZKmkWtRHaL/DYasQPU4LvK+sjJ8RAlcMxRkSV0Jv7/2zohImNQiCxQeGbvLyotcj+YN9ysWiCDKVID5BR/WWNET+pGvmCgq6e7HfphOfaPh6UZHfJpqwdQ2PVDU/ksG4Py37d1nd/zJt1a0EpeNk87vFtw1wlJ85Cke3OA26Ttf3Q+PLhx87PMn53iSXrrhUqvNLBZrUDneaD++8GDskXDDQ8jECEh7Aa+b7Z+/H7n9hRF06Seu408z3x+IzgrrAoLEOLZzEPnwS+alirxUSbcgGnnqOOVbiSUiG3gmr4axKOvvknDgjW+W4G/x9yfDb01/lCxC1jB600nVwi9AvNLJejiYpg/Kmlx6KWvJLCzEndr6/Jt5LnXAhhBguH2tBT2bsTj8rxm0efl9AfHT6pWEkiT5kTnhYkST/8XnUGVDsefDzgJIDDUrNDe/6eP+M+Ecj8Q9GQAvV1BCBYYwzysbM3C6tMRmDlJeh8dyHTBOlNCx5UwxH6EyioL1J3iKZCo4hFEb2UpbBWw+5owx11945NF1wKeercvyIZF8LDy05HQkmp/wgW5sQQcerL0M1nDsVU+EoO4RNsPTfFQwNejG6ldWOpBZwOCfOCu6rataZZ/cMpzaRa3/GlYNgXCGxjW/45BHMv+4w336KLIJKGwSNJ5aUDSgXxdjn1nOICOHLe0ZsWMtT3By99rFp/Q6AEBuDpaQuzW3BYmQHpIUGGoVKSXYo8iR/uCjuFjvDAqDPKoYPRJSgVRmg98hQ1Ri6xOEPIrGiVTNZ1arx0Z2+WI5tqdQwsnbgH1oqgWwAe4xnf/Pd4qX7jF9vuOrG5+6/ANk7u6Wn1lPPgT5lWkQ5tPItD9Jf9TLUR7gEhiOR4PLy+LnDU3aLH7KWkZcHxz+Mg2MexsCLdozDODjuYRz8Hh7GwQkO4+B4h3Fw4sN4UN7Yk5X+i9H4WfE4jIPECAPpmhRkhezNx8UFzeTGJOV/8l4OleCku16rc3y9DVUpTXIPEeDj0/+TZSHetR5st/uxsOu6hvh6KZ3/kU8tSp8b2GOo64cAs1/8tnEQf1C1KiqUdara3fQNG0mlJLeMz4oZ8gI4ZSCQDlrhaZA+oNuhJJI7/mrDxdpAiffPwkkkfmVd/JV1UHDZZzswpKt6toCvCIAPWnE2+uEvc70MjpR3KdcPLKVycDYRIyB7qCRtpJoHp/hjKoKqJP7XoXZIMO8epa8ksbvGpdvB0cocz0PGLqWyJ8eymI6SclSO0+F76KDwdHLSjqPxJjnHkiOnNc1MQUuNRRYaNuJWCtmDJYOq5oiGbLnD8uK5mXhue6wil/l3P0/20ciL6aPguqIg3bmXaDzKUgQbSqlceIPh4IQaRh9y6eXq5WmcJhhA/eAsBi6aY4EM8ERyt4nsMcfHFrmXqYg8V1mHY0xq2PAkhx5lsy80WEfg0SauQzP0pAox3oOastacLt2k5st4xZ4B5uLOU431hivXs8sWNTwoRtFBOzCwQ7fcOpXGIbWduxpHf2tu8EqzKs71btEF49btrvMplIzB6fmlp8e3Llmmu9Nzh0/P8emhHnjZXS9crsm+eF1VtpcpQi9DKwtaFHgChPUsZnrEZla5wHEUbev5/pnt95LZiUsPKlTVKJOzarx/dveCOKM8VwRDhIL+rZH4OyOFbbGdOzQ+3xrLj4bGJdmMb1LvIrEuyhg3QLJ8toRjuKKt6qNXD7WoGvIs37mIYQmzapn90cfAJyZpHUapPkoRFvr6KBTCjScsUnYRfeoTok8G5PCwF3jSt08/887T3OmWgxcqVEm8dTuaW57aOfwNFQwmPvZUDN11OXR3DS53f7f4Pq5BwdzJpaoVkGCfYPTINB+tjifPLX4a/25gti4nLyrZ/7sbRp+mj8lYs7uegva/XCzzfF4izyeOc2IUR3V6+GebWViyBlJ4eqHFkzZdxMVvWFhKykxaHvsW1AIfKvtPenIQN7NcwtUeWvJ6vDh3i7+1NSjvMQDtD47ylTjqQqTrcOQlOOIKHBv3T4H5J8H3IbaH1AkpuAY8mY5i8mOjpNx+kxKJ09IIunn/1ahGDOOML5grwzc51E5NWvukaPLn++B7BL9jw+8Iov1jZ4+igEy0px39jYQ1GXzuS4cXyHUiupOfXzv2lJ5NbyieyjFvoOOdHC9tsghhBA4WRVYgOPle1TM62MgCNbtsMRkXb8UDjtEA70WMTDK+YUgUK7jPvrKsE5CdjQ+bpU+Ksc811Qy/hF+hqBwYx7Sj6zEXtoGvVadgxLhOUAeO74NBVFv2xzb53KXlwjg1V5bloL+Ggv58L5YvXpamTKLpI4nu2BLdEiSefC3iLiPuEsznxiEpxILOpivC/nuPwt8IuLv8ZnM00ekweiAu5nOJ3yjqXU/4rmRJZ/OINdyfNaSjuofAfEQ8xdC8iCIxFpKrvFv8SQ62ZX3ELhv/DM6S3x9xN2JLeY9vrinJhewPf1/1UESVh42qfb6Hrv+/Vdx/T78Q6/cZiohJphXGG+2541tnSekyDfAZanxnO0nfSMJlX/a0TSXj2Ilk+8YPvm3wuCxYNMBmbNXkUww5ddk5CkisuHrioT0/N9BlcqW+S5zZyMWy7T0gMqrloVmbASCXHvYG99099Ky6/7PrMXw6PXVE6fxuhqE5dxU3LzcO/gZQlA8eqZSPVMpHKuUjlfKbU6U8orvVUbLCwe8DWeHgW1BWOLi3rJAba9h7QOSwrHBwCllhsergcWSFIxrQHEdWeFAvbnLh/s9r4w+Iy12nrkZJLkkX2N9I/m4ED+6fmW6Iz4nv9FJJl4qs4M45Sa6BgC/6H9P3HKvjJfeH2qu41UzXQI3TYf6dQnxfYYwxUiWfk5kHfJDyKsjNxP0LuhggA1sBYvDYUoD0kxxEVPZ3ZDjhErUGOLmDeBTSBKpc4wDZVKqXTTURTynW/qilAxfmxndLHfqfmDbvPEulUiy3BbPBwawEqkjVUZ6RO+jADIrYH1FeR2RL3znRK1BUcDDJoPzQEWPPQJWHEhga1RvfixbpPdNrIa+4hfzxF3FwwkWgb+4Ri+CrUagHReleYMJfWBm/HLtC93PJAGttuX/cPBgpXQp+3x+Lq2JHK8lNqOFzZHqMHFWEBmZ0hYty8hHxCtiglPBmeycJObooPXT3BdnDDYBuhWTLuu3KGu5vdmHQwLkUBp2SLnJCFO3sxgvivUByYhWolxrf41RuTbh5c/TaJ6f/jHL7Pn95J+HrniblTiEGIGjJuUhNU5YZe3r0iQ8OAa9VWm4iUXdTk0Yz75+dHSAQ5VWmJ05Lmf7yyvjDQnKuAmJoUoUmZCEFav4YiZRDxnNK8uX0pDFqKotb4jM4JDYUcQqQ5RaBVLgIIg6UBEpnC1U9KASAYCvLlYWqVEaVlDHMPnlcXEAuDs7fHHGqtBOUXq5lxZ8tEItPT907K0o1KYh9b3ldfyh+Z3g9AAN3LkMBulzRHjDKg4sqD3l+acGz1dwQarFDZH+WFKl+uqM8GOXMk9ymMLWERPmyRlSa4V3Nun7LKEtL+clQ6uBohrIBxEzh1jaV8zOeNA1qHcA1s9ywe+bh+KtnqHZ2TJAOUOk83+6bo9c+M/2HxTu9/CCGjF5WQXiD+x2cFkg/sjK+TNcc56uCp1CiVAKM6c7G/qb4F8X3Nqk7D2cngscAQmVASASJ4TCUWi6PBT7TghsiWxxcoapCrCYeNWSDeKEQC2mCWKF3cmyHwpG2kx3xUuMgxjNpxAJcygVBtfhEGQcB7xfFWZ9TYZCsuID7n+VycIsI2KNF6y2FMy/jXQsSVdOCsn+pOCs2G+eYTH+5KN4tVlGD48GP5SGnTP4nhfjRhZRJFYwkD3Ud0yFVFawPlYvOKsQcujIlT0rKTspZkwqG4qrmRt5NThpWGuELFdc/QuuiCpHeXZttBdUJR1OGxtWPkiiPSqL8rm/QJMrfLcYTImCtJqSkal25WaRAySNK0aEPJk+LJ3FllQwtOQYPk0jHPU8hKdDl0TGRLYeOXRt3DQTyDf7c9NdHy9JXelxrA7VCl3QZjiWwncxlj04m4f3p1XFJxaNzdk7wLMGDZN6pUU0wtw7eFS91OTuaOz21qUZyLjeNgP6JES9X0nMfS1KMyN7FFyfmGuho5OJus4dy2p6N4JYojwmciUmW+EqjQ1CrcqnJrWsXxJlKMmXFiBsXxJkGVps0283Ra+9M/zRXbRjqlt2PHiU8T/Ub4BZRbq+aLYYVDx89HFbc+MU0q+ETh8KviyY/YI75wCIuPCOeaFiUqvoNbrYyTjACQ+hrYuZShcyl8n5o8vNPjv9aIX66ABJylR2FKtAN81mJtIyarsOt29fj56YCUfWoPyd7AoW6avjBXEkA6Qs+FWmKXT0UhyOq0JquA2TUGM1VlabA4bBdzsyQMQ/uS3iGgQqxzquLDbD3z+2f378wHU1XxD9dEX9zxVCoNfAcbV05URrpXCblv1rQWpKfTQ6uxp5RNqLpVpRTAFUkcZCknGdzGnmCkb3DRfyqVM6nP6TKMOBWcMN4XpxpLrcNYS7NSk+y07hlXzVCHzAWgabcaQZtbmWuTNRLQYIdCUOR+0NDPdso02XtnLtQJGj56IYNMuj5EXCmWCaUsvlSNT9uq8umuVu3F9zjHoSDK017JhDdfLSHZKr0vFjLZJo304cCZBxujaj0/OiDn65OaqEVG1844QBrU0z6Q7/yL+tEnLy0/aw4w6oJvaQ39e6O2AbOmop7zJD1olVwczQdmRvvn9FOXFa1zC3cqZhBxcgHqT7J9MPVX8vdZxAPnNjH629MVT+B9+XxY32yA9zMbOQ86kbNgmXkZcXlt4vxY31Sg43lR/5AQmrDhmKUotYI/gcXsB0eIx4CPcws3UpUa/GQvXXLRmtChRYEhFEJPZJx5KWa/OHhS1JZtHvMjAfgG8ESYJfH8GO+jXf9i6vjx3qquwYJyLu+nYqn5TuaC6YEmzs7V1yHE3EDwGosouIL08P/ZHKPZpHQpkVNfm6l9xrDIKAJkSKeukVyaEeVeW7N2lyDWA22OoMEEojgfohmYWM9G55lghLcy3zVyKKCHnB0edHqpGbKAAWSApQMkxGM50J7ACiGg7TFl6RsCVY70b6OPI6KC2rt5aLnlINB74OUpxhwynR3HPWcYWLBXmrOiydAgzYQvDGaa/Dg7ZitPBHMY/MM+DcwBWBuK8g83OwFHyBhAi3Pg0MhoxJAwDdo8djMkK3sctOjF47SuFaUqXnM9vixXoKbJoabhhWGB10Z3EA3wMUVVathM23TJXjbOF8nuJwB7TIIzQgNP/Xi0cb9NSo9OVxpHqf0IRsGhJR+vl5PRF1RtZusqtrJyRr+l8NKHjxrz1DWrblIzZ2uDKxpPRPKuqpxbjzspaOGbSro0xU7OBA8cMRit5RrJeH85AIzAvyB7jL8KIvivOweyEYqgWKhPDKv5mo92Nu5Pi9Jk7MVkDegl1lwN5RreztZakIZvmmkHNuZrHhO4SYjvVYBQVJzNkWItVwoynLkrpgo3CSV+VOX2V4On8mS5b8u/iXaJ2iYMkE7F0zTRMqQBDVF3YlMaZAjZmco61nVM5hRGWyBToLzdlGuTQalYBREHWBEu9y63TTQS4xhPePs/rn7ya5/oxhHEyNqDSQtaX9TPCOe0txLmggVKCk2PJ9siY2qunfbnMR6b05/l7MO1UDYP/RjKLuvQow6re76xdH4vBino0n9ggA9GJ4hRaAw6h4XfLSpHIufT443bBudeyR17tndyj3wloLho9M9QIHysE9ar2aveWBI9E75y6nZTS63XflqoeR2JUzlU6UO6keTWCc8VzaJgjD/9Xxu043Jh0TTPQjc9WhNjerYJOlSo1hY8BRLsODiPj/+qBXOcVvhvH6oFU41KE5d+Spb0d8tVipfnQZl/tw6F5w8okL7r4zEV0ccAztreLUAQJMwgWpnwXHBnnroIJqEGqmjbY4Dg/e6sgwc8k5dq/Eo6yAV6SCIme9CSyhGt85qWGrIo/ZiZAd8teBg7O5PIQJqrwtv54TWvRQzADnQJ40MMSUUWQLNhwdDGWMJEa/zLHZyOxOZI0ewUZvr0Kb80qjlRZWfaqSy/TtndjLGTDdQsRt1ETvAUoH27UbEVrBQnJTtMDCVql12dzCn+xa/PvH6fGJQ8zBflLPo+RMY3Ke5Mb+6kuqLQg3PxZS7ymDI8bdCN1412fDHIQKwCnDXNZLsI3pllvSq+BAbky232Of2zWRbB1RbNpRU3B2/Yh/zEcbDx8WFWiJQqk7dyPe39MVojNX5E+ZjMBZSCBc/fXP0+jvTrw5K3FUnY2sA0LBHMo81936ySDI7v+/Q8KVPrbW5JzOf+omPOZ3xz6yOd8RzKhc1SoJCst91jX2mG+JJcYGIdEepqetULWzjbXJZuTlZcEwDdXzW1gPHRlsPzvA58VSOGQkKfcXSiY6XFuSlnol71awm6cTO9zdvjt54bfrXep307lOjF6bdq/fwisEhH8dlT/BxShOmY3xp/HRP0D+iqgdqdbx41Bo4KCCu4eAELz847ssfFGV6lOHvr48/KF5CMQe0gyJ7Re7z35q9ekkjgOhMQOoUMRxEHdedgxrMo07jJz8xEn8K6aaOS7Nz8SaZ/kmUGsBLOOsMfJqYBnQS1GTzFKrEFiJi2xiYXDhlKsnem9CmGZrU5brkT1IEFtdhZ69tCt1LNTe4/X/d64RAPmGwQKzSMpnH38xXunLVxIVigBQXdmIQushhcLcsR/nV7F+mcqmbXMA9ziZpagSiYQ+p/NXus9S5wHKkWhdAsL/JdwvFBrPHGQ7gxGHeeGP6NfZU/eiR+d+yNb470GRkZS6JHCWPzjBld8yTjy15Qs+6nVPeZ/f4MgB0s90t9scX+2h/tbe2x/Hq1CwGQhPyTJ7pfcisO36Tqx7xbdNLb1t1RHGkoT+peBiVYn4FAVmXc81XeCNaxkR4yska16PRqP8vvlt8VpnM24DAygUX9WCEANGVgD4eDNgyGAmOimx4mr21NfoU4BxwW7nxemQEeAWKU5IX3HH1u3HHiyFL7yKYIP81oN8Jr96aPtGn2C904TgDa9Uq9sGU9ZVBiM8gaue5WLufvBOH1s6PHyNuuasZm0Mr9CFrEBehfQiH+8NRn8cBcBFBcV6cdbpm242bT94Qr7GRpUdpIIpX2V0DyZvDz9OtU7lUHSv2qbgq82xo9zUEtioJzfT/jZfEFdDcJrVq0bPQGCoqWDOJ5d61b+xP/3pPWGJYvFts8OJPDJUEkv8blSPHTNshBWxM14UUV5myq9SsDv03JOkGUHBmZRfKZufT9UmMUVIc+FR1393PvvPGJ6c/Nmjpv3uISw9v+Rqt9aEIYj+wxujAlrf9LfHnCvFni8Y3kv13A79cEoa5NKBPsYtRA+MIhDJUrJFyP1PVhSeBNNTssAJFCa3OrkN4BjlPMLm0aIBPIzhwOCqLPKWfT94UrxMKSTSskzKvLe6gCQptGgyEt+gfu3Ubq0hCgE0hwtvnxBncAhYGdyMCG88IrGPMRSq6fA0GPIyssmr0xndOt3GOdwsxLBO40kh3t3gifpgLrcBb0Cxarpce+HpcVjZ78SC9+OOwvN50wlw385EvOHiQFyyi4JbYiIW2Tti960sbbCmhVrNcV4x5wNZ0Q/zUmvjxNaW8rzgCI3rDlerdU/I/QZAKnPTC1Z/rNrrNQGSUgXcGCOcAVpgRHGfXkCPYZGMd4qg0hiC8Mfd3orbf7DL3HFhg2dNMteXwRi4BRtVlyaJAokTq+M3dXenpBuWZuLUOp+mQ5Ii6kzQTR5bhfXwNuY1yMPCOW8tOLu9TLpHjsez1wwLrWTfQIVuHx8de+lW8Ufi1rmXqKxxnIUtFPeMCns6VqdwpfPDRHGQtjFeYUCpuGAhizvEIznGVTXyPmFC8DUGh3F6LXtMEzXnoODs+PGzN0KO05CgleBQnhG/QMi+i54PWnJ5tcqXf+HR8mJ4t46ngIa0rfC4dbYyMPSVekWxYAJ9lUCejguEuidiuikIkepkkJyR6/DKRI2vut1Us6GUjRdWZbQBX4IAmK5MP8GI0iWXc3yXwxqem/8/AcMCuHj2440NBYwPrrJqHwzf+183xp8Vr2TZIuw5Vbg0bkvrCbaaT5SpJkAwVRDss+IjEDerRh5yb6MAm+yEskDPujUbEPQrWdYrr0ld9b6bJVXHJNtbzF4Eli5p7Kd+xeG9+67ZIbXoRbR4/3X1cnGt9FVkBgsjx4bKDePPt6W+MEHQYGg4Vsp4LF+CSKa584FB8jY665hoFlFtSpCpck8gaHLOG9crltKHc2Dp9uanhfHZZaHxsyFZGiroy9X2xpi+2PstZXRxaVEp4EmSV0rWQsT2Mau+JoU+whx8ZCg4GWLDooVuXH/NDB3TyvO53Ire92nQDPtAdOdqgY110vMEs5tGxu/ng3lA5OAUEDh4MAgfHgcDBw4MA32CKmj5PBTqrJKHlW/39hfhCgaZqhPLBJ+kFGE2mBNlAIrdXUVowlXmmpmxlSPDM4pL07CIA81KIzCo5hpOzHhtc9IY9U46ZN158Mjngr4zGL4rnLVth+q26TIpd52Zdu+IlL02XW5YYaCxtWLdcPRqx+JNoCEoBuNZhvwpUBVpLjP8oT9qh682b0z+z9s4W2XKC0yVfZm49cthmMeYtBOuO0OaHFPv0RfF/pGDvbWrFv4ZoY3I3xA8mZ8QWIqKPpai8+dHp11Z6PeEfeFl/FfV7n+Tip4Rgte4yTD4lPmlS/DyClGQdjGyy3G64Z2E142vh0WRY2tDIWPis1kjDjgHVnI5h5pOHNWsy0h2ri/6bH5/+zNo9erhdoh5u5iFkpPz4wE/v5vvrIqbbaMm5WPDVpV4pk/eJ57i5DNebB1+F+sQq60VxvkaeFWfYmfnui+Jyw5GG5D2kOxbdRtluf+v2AAhPkgmQpD6fLK12fnP05iemXxoBKp9/fEdxVgUoEwS7sswMr1OUdC+57bSA+jNR42URBB1TxFviDYiEPsWIOngze8QmcBRgcqylkPTklUsK5ESJlzvLCWcvJmdU05INMVRsO16wknDZ1t3PiE/VJmnexve16q4HNcJJIJzSRd5jhxer4OzAqpM/OL5Hb4tL3KNU4UGpEFmHCl+zUFO5ZD/nU9sU62AKNQ7qk9MX+lLmY0O1dqRk7mtvDvXCQKF2OyjUrhd/DNXJot5elZT8ev0BSmWvR+cys9kHevHBqV+8iJzRhJCrL5+MEf7CyviKeB8hE3eJSLnRiaATH9wUwGepyN0ISXXyEfFhNs3TwNS6kwMBgFh2FpBfgvhOZuOuP+v9yNpbr07/Eakfn9dyRyluUZJalaS/eXosOK6APi+/UIxwxrZrWXmf42HHWAoMPIGHtJCLDUrv88AGbA++Wn6YJ6U0f/yJ8cvieSIlTEZhSVcp46xOBwgb+ioK+043xU+dEXfPJINGYktyL4cbIbLapGhcJhs414b81BC9OEw/IgCoU4zOgpeTW1J3ASGpIkByOumENhxygE7pRF0MdwFOSQcuBSuQkzVmlFWJfEYfbnKscPQXDLtdkXHJPU9IuXNpLmyOVFTkN+INHPMBewEXkms4hYjbaqZSFyoGq0RbYqrKAeoWrQf8Joqv7mcxMJUPYHz9UAtkT7pUEE8zmc/9dg2bi6DUcsSK43qUvKZoFuGYhBQ+E3MkyGNHzAM3ImTbSKvy/DxnDMjOcTk6wkJzOEyss2J5WTk2hENqFleas/m60uey7RrOcOVGwAvvhIeGErXg+9OcjKppnbGeezwuTAduk+Kq8TRtlFA81R9s2BbHR5KgGc/ZZw5WddUF4xnPyoHPFNuyXHtQ8ckoTnOxHFhIOBapPnEpQsHkcbUcjl5XqVaKdRyPVHkO3ITdiRNTUrg45+eS5wrrYujpaFOrMvajYABsWtF2hgkNwvXdLNgK/qT4mK04iAuBC4rTdiMqw9gFYq2xWI1H4F9gnMJ0aGVHgOlyWLCGMigr+UidDjVdlmTq0jn5mPolwPFdBnQE5Bh8nwo51p47MkmO6AXTwCNl0A2MqDDLORYkLQHFBq/K4AAkRzUWPQnWaNFLnxFOkrUP2+fwfczex/WKq3RZHTwJiMFJhrjBlUHBG42mpAz2KiorwWA2ggdArw0WWlts0+W6Q/yPoVXDu6uxc+NqQzD3/BXnicGDpQAGnKA23DcPObde5aTbTKs3ppuTrxbirxZYiXVVlsBg9oP1uFEItEoqKG2fjoYIOZPvfkCYrzvCFOkVgSkTkV6uVLIGVLPhdeQ5arZEcbRfV/qVGz7xFxwHhhvF5lEOgtl+n3gOQmkKr0gBTwEhXkqp3Q+Lmt04NgU247zSCxReC+y3PCm6iVhnkdOMbqssUpxhBwpSnm6cERvO8x83R2/dmF7tC57Xj1Ke0I59sop1Tdbwv5qs0w812eDFsuXpIwMnSI//d+r2Sm3dZLW2zk7W8L+frNMPuzQNo5+TUOc0hB/kYoCchwHLSh72FMiRqXGN4SdoQuWRsz3ZxufxK9+UFdmotQ0Gge2+Do315URijKkrw9/qYJBEDyoFRG4qDC1bSjdprM8pAH4QpTAoC0jLgc8jBSpSoHx6sB0YlO0y88TjsQ4HMBJsB1OUk/f1P+Qge+IycPeV5dDRbQ+BMlsKP7jMaTV8/3qrTNdvtRWfJTpuvQ3e6XTrksSLxiKaCkfEVHBiJ1DfbI4UYwstWfiQc5R0p/3xl4siS4FPk1KLIluAtXPcOGN8Mtn+H6ZIcluZcERo8D8v/qAlR6pm45YG1iQHFXhg7ZMgiL7Lhty4YIwQ89BSBgQ8VAZBUcFTShKdDGraWrjYZWgMa+XTjcnr4iPxfTapDtFRwaIghM3gamYs7CAznP+kHsWhnzQO/a3XF+PQeQDHodvKZB3k3WLFVubEmkgP4f7x+vh56uwCOaVio5tMRQi2wNDEd4lPZsBHNmuT/Fex/xKoEeXa7GWtWK6rwdHBiVOxuDg3Zp78+Ir4sRWOPiNBxTnuV1LNKnjiXFlG7K0cMJRzW+sUyxa01k6nfIloB8R0kitRBKXgS2OMo7iivrMlJHWK3EY5plxBj8pRfAh7rrs4uSSf0Qdayhz4YvOnfOQIOHUsoGA9EFAT7gBmjsJTSH5xDteA5ZMGi0lB+1DDQ8UxCj2MwiuowkBwMG9yqXA1i/1eZIWAbafKLCXDiqZBg7nbpGwlHB3U3srEfaLWO+UNlzI4DcdprsPR1Zjwy8rwnKP6B0mTvzl6643p39vo8+ndZVR7GGowqhKvZBuNHgw+SjMfGc2PcQ7UofFLH1vF4Zw4ztnYU4bX9C7ev70yfl68B2JrY7M1sZa9SOct8bLYc41NtC0JZ0Rv26TVwXNes5Ec5lvPD+xp23RmRjbfLloVDx/lZfFCw2JH46NKALG0wptSwaabo7fenP5PgyjnV04CyDF2HRo0KeSz8yd5/PTd1HvH8N+ujJ34AMiArVHvobNss02tXqhPw4aZdYSfK1urljP4ESs8nq5PLovnOfqgqlWoDRwUJPOrKnmS7i9If0hUxxCkc1TdyeXotzo5+uJObwuxxs06NvZ7LTw9DMkpneKvriYupk0qOslcDBHEFCf0Xxfi5wojqReyY+ZsEmnWJulMIP4tohhZ3fRs3JAcb0NP5484KIgj++ijlNTHJjKpuq9YQtqruo9q/kh3HzVcF0qGKhthsYMJ929n2SWLOrDhJolmr1qIrBsmpwAY9zDf7k//90H0yItHqVdnCWBsvstiO49zS/K29+4fh7LW6s5nfr8ZD04440OgFH9yNH5KCIBZu65UEQzr4sPiFc0Vrhj5knWJQ+pZqFC+TQZU3XnC55MPi1ecdqrjt0mOQQhX6sqsHaR1mZ01npHj/v7Zt96e/m0OnJ8cCVd9UhD9f+y9fZBk13UfVt3z3YNdLC6WWKB3sVgMd4ntwQzx7r3vvg+KFEhAIBsLYNkEAVHc3pFEBnZUjmRXKnHFJt6oLLHoiqmSS3/pY6UwqXGpnMguxeVY/gOpVKwkUuSUy1RiKrIlJWJkOVYlVmJbomTLjir1O+937nuvp3s+diBaZe0fwGx3v897zz33fPzO78wZot/pDa5IcrGuW0DwABEj7k7jVZYIWyACEKVQXgwoibjzpXX/dSdFim+HphI4nbdfPWQGnhBl2ZhenfwVvuqzHQFp/rQEZL1+zrNnWf/zTpYV0XvzJ8130mWaulZwB8EVQRVUTP8wIofZ9srEAw8EQTMWdoDhDF5qAly5h1dYpbAWi7hP+f1h3dIe1SY59ULd6GcOp988EXlt8iu9YxPS78ZQfbk/eNJcypXeCK8t4ccI6jcfNS+oXka4AxlglptCZED1hc1Fgr2ITFj4FfDuRqiyURkarw8fMee0uQ0NqoWjAGobPtF441b/Y7cnX+VonC6v5E9thmZnL8X/qliSgSXyUB3gw4rrSSzJibkdJE2P5Ab8fUQzIp2fI4LRa+7aM92C5ZkoPRZiuc0a/ZR5s0Z5xwbwbhrDhpqJSrA0K6SlYfBpeBn6KxbtopRTRH10Yht129yMNiqCGYjUi3MFUyvNQ9dUnUz+bsdUPaXNj0xGt5byZGdyo+LktiNF96FYf6o/2DJXXXvRYOsPwqyhsSHxF3ykzb77FphkGjsHDqHMXkOPPHzUPIKJEwBvhB4IxuPuWwqFCPvbF8z5svPNXBXyick/5aK5eby7V+/293pPdrRNK+InVv5Zl8bPrA6c2Q5JEpKExJRFJFwT1cyyXtJLE2v6T/rmH/exhYtCod1HFgtwwhF/ysxMiHxGsAzx2ATk+RgUAcI2dm7w2o6kYVurEdmltgtooilyHx85jP2ushuRZWk3tLpKT53cOo0odwnTiMoUBHsWj0PGEywROBAbbetVmIKxaSTprfLW44T6cbLO2zP5hO2pJPKHcOnmLImOyXvIY8c40HgwvGauZCzWj8DBrEQMnJOy9ZBCcdFgHL2GywSgJS3qespcJsEASi1dBfxSIKgXNWBdV0jl9fXJ1Vpcnzk+pr3sbJXf631yUXxayPdc5adNkhll/WzBEJjSJGkXEqaaBqnCgkqP7v1Xpw5aOJb06rE7fJRwTLnfBfNQexWcNiz9Y33wGhdJu6kAFpMgMDjK6+NN8yhKsjN2B1DYydW6at8rKKFoCE7gj2yhdXTB2g1Qy21vmjUp4S8XsPN/7I3J7yx1jJV0rrFS7J0MTmnPqmb+4tos/0uW25mo/W/0zD/sZTmic060SmxzgNBoKjW50OgZWvtz90yqkMXa0hjfpsEoh2JlFYX2c4lpeg1oe+ilQjKhoVWkB56zHAalRWbWT6EB2mTSKsUFWtNpgtBBvVTZlDyRQMzypKqEHcb9e7I2/KPzqg+yGifOarx5dFYjy+11+wM97YXyTm8py+19L8jf6w0eMxdAoCdKv0ojpso8ZNbT1NPUH142l5S1NhOOj4aka7G3sGnWUkZT1m71P/apyddo91xe5Dr102ab4a/Nn5YqWsYjn1Ub/dM+vXDBosg9oIsKQhXWzJ8y38U4khUntAESENuA5YTvMzKe5GrNIIOAHwDyl3ULdxWUnAEhXXJXZVrKldMIH26bmxhkm8DTi2wFluS5CB9C5GTItrbMNdyoKLVQPkMsTHI2esz2ptnAC8n7dGZmYNaDDWr5f3ry88t3lpLE/oE7tb+xMfiQeS5ijlgSDo/n7XQn7/KY0GXP2yVOm+Z7e+a7NbjXwncorbWMvvwGIY2OWz38StKOL4G7QmyyqW1CiRHCVdhhEWygpbRLx4xxwhQ79T/om6/0GbVIIyWZ+myw9tIIZ4FrnSqeKIfXARcvEFGiKKK6Ho4VfqDKJNUsOXaRBivSiEmulW8Zg6f4F5r0yZFVQbK+uqpDpU9YqvB2AOnHLQMmZtkgBbPYxSqPI9bVh9aTTkvLignaUenPUSODbUAZgrSFmQwjcRJgE4lFy41TK1ZOjMFC72yPzJNlwkZL9NZg+EIvWdh0UTbUAPom82HitGv2V7olGDml99iNdLjNHgDBSOIEqzIevzL5yuqdS/IAPsEWIUMgiNDntVzleqdSyrURK0sl0DVlkvh7vXyRBXZVW9DURn97rAEOiKYZbzBXH/aKGIHxHXe7dXTX6y5/oveve+Z3BX3FpOguutth4CQ0hviERJBKIKPA6KpdlR1QI4hlgM8XhnqXRK3QYZakRjmN8pbmuvei/J77dIjyhXgeAr4E7Ut+gtaJY9KhbY54jZpqVZdMKEPOFF4iDOsrwuKJJsl4Peqwm+a614iPTC/csloqkKQpSj3jdK7A314eDM3FgjTlyAOo9EJazX/dN3+zr4XJCWuWI02A9pqQeFBADhaWHWwyQQkH8n0AhNPGbjKWxPJoWanYqQiZRYAKOxD5hRUrx/nBdAJqS1BsiNxeVpQlBjJtWpaC7R/PaMVmzNgIj4rP50UETJOdn3ZmQkxNNo3KmP3RsBSr0taziyZ4uE6gKUn4a5y74cNmc9oeUJScFUniji05G9+e/IulTheH7oLcrbs4yLVnV17ryPbKOzj+SmfdNn99ZfCiKdnSK4vpatbcIauZaK8oBBs4LDAipIOTbp/mG4wUGyifDPKiTQgkUSixSMieUs9E/pi75psZTYwrxQUlBovGSpa3wB20XWp7H824MP2RYLAqCeFfH29sPWI24kvUT7u9ZYYZW5prRQSb8uwE1h9zis+bQQ7njJeLk/365HuW2iHN5waXWrpR/+x0demK3IahzGc7YYQFZzCE2WncQ2GYOVAZol3nQRZwfq3Dp0XUqfssR58UOc2Skxy9Km6d3uAERBfdaG0U6EvmkcypNZczQbA+3jiBjLdU5vfFskb0UMCsM8uNi5lr5qojzhwSCle8Tmlo7mZ4sU47+rR95ta6WYWc5UegOJBDou0kaqfVCQMtssZvTP4/Kg0CdDiq/JP+gfTI+u2lwdPmEvmQuoX/zao0EzNm/BDBPM0siEXR+PwEr3PTRJiDZhtKBbIINVjHFYc6BSjlJMqLcfOtuiISoQ4UoGSocNTB3z5vBiXXZ7YfV2Z0BJ3lRW71x29OrmM0P9tPss/1k2weYU93SHt2a+1mkl0TYr9v70zBXClNjx+Lw4PQuoU9ydpZkRtsrchzzU72plnzBd/4dIvgR88N7piPUtFS0xaaTRLAW8b5qsRMtkkjFwVq5jVm8Xa+3yUPB+ze/OyS+aklRxBfzeedSRCxqJtAwha0aRUA2HOtvGldlhbY8QVHMkqftlqcSKi8ILBCW0OAjSYwE+CZVUMYHbYlCTCIj8z4qjRjatY4eA2Ay0T/m317YeGJQZP62qbJWXkS2DKBCYMY+I/VSuKcF9o4gdVEPjQUvVohQlOntinl0XlwpuaMk02QqXkkPMj42zY8iVaU9KxVTw9voj2AHT0zRzKZqVX+GukNMVKmjziZmMjhZXDzRvgj+gAksQfU1ofMcyBPyfeKxDccA3negFbFWEgSWx+QRb5QMMaDCq5ISqWCk08pP3XNqZe/afKNd1YTSV/qJrt1aDF3F2ffBjgrbYqI0GZyeKiWTskf2Ujn+d6OlxV28rnlAzdnjmoxT2Cry8uGH9TPTxD4Dp/nRhxi3uDpzg2yncMccxFQ4fcXK7SlItGqCDt4ojnsSJ9NezfPWiV88rpWonn2aJUkHcfQ7x95kzoM1M3mN0PDT91TesnBSSb94LSTfnCiST84+aQf3NekH5xg0g9ONekH9zHpB6ea9Nnt6EmBrtuQJFWR5Lal11FPdAqj5HfXBh80748WR1DjYUeDx2C7zJsC3WKnjIoLasv8eM98qcfznVUno/ZHqI1F+UMYEG3O1U1N2ciamQ38k95VrWDlC1mqjCXJNT27Trc2l6CKNpMCIOZwS7i3ODHfx3PCCoIn6hwvqln/8UarZTbgmdvvNU9JRwwECeH34v+gukgib/Z4AE5rWyDCqKc1XaJwTcBO8yxaSC+/NPk/OoyG39ghtmxFmK7GUcurgNxMPSRVWT81BX8Wodi6wAr8piQetz24GCVyJ50nxb1GJ3/4JGbSRZSx676pKfPW/eYsm0WA950THFw0j8fDu4/XfbjlqRJBRmc/7RzevXqv0e686vwhSuMzJPVCd4cW+mEtOn/lYvO19JMbS/J1c7so0MGbhh95LTDpebkXxAFnFaOXKsYYBrV73cSWBl4Hx9mlP7A2yM3ItlDeHrrneeELj+5JWswxPc0HTGKDVzJcV+pjc5EyEuzTNs34OmyRydrwCz3z53ohpDZpMmoEjGrZHVxA9pOqTTAUE0tSRY5ObOxpJ5ASmIaIv4MlStRADBS6LG8lMCM2GKz0W9fMELYxeuYgMW9b6He2b90x77POulbpNGjNGN6D8VcqlGN9PHDQIlEBqFn1x8xncsSaC5bl1BFnZHMLIpMkjlJYMuik+xJ2sYUETmHSCt4YzbKmDvhHsficuDhRG0HBvDr5O2t3zknYU7MbqmuudKJZJCRBNGvJuoAYR3uZdOGi3ISWrLNH8LXzKPjJqVYoHL1aeYYUo/DwG0esVh7et272bYhEqd8mlLo+Z67BP2ZTL7V7PR2uWklsw2C72jrO7hx+4vq8a/V5fRtm1zSmPghzWNZGmFwwAyvMn1glkP7jluR/IQQnNnNNYQwKM1o87ihpmKyaq2aIoyCSPqlIXqwcTJPVYWpcnjk0FFYgHIuyswp7Fq5fZS7V5BChsufMZpEUNqslfvtD5hsgpGmaIDkLtjPnSkZRaqpR+Tkg0YIue4I0GNUPn2qmX0lhi/0XNmIlxq3+y6/N1mEU3TqMzKVRd77Tu5jLs2NlIIaGlKtL4/C/bD7m41PGh4OrKm9TkREtDZlLZXkl+AdeBlm8OmuKl0pPQsymc/VfvTAIAupOEnCjqf5V6GllY6/KliOGeIz5RxvmlzZcbnNNjmJ2A1c/pjS3EXsqRRvZ3kipaNjcA533QLvXUp1iF9dSAq5oJBLg8HIgXN2aLit8lbMakbhs0NoBQeJInhCCi/1gCXmygnLQdIE+Sh109iAqyNMqL4oRS/1Ed+d7LQJ/Gm+WewMKtER7O/Z/B3owAJlXxx4AwQMBa8hbXnPFtmEhj5msFOEpJJ3AdiInioYEFsZKEKJsOmW6jNjDGhuEg1OmRYEzwY3gpY+IVUe/Q4wDEkZeumCC+UPOI39MTkIBJDaQQ0WOW77SohTARoIwmsIHQsgEQSsYlEHB25orE+QeKOgswgfEFNa5mZz90PEmCHDg4VMUkOFIjFuW7zHJYrMqNs63jv0jgUmYJgilFFNmZOIMcnoxOjA0JILC/FMa03aYl4Q0fRkxRuDsSjXyyGRgPT3KgOunwvaldbBhGvfmpolnzaGDsF1KJhsCmGTlWjQikeCOAjXJkW+bS8E6wpWs9l6K7UC1zzkzZ0EfSklmhFY3Q8QL5eAFct98hJhIAweONm5yNRVuPQFAbUyZRAuAGrPvs9xfpA6dKzmz7KkJq76WXOH8T/XZgzZhlmxgzpJhTQdatuJlLYQlc4BOhwfkkJY4zwmKpRLBaJJxoOzdUx+GlGmW6ThYGpz9xEcUpyIt4LPVD0d87G7WboWK14EVpDKFKj+SgfyzNfNP1oBoRWtI0btFoflBOHjeijJKSPqCh0rwWVYVjk4hrezBT7utIpfM4hVDNhxNgzt+LpmadiSinSZWK6p3Q4PyCTRc4+8lIfsqUuCghokKxDGu65HZbAtNiKc6vkrJNLdmxbF4oeHZVjaS6ViZvxRxS4FdJEK1XRRs5K0DEcuqSS+jX+dJfKdUtVsp92moTJR02dtaiRynOCCQhR81mv3uW/GGnfmAWYvs8TSpVzdqdrjmEiVhkCcCQfQ0Yf45GueMvhK+R2orz7YYqQA1oJgkIEztq4BJsmA1gp1oMzUbwSxO9VZLwuEmi2gnHdnmRV3o8ENFJmpitMmyferFHZe0ple51rEX1wNMXiCrvh4bBuClR5Wradspq0JeBm0jWPiUEYuUwinoC1ItkiOIzb11g8L+5kmTE8VTHgcak8OFqQaKEFOwC+p/EbRanRQFNhPKhficWxZx6sgVK05TRp+oKshNIDy/YpSV+6PRtkRSMuyu8s34nHhSRaZ0szMB6tuTaTuQwsYCuTYWCHm41/t8r/624LeX8bpJdKkSz30TiM3hiD+yVzK3H0wmCHwym1W5Bay1GA23T3poMUp68KzaXDMd6pslWyTo2pskSW9B2Ug3BLFkUzn0q73jwxVbIH+AUdjYbTT6YBlig9gbFvOOgdBZhtAgbzJjtVDD8MGqGg2/ZeGZTVNtanmrIC8KtfjgyIDMvbI4gnjHf7B5/Hh8fulIa1JbKIb8vizHts24e19G4xxzcdfdn71YXwqBLdsxFxdbix07MbMx3nBSCxE6Dlm4ssrDCFsavrDwHCtXVlle5WgukO4Nv9qahaNM+tilJBAY1zEaj5yZ40z63XfXpt9174pRD4+o3iTUcscTiskO8xtDb2FDQ4kSOYq9C34d1lAR95BSiP/3Yls1jH+Cx4Nd6DJbheBO6EOcdtMXk274M8sPpnjuFONQdibOlKdyVOXwePK9UXf62xPvbW5bsx8R0oUbzQqBWghRIZeYi6lvVDT6+Mhir6+Ns8WwO+EUtx7flicQsjlqB+9po/XSlreCbEUNWy2U+5dXjwj0Ubl/qUcqNmbUE/UMxdaZ41gpMCSc3LWa41iBMcy6Qw6VXAJcngIpRr5t+OP9kz+gHCj2YZEizlNpyYuQpd3H8zam4VzDsEZwNrZhfgLjkP3M7sc0nGMYwnAQ8Rz+cneciDbWEmgZ9lF70BzdPowS4vIe8m2BHk0aA8JTUKPmO7UZ3hnYjkF+Srs7/zdieM8uKeYCw5xcYKZJbxz3QqcxqZufDnwIQ5Bx8Q/PtT4lYtR2Uel+Xqy8lQNrWvSnnSObP90T+rZIegd/OAz4g5Ma8AcnN+AP/ggY8AcPDPgHBvwDA/6BAf/AgP+3z4A/eGDAPzDgHxjw76YBf3BCA/7g3TDgD+7DHp8DYbMpw/MnwZq2EC5/uTcYmFUi1NfMhlnzAJeG/eEGCyyOp2x7+eOT3+nNY/VjoR4GrufPWq3zW/3ByDwJiWpaV6McD2qahRatdu8fNlm7EA/7Ui0EmHjYRHBcgjguUEXZ4Wq8h82mdsIStp5jR+ETk/+hQzXcqlXz8+Qiwtm7RXMLUJ4ohsmOrCObuXo+F+142lH/66uD58zTCicsZTjZ1xTLecQnHS+zTnwwWTe/2DM/16MakYpj65XULQ1FNHXQMRdfZ0jsw9ARKECBzRfRC9KIBsW24CjcjdjIVJCRyMg2XxI1uT96fvT8qBL64QS9Lur0NYCDlUfCV5qwVFCN1qF4Booi0bIYa22FxcQaSr4V2h+tD/98z3xPT2s0WY0tWcu0aXXQ6sPmcZ8RG8JCy+ENJHVnw14ipgmMKeizQotYpGQUsRpcw0oThlyTsoqVPF4WX598ti2Kjw82GodZGqOVGUXpOzolAx2XenzmOYSR1AAQu/j6BXLeK+cStc7VrCsiiXPl/LYZl2RVcCKCcbtJCuBZ94IUgVcZGxQAtj+SLRQCib2tyLA3lnsjyPbplOpfXRk8UTdhQKnWNI1N7kDZbH6sZ36oR94I+vMZYQcAafmcVd1AA7qUVVPAn9U0KIWWaBG3H1BQH7tDBXYgqmkTpdA8p43OhHdkuBBzgf1YckdpXxt+f898EY/n0Z60JIkUy7tYVwWbG0qTOAK01Kb9EUhxgRfKa4MXr1Iq0QAaWucN42gkHcZ5sMLZGbDFI7B1gSyMBRuErm2/bm6XkVaf9e4wyxWlYIlXSLX3I9BOu6z82lW6YBy0p+DiziK6aC6kJQ0Mgr/Ha7f6L39y8mv9O08kJLZvdYUcVTZJlK3iCIKXdE7Ne/OnvQgO7ZaxYCzdH69FabxhnoY1QYqaXOgiIMdlQAmpTulx4vp7Dw/eMm/AfgiocROkZF3oSMaJIta/CW0MahpjRCNEykIpRwlKUI5l/fx4tYNS/8kl858uJVmGwZUq9rct+vwmjZmozCf5Hhn+4AgJbQd8I/hthVqJDaFXPM9FzkG3V2EE6thbRuI74EnUvmzdy+NeIi6Ca2sqHHHbwhJH1L4HHky2Y3m4bqXLLpeZb8obiXrBOlKfduaKjo0qlK1PX7cWWU+E125o9U7ScxFTYd2ncEtMoehIl1i2D5VF1bAxagkmEWRsUp7vt6dsuGWueOJoxcCqCFXyhD6jOfkT5tEIJPH7leeSWh1vbP/75t/FD0VekPGyZHk5qCz2OA8Y5WlsMUEmVjxO3UTDsvGFePej+hLSoginVBHwv1qLmXvKPFF7+YkSTindff1MH7hkHsEl8UhVWfLsDfLSh8hL//Ibk2ewg4JWZmbvFAs+UVTh8BI/NryzgCCiS+zj3S6xGzjQVqUb3etdm9l1CZuHpbySgHfvXu8/6tTE+XZjpBuS1xGhos6FUCRAf9g8IpCG4ZjDSBSJqQD4igMfe7Nzy3XtO1+NDXbR+57oTlHYELvRbDVAS/stFWWKGoSOqTvfYVqTEQAf5TecxGJ4jCIyxS40wgYKG9Pf6xXds+ff7NFDg2TTCADS+Wn8wOZEnSeq87TzjN179JJZuszZ4kI90B8cLxoH/8ZEIzatvw/RODhaNA5OJxoHZxKNg/sXjZhaOl40Dk4mGgcnFY1jCZwvmvNemfZK7NYnKXH56WUpcQn+uBKX95hHbPDEIbM4ZLI6DMYzMAvzLkHQtaxKn2v8J0nqBVnm9GHkxK0nzeUCukSiUD6gr2FVxC5d23/cvKXlLK3/klChugFt5xJP8ze1UnBPwGqSuMq6BN0YaxMxy5A0qWxhkypnQ6IQlCCHZp90DbJBqHvSbl3MrZeauhhzs/PyWhkTvFTGfPoPrENJnO7vNH9C62jmDV2sq7nfIbvf0pu/unqIdTYUM6yzv9Yz/1uvyLMsyWgpwHINBWOUtDKrsiEJZfDl7lvwTzCMaGzGsCbqMr24OMgfsUeudDcgOlnNPqY2yB9YiN1RTpsMaqKhTLGCxPlI2BNILSjcq+CNcq0/xupAVBSpTQrTZG34pZ75EfhOWShcGfjYrkvuR7uNsVpg2zlm2vG31VBd3jWdsohSkyDJNMlZeAgjVWHxbTIM+LFCK4cxGj1giT01S+yt11ossZGX+Z3eWobehHm2QAWfKDzwr1YHN8xTLBWTAAkmEwrTuyp42qRoQPGrS+aXlgou07qkj7ascsRkdKAjnL7FRszuzaKu4XKDLs4xTO6jLAmdGZqtOybtYrtVhEktp0SqHkejyqs0Z5HQDm5LPX04l51DEMQKuwhlCJIjsNNbHdzyfBD1/NOS98A7ZMjsoblv0CxNW7h1QU2TgD5y2kMuqbxnIIf5VCQQ6gcTN6ruba2lNLFiKaGGoOZxzCdg+eBEOd8zSxt4NrgVW22x9bnhR/mc5Qpsu41mg8BmYKRdoqiAyB5aU5LjR3L5scqhRYWwyZYzqGneHphVcsasO/wQ+ENklQcZUpTgj0/+u048OjkmHr3BzGiRx+L6LvNmaJt7Te+zSPRHk6jFIdIwCxwmblsQrr5oLuSYR1FLWhseV9tFcwH6yybK2ARG09Otvl9OBmPjkljZn+3YFGkEZf2vY9woILWtAtJsv4ljjM+Nz48fHl8YP2I+3ze/36OXarVsC045tvkpaSElUoCtUXYO2Zegv6dsiozbjKqW+4gkuXzJilBSjLM4BpcQDy+WONVsaHffajADLECSc6kr/VR5el2L45MErjAbZWXgYAR1uVn6ZkPBTaCoxZTudvUaXjQXxLiLY4o9f+tbzd0kyXOlWGbPj9qrF8LXPAcMpWBBoQyBdQjEJjySSl0O9rmoa+GOznas3weHUslWM+MN7lCbZi2LC0FaDbQf6Vb/1mTyCah1WRTvvYnsgFP2w9H1SknBPHoVJ8+PYOD1Ib7pPN9FityTJB8u4z2HK/h/PtyQP3jje71wRKiPNfTn5K2rkFdlOdLV8veXBqa5X3u58Kw3IHAuq7yUZKbIisKUrIPEUw8fr0rLUpRZnlVlDiB0JpMuTaqqNK+Crae2QCzDIpTx44IScFkuShXUiLXLCNVfpVkml8OZRZWlkf8eQbO00Nujz20psZFir3X7Evcv6geAZU8KCETf0V6VDAl8Hid/LbtQj+qmommoQjGCFTT8G38wz1lWZa58fQseV7ER9/PYnNsuwaHfnzO7/UwF4d9ZpDZ55HsEoMNGfyyC3hsNn2m+Zqwq0ddiQR5279js+l+fO/6Z/p4gh4FUSVu9azMFJDlG1rFROmy4qfhd0loh5eaIvBHSe5naq1oYDLyXrFPus3XQQU4tcC70n5zjVOfVwYnAwljP9ibs2OC1vhT/YFv/aJfC+4IuFl0KoGMOzgtcG1FQJiQs8y4o6N2lgWKrjCR68hrc4a1OfzuOTJiUJ1+eF748LNFiytpXK/Sbo+HnVziqWFgoOMSJCSKWc4YYd7GOsC9o89Gh4dYW/tE+qVNYVeZie38xqxRbxLJqXEcAjlqVS65nuD05rXclhKeJg3rrvdHCCUuJWTs0bbhKgoNLxsKPnMATTx9nAMYdwuR4fxtooAkNBqcWx1khbsGRnvdxIK9SHBFOQvfMes6VGX2v8hmtVDwdkETEuUlUvisZ9yESuI9FOpvIRtje0LuyLbosrQqfj7RdGeYCO4Yfaf0zDgoo9sWbOVcVvsBvkvhO83otlrHLc4aHRPBcNMHwd08jhVEEjxE8XFE2NRrtmBqbRcf76yuKi2SQ6RmZldhGvEYyjf5QiKadFUzNHbH1Aby60VxhjeyrMjfQtvg3gfVqk5Xizeib4EUklyTf1cK9QLY7Er1YlLW03UvKriPThSvTqsxGEIRFEg2WlKwsR0rtcaSUF1XhSmFi8kAe7JaLhL+Iks/9b9wx09y87e+aROWmeCvZ87UxSa78GbzU+7uIsJmwNC/WGI0L2J4OHV7cO5L/UA9seDqf6+zo6byj1+jz8AxGodPO9WefvJfMHjgbrl54oILpdrJjDsz4ZyefOfDgPu3pgwf29L+19vRBb7sj6X5/zuyqPX3wdbGnDx7Y0w/s6Qf29AN7+oE9/cCe/iNoTx+8e/b0wams3oNTW70/0UPjDO3HG7MMD5n1kGtk+XTZhV9ZGnzIiN6zOTHydRq8hlY4wIwTWrcVLKc9knsr6s582nwCow/LCKOi+ELITk4gcSChNwgUMxyEhuiZa/iwwCCZANvMO9UXHz5nnmWugt0RAT8Oe7GmDZVmrYTHxtZj5kIBAD3yCbTd8YzbBm24k5hgDU0AvgNDf+Wlye/30Pcw5qBYwO3m8M2vgqEwVjhsdVC0nbyTzWecLR4067Iws9QkqpjEPUvq9mBl8KR5FHDGEjkj7Q1RQyFBa26+p2/+Rc9LHWNQmKvqxdhQjRWTo1GHHFXLQlM24LCkHUd0/+5b3C7EUqurvzJcnyYfGqdLbRUw4lTMOFSSsqGsv83qbwPIJVHPqqjs+khtGyKrW1vhy6M7pz03cBkm5kv0txYdxyoHVCZsYAyG32He4ggIyjL3zXtqMzaYTU7xnpKrRv4qUXWcs8GQsPEU3P3g2yeRf14ENLbjxnpaXA/xyscmv97JhdpOPqdV8/Ao1i5LSSGQgE9Q2vwRzidlbVNEAnNTaG8IN7jcPVz9D7fjT8hQflI5VSH975cHT5lHLYVU81/d+qjvA+m380QJsdiOcobKuZj4TPcr50k+GhkGnWiuBgTN05HvF+ipYhs8U+YwzNjWPzRd00ax1uqHeuYHesrhByvZC5S9hBCO2CQPD6DSgX/CuNESApg4oicL1u2QSZVp/ag4qYSn/sinTwsmlscbW5fNJVtIAtIStlMqrn6wPTSP2bL9Y/PbXCkcT35x9c46Us/Yfef3TFugxVDqQjW2AHLalcTemaXoS8sDZ3YwuD7Xlo/sh0eYINjF8UtIiK9eGa+bz0OwuAWxqQ9ZJqGTEpi7e5V10GmVc9DNleQrE5Y5Oynuq7xNkuqIM9I9/JYI+A2tlEawJiF68iTDr/TMlyFQGRL9MGhwaU86f71uIhaHFZULCalcrMGpLx/z4HUwhcnkUUttZz525sM7JzU9LaFJZPKmfUzQk2W+n1ak29NiabwTd3sm4LVKJqJFFpZ9vdc8xQ6b0h8Mt4GiBTGg9Fy9+9Z4/Vb/lZcnP7nSaMGzCsjf7g0eQZfIVLAJsJXWJstGyiZT5Z6dLA83zQZeTcSGL8CGcdn+3FXy2uT7+3jK2TLHLhM+5Xy1vv9Z3+VHlweXzXvaCMYuejE3vshK7l+urHw2qnziZOvN1MJGLXvIplpXBMDdcMfcrE9MC5H1EMTFqDKUcuVwultHP4DnnRSe98rt2SbueaeJe5GVcR99p7dUZOVZrL7f7A9umMuEW2HZo58bXjrXzn3r3FY/aDJuY2WoYJCTRhgxLBk95MgzgeLnU7ikhfDMZ/s4ewjmVcSyfMTQHFPn+cpk8r+s3llBF4xStxMuGnfU5qDGyXFtAVeasvjZ1XhUSfNZV+OvPjT4nPn2BqJV7FjLymO3V4XYFgQuFGoYO6XJsArgZfF3/ozCVuK68hEdPlQsd0Bdk6XJ8mTNfJt5jSFRTAUaaLMNH/RyoC9MmxPXXHScpZXX3Ki+wfBT5hMWzxJmgM+IujgHfCRtLzy0umtkEbfZoZbs8iC4/FZpdtlKu9Dny6UHbL5T7kuUIAENdBye8Ya++vYF81DWUinRoXvYbIREW2YMxpsvKNYQHvOt/iuvT16/4+WmkQhe9rs02wvCfJRzbeeVVAuCWBjlURDXS8lNqDsMrihFaaszGj1/r5fVpVNacrVhaQDa4SD+0w43m39rs7eXOg6m32lBta4cNVVy+vZacv3FF3ev20hkcBjwhQ5FdnEPJ0YZem72gmHesuyetAweotnzZlpAzb/dOdLg129z9K3Toxu/tM5jw5jQeeSuJllKEg/4m0/g6bRvE+bdphdm78HUX5j/eqpWkkhzxwNd55nm0NwdvEuScHCUJBzchyTEJObhVzhSEg7OLgmztz6pJBzcz9T+RO9hNOFRz3OjFV57w0yg1DRG0dnrEeBmOBXdcfEL1njR9GiqjSO2u26Q1Kfb0b+6PNgyV6TtV6AzgxAyALeWwYW18bp51Ywdw81QTdrqjmGfKk1ZUSNmEgyCFJuNiJKP9cb6hMP/pG9+uA+P28Z6DyLZYbDDvYJtELLITiGxGhcYT5ZXlohI0NJivXdTf68RIFwRvyaVtUiaydaCqjSEeny256sUSpDtd6IPwqCPBKYLWnOxHXoNZif9BRsLsGKGDrbcE0pZQO7yDlD9d99qYPrTxNP7gYPX8W42zRpnZLx2hM3zqcnPSCwHBZ7tasS4xBFNfHJqMyZ2EJNRBio8ZhXcbJV+KwC0IoHX+7VgWiL2UyssivJpqBa4FX/W/GmfBnUrMDWS2EgRuq082CCxAPC7r3xRpfSRfVKl7EQX+y/kcXVYSdig/J2kIlbqzZrOjeKYIEYnt/aOgUb2vcB3FoEXoU6CYKX1LUJS5dmoytKyKmpvvOShGGhtBp3IsWUyOq1Tc9WIWk5DCYBFdG80CvVH0Mf59KyPU3Z8HJ8GqNlzZtOnoUhRGZLuv9Nb8mk4i9z+8srgWalO8j5DSkhMPUbukMgSW3s8GJ9HqHeyZv7ekvm5pXi4Un4lUW1qORCC0L7GcLR+gHwFqgp+jY++IQoglYeW2eDXtB1foupizKRkO1PXxJ5wfwmCc2NBsBK3Eccsg4XqtJmjy7URdarkJpjdgKSr9u6OD18fAqbMJpkrZyeyIlLARa3L8ibhGG/slWBRH7N5t9C+BRCyCMZn5Cmdeau0fis2Lqm1sZQ/ujymgJtLAcVQMqBfX4fkbaEcNdRC9awibsGdAaWTBTseioJAdhLPVbuuqKvYulT3coXySYqWezbT51oEDH1Zi4St2wcvoEtrYOv6wa3+K3cma3eWCpt8tld8rld8sbdcfLfVUNU7vWXvq/ws0v3O0iAx76sH+G12PSloAUj1TtsEYLjcbJvrZBFD6FECxciXpRYpWiRP9fjJ6vBZ8wyOpfwHJnzEvQFprE9czK8d696/+pHJ319tpy3KeaZj1yJ/RPxHzC5C8qnyI4FLod1xuvvnUCZiAzxZsrB4djbvbL/g7GWM79xIgDHn45XpZ55g/nTy/s7S4ElzaepYqUX3X68Egw1F2AjJOVgl7LCl9EspIwQpaYZc5xNUG0OzADmw1Z3juWF/ODG385J0M6r501Y1JlQgwtEMB/OojC9b1eG/EuFvrYGXl9o+bwZABvAl1l274m9GHF6Y/HQni/X6ofBNVxZ2AerING1Sv9ThodH37IZ53P7i667WUzA7uSddjjqdv7A8WzaeHyob/7D5YF4XiftUNKZPtAMaY/N10Sbw905wC5lHk5QiGjqfNm/yCoXEpStfpDFuiyukMR5vZY5QgpaSGXSKgWk1tx1VhXWntWwehGtffXHWlCk6pkweiqgr3ukt5aE4i47/kRVQWsCFaSv3NqVFgLbYMN9i3qDtndjKgnVKedi11lL2xzLTDTzdk6nOsagDZAmEAiz1zaJ1PV4bfsq82bQli2WVmEDRJ/RwBfCASLKFr+sjQYbI3tSTNCp1Vch46a1r5mqRJA4IT1kxNYlGUC7GdH/7WfNMTX3h6gbFlu4DNoRRlYFYSjzMu2+N1+ZuOi9P/vKSapn1mzZJ7KhK7vU+dXwy5tHaCtGub1A8o+Gl9pf4z1WwWUbbq9dffPFaK53ZqJrDGqdXzBIC+k4Qgof1s7K5LMNXzfXmXDbTw2d1GQqNcwyjQ7k/hyuK4RXzeFZikmRuZO9QP2W8caTO+2f9wQUziIFtICbWzHea74BxSR8qS6Cia15I7bvvgH2AY9rObqNrAPLecLsEnYEsA5nyhbcX/TSRXqr3MzlxsjZ80lzOmXxUgyduZdn+sTbJK5Nvbe9Bo+NnpZd3ixKaqTg8I2uI4C8C7GyaDVvmYDkJp6En+dLSIK0z2RhB6HJPTdUwxGIVkraYtsR4wwzMqmN+Z/gx85FIcyNRq1FShRCw4+i1RowQuTQR+Az3eeIqSP/I8X3CvMfXXAogvk9b4jN30F+f/ONee9SfO2bnH6R4ALnzybf1nuvO01Fzusai9LnzdFpD4Od6g6F5jFE41WuqqNfNuk6D5JLrwcz3tx425wBOq08BadBimf3k5ItMKT81L5pL+BlgL2d9lZ9ZGlw1Q7yKuIXwM53oB4IAx+uGgY6pReIacSnZBqqaMijbH66bVSBkcvb/xoYkI7H9mLlIjljwSlSWI+QG6lCF/ZrKqA4pvh32XzhnNmHTJHCwwv6t/qtvTP65jATcq+VpUuwhmzErSzNTHWDq5ntbqzeL3etWms4knZXcCErrtBXZzraWi2vXLU7JOiQRLUTfoz5nYJXsi8XeqH2vm4tDdUjuNYfOzl09FhmhCKezIb62Pvik+YhXTnXICvgPMTHFfuUDKS4JtiR3hBiJtY0mv5cNLzLzjOaO+RYYfHrdun0M4hYJ5gkTasmz2ETUNPUHnY4tH1Z95Etv7jUcmRtiV6CAQfBTgf0fQAyKe6lfu7ElPnjCBYbcn3DLUGrG605+t/ydi+lZc9kR6yRazRHP+LYFjLP7prf6r745+apI2ueSm5ZBckGcAs0DHqfAVjcZotJN8P/uW6N7vc90ZMU3svJh1+FsckoUFdiggaM5JblSvVx4KGpeWlbHv9dRcs2flgi/i3dL592tu2A2nN6qOe3lwdXDp/GP3XGzjrfBA6PTr3CcCwVpcy0GD3znIt1HOA+mXSvSmNiw15ybD64sfI5D7n/fJfdphd3rfXJwY+GN+Kd+7/OtO167Ho5+9T9+5DCG2WF8TudYV9qhiY1YbhEKvdH9bh4tvfOT/cEloY4GK3njsGTcB7N6H/xWc1ewm67hJfJCbl9oQIKcagIzjkS5CYC1YL+ODp/8XvkOIDnd39qIMYjtDbNGkhWHb618O7O9fvPkt7i9vr8j5nNne1P0E4IOdu9dGLDvXxKDmi4ngNiTNfMLPfPzPXXtYARVCgxBy6QUU4jJdSlDs2JiI1yXMS4LhA7JflFqgZDqbtqcA32GlHs8CHs97G6/S9aqlB43fsvpvzsVql1N6UWueJjxMWSLe8BwkAdELIIQobXhObNZ8k3ezo831T89+aWO1fjeeTxNSGUD57PA5j7plOh8/PPlwXkzmNaeBbgjN8zb5s9qjguCFgiKh9NCsrKkoQsVNr9cQ+ZAZjL4gSeMBEiFVrCUUoYt0JZ0mmDxS1OHiEgfvmRepCce0fgSNEqbNud1RRsjIAAYMTCEkDnHfus8WcjpRmFZFHVcuzPy75NIcSjxroi5uz2UtGOXJ5U6Lpfu3+q/9pHJVzpzQw3LJeNm/3QV1IpUjdJa/w87Ji3tI8zq9aBVSNi+vDImc+vCd6UbDZM4N07asBQsaxgtOoO3pSV9WJiIYo/i5jsWH45YQWKxI26LSU1PpAR+dpmE+2BTjBlwpBTAonfFPI76qBx1uyhfE1Wh3sXwpnlfSsASU/ca8oVQ+eZaW+8xj+A6QNslzQUWr7/XXpz8Sxrb9RzfmLcXdk2OfpGfzFdTwJM7Ymc+LDdTlILe631gcE1N/oUnzm6wvYxFVid/uoP7ebqDszzdWbXXX1ga1NLCVkcIC8B/invw+yWQwALtkrTtIjASFfUeW0WIvv/wKfNkyFwUJ0H9Tq26CNn+1qCxxLfP13Z47dDOOHbyfC88ZAae1OYZtMg3Tb7WkbCtRb5Sm66PJtlRE7iMEAcP557uO3PQPRzO88yKjlMgL3jimE1rUf/I0uB95iqGOoHRneuqzfarQkt+4G1MVs1nzV05ribY487hELlGvH/KTlEpTXbpUWJBVaD9o5L6Q2VJm+l4FGdREns3zNPEJUVfz7HZJiA6PHDrCXOpYGsYJ7tbqapi+4p5XEs94ZWh51sRT+wokEfMuRx2Qqrldeu3+q+9NPn9zlyfsG5k4c5+wTyU5xqJPCGPos7NV/qDp8zlQMtQJBs9gZW6EjyUJjchlKxKgMGFALSvEGWf4qNnbxLkNWDr1mHWt9N9BM8FMp+I4Wq7vnCIE4ntCDwXGfuLZMQeMS2PkKYCmmjW6iYeuIkf1tzb5ibxTb6UTUow+lamqUrzEGtMZA//6OTvLjczcr+6pyXyf60/eNhsMDtNW/Zl85JLcoeKaZZjJQxY1n0JBYAgwV5k/ascvjUY+TGkjBnL/E7WhhdqgnDugEdZkBtmzUFr5XjNj02+EAXvws0pfQps6MKAveyOzRC7k2eIT6uxf7s3uGaueFc2Gd46IlilgWtrzTxmLmLMQAnoiipzgZK2Za5Rm/sqpKNQeVdWqdRHavuUhUM0MOuZxIFEFMaTv7ESM1w9OwswS7u2dnbmOONfWhlcNA9FZlAxdQDcMP9jz/wtltLBqANRRT5KKp+mqcgwvKE0ZcUWhgt5VTC+743ypGHmprHuNfMoqowZU5Luoo8qhAxTiXcq2CEwa2hIWj/Ux2JzxN8MG4ZEphD8xOJXFhnyyk/Whl/ome/twT73jLOneYpMmy338lHOF+hmMrTYyrIyrX5gpOvw/A3kJktaX5YdbE48ITrD7R163axiyvN9NzSPF4cJu9t7tVqBr0y+EPNpsAI/cky89YqrfL20U1bf5SDZwRJPR3ObY/l5e/IKKqN0y/eDJ5oDjzTClpGMP9PKbOmzn14aPGw2o8aAkJqx+SiEK0HGHiQUDjq6wWcwJ2JFHhQmBaWdEsPJKEXYH37AFE6TirbKy1Flc/WwcKl6MqcRpir+Dc2uVoRjsf3+6uRX2dfwdl2PkLIe4ZEsJ+gV7WlBfTHcyYiXgjSEco992xDUrXK+gxNsZwgjTMgpDGMpjS/3Du7rrLNqmq+dH9wyH7RKO1A2VVA1nKJh7CeTAWNjXA0Af8ew8GTF/Mqa+fIaoXRcjR46qYKBiqLJSHssW54wV2KBSwflKiPOjsWfyJxRQmCpC7yZSBAcDVyZtvUjPA/XRvDAM8LgEt4N3cpwChi05MuQop4vo4BByVUFyovxetaBmiTbq7FclUtz17oaziOFlARy0tzF7sVeJJEsN/gN0p80iIvmSaVEukrRlxkKPq3rdzxupe9jqwKPi/sFbNcCK6ifAa+fN5+wEkiLIj/LVYkF1Gce8S580iTNExkZF/SlYCo7VD3Clc6iXSWYTtxIWOBao4lv9knHYjtvhS9qHUyt6fcjgrJ+cYyVS6pUXW3P92+mEa8dee4baQpWBUhxlcxwy3odjWAoeSocuaRlC1EVOmDm4DTguogKyM8o3ZOwXSBOlK5Gs/HJacIHgzvA+Cz4cCUbWGN2ADrFa0n1cd1fOkDCkdpXOgakRXg9sMKQyN6xDS+HU7MSMI1xoA97rpkRF1rjSOs5I5maLDanDa5BjLybxQgnHl37+eK2eAcQ+wBuOyLfHdye0KAR2CMTntjK8L/pmb8O88PK3FZaMqttvjI29FDQE/iApCnvSL1jxcnh9RUK49HUgz3CRjq7RNDAlkcGhk44MJZB1QgHQ9iWoMCyfXmgKSPr04Qpt8CE/3iwdcU8XiRJkTRbu1P5Gw/mWIGgAPCejVcA7kuzgvUMt/qvvTb5tvbOP2p2fjdvy162WaU5+M8M3nNYx3cPLxEEJK4LYkfoRJw8lSYMfaAMwquMmIBnW9VL893HZbwSj85P4m+eqzcF3MbHCOULh+6T7mSd0y6Xyn0iokyOL0FAjU7aQUBHjvEpv9+54fwCWDt4YubJmj/d3bRfusMPEuZevHxXHJu/BBTbYxh/1m/qCmBYk01pV82f75nvxmGo4/BK00NEKr7HaqxcjnXhYZtHxgKGKQI5aXI2j7Su4LqueDoUT8FOBqLkULDJtZPt5Pv1gwy/yXwY8VFw3yFQPE3YwagOfaH1Jwz/RLM/wgrGNqS81lEcB+8327lwNWhPTiI0mUjXRgdczwiY3J78/BrYh6yuv915a6o7eSt4ZW3MfLUTWW+BRRDARhx1e3F/jEEzJbzad3WqlToHv5cD7fJq4VgPb57goGnS3I/NO8Kczr7Nsj6rlP5qH4V0SdF2HYuq1rCcUuCynzHvxZMXRO1i4tB4WRtj0qIfrw8/Y/YyoHp0mv0+izZ8aFrR4ufQOF1VxxHDxgGbTKaoQpE9u9dpmdk5sxGf96hCs9c+PvmF1brQrFsD2sIj9JqGr/OkxL0LiKIfljoyeGWxyj3L0ZqNfS5QR/YnzZ/IarSirFbBBIZMnGq4vV7kEAKDn31VIE1CWwYthwLwazKyWVrl0sOtyotpzcyIVqblXlGVBRGmk7Xh1/l+D4DWJwVavzY5umYsy5Pr9gd6CpV6p7eU5cl9i+YPLtVR4OyIKLA3NpQZm7kje4pK1wC7NVcAvy9n6jSGz5gbWdmkbdszgwx97hgqPkFENzHvx5V8Cb8QxZU5/oEWFhktTnz2GuyXYN4nJl9Zvv/c7BSVxNTB/jRn0ip5F+Iuv9MAKnfTw4DKGoEI5kd4OuLeYZ/JuVsPLxojJzZDP5upWqw0X5/874yZnDZYsZueXVn+q6XZkpIsS2dKSobmsSxLtfs6WGfZdXKyNnyf2cqylJoiRQc9mMvgA8VWu/dAIZ1aIX2ypZDO34xMUKqPsjQKPJRRlp5F7H9waXDDPO1DSNrJgLw8vAJyEwTL6LW5eBJikRg73EF9w4khMyGXxosCA8+x9dmkmvLaHE/HO2Ulojr002EBVkUJzniObp0jTCL4u85RiZrY/pD5hkDTnF2vUh99Y4RdAFYRI34k1vndt7DVKO9ZZ0Vumo04FLf6r70x+XWCtJ5eZLCsw3GEWgYv6kZuEU59O92PUzIw64FQ4tNNzA8tD25KqgXkEigp1g2iIkdutBTHA03mUqgBC4r0CJR/VN9ktPJg7o2qIN972QeqHNWyuEc9AymS9C6C2+DqD/8D86cCuQ9rW4UN/LB/FyxwpTgoU0VtTwJaCEu7mioYt6b1jPTbWjXrybys0erx+tZl854C5Z+oOZBdi4G98dp4sL1p1jwBud2ynIvmArSEi9sqXuFW/7U3J/9n785FCElSIYZTlxAB+/TF3lLihGx1TgoK83yOTebhhfm9e70nFwnEMt4ymr/hEKqgV5xVYX+tP3jW3FBYjUbpGvHwZJmoxWNgXpL15zuDDIJyRtfQZC9BTy9ixZBEwMzKd40EDGuQR5ohvtesYv7aoKOxY6CGuYyf5u553zz5W2t3NuqpsOXoiz1ZZtfmDT/gT2t8q3u9Z44PVQhwY3aUHzOGKQX01Uv0wU8x7r/ZR41cZJZGFN6y4WK+X1kd8A1zWTgstBCyVjnqZQ+vm2vWMrKGacEl6kA+owMAAiz06HX4PjX5CzG/DIfq2SbA0oxMa0hWbQNXXBDuWVDYUp7GHFtjAOpd8ZM/f2Hwgz3zfT1NezjsmzkCyVUhPDaifKosNLyRLE2y7ZJjxjp8iET4youQ6X4PabdUUgUbtcKMgZrU7Z/0XePNydpk3fy3S+ZvLmUhZ6yJQeMoX45YTUmKIwfntd1pvAeyq2iZnClw05LJElHi2IsCO5g8iJhVCuTAoRIGQA9OKa1XXBUcVjY5rt+xZNCbUfg6zY+yAYKwCAFxTEvDgrXKDeGpE/RmQL5g8GWnZrhZMbkpG3AKWIWMDbvd4cw1Dq/TIpfHU2etmh6QncVJ2a37oOOrXMsFhGmYKwthWh8b5wNMnLK7QT3cSGpx+BgxgXrCDA6/vGp+bnV2Bi1nsYVU+zpMYTo7hV/36QN/fHPm2SfO3t/EIelweO5IJPJgTf3hXVNYUVuPmofp4GmZ6CFL4CGz7uMnsJqE+ClaBt8ySe+sCCjgs/3Efu4R8iTidZA+qyxthUe6fIf94GONHbenlnHWbGybgjdFQq+QGjv2Qmo2wcOn1FB/HFw2uY9599Bt8IJVXGt9H6n/216UN+me27NJ7+D0LxKbOp3kRQ7O8CIHp3mR+zUBWn7Q/7MxeMZctqTTrIgHmGrkKyZ2AOT6rb75jT5tXacN0MXcRRZHdn6nncjrZHFtAgi1rC6RIjKgt2AVCT0YYmqpDBolgLsF0mzXbhaskrTxkaqyaXsSoZnWU+MokB6xOvZ/wTlFgH1vQ7s1tRaCIBfOd5ItL8TQYGg1q05bSfegKU1JtgemPWulOdWmIlqSB6fTeupCpIulSkBuFr0zMtF/AQ29IfG2KMCCBxsrhRaAt5gDRJTvjWK5PLEPSvaTxWr6+s1tttvyDHFV6GXGCHAecxSax4jpWo5fztYuUjElz62qPNUiDYZ/vIV3qLkQZPmxT/iMz4P8Pl4gq6Yse9GHK9i2pj0WYJJ9xJyzU+x7CbloBtvnzIbN4seOJf/t5q5VfzrTN2BWxkmmRpxlZyG/Fo+fWJkzQLTq+AtocWSv8Y7jp1z+oNO/1X/t05MPHu0kUD3sKiRdmjfQ8P8zR+auecoVS0wB9iN5eW51qOAZJjO/ciaCZ9EWre9AFo+qOJwuTuc9aE9x9vkhVdRoM3gmNUHn7vV0eE4eBTFrMPkerfZ5l74NXX+pufbhg89I49xSeD/aH1w059uAMNbkjMwNdp1q1AUKg2XQ66opLkywYiNvmGvuu1W9jB4gRziWdybfG+sST4Bd2ADvhtzohJwk9ztAOjr/64ow6GdFu0GH+Ud982v9LE1d1uQ/FQTPZnWeZXNQQ5ULKTJuifOEjLq8Sq0yhwYHPQC1mbms6Uu1V3kivnZCcwsC6dDmAqsTUJ9dNiVTgs+8ykpRHyk57yzQqbI94R5lBVgW9iybwwBLacSBx9UV9E5l95XNSA6SmaeWkpfb03twQ6qPAmSu9UPGH4IwY7rWLzl/kUSM7EzQMPHmVCrDus4WnMuwKIPuwBtbysCFD9B6Rc6g5ww5x0VjsPQ9FC+QeORYuP2RyX/ciWdsd1bcXFnqx34xz54EZcNqpwUrf/bS+dyVz4NnLvzudKD5cn/wpMSNkpC00vwRSbVq3mueFlGse3B4JiDoP5C8a3hNIlRayqHGSmxDUmOzEwlxeynJ9FmbK7SjDG6/OPkvV2pl8J7a2PY0tlfrlgyzdVHNH8zT+6+790NFIBkids7B6Q4/64j+JniDHpomDffdeN18m9lTALRLonvHKuSAeht4SzXJY06Xkl1U8XX7GMZJLVZMofifYR38cx6LEYD0adEUYaFIXGgcMxn6pGxnuLpD/9LkHzIneKMzZvPlNSnAen6K3OGjAuSDigDzYf2oZx3vv1hX4kh9Nlb+6mTFvM9sUU8mGRShKypfsmcoh8XvT1aGdZ2o5B2CphEmK8eFQW9/dPKfiYAib4/isYKw3+vf21vyhytuW2H61TqRiUD9ppoQXbSPP/OA/NLa4JPmeddwCrxdRD5Ym+xYj0/1D+2uU/gn23vFDE9st4B+af83wBmOfnFQf6CODWjTMQbyNcIAEZ0qM2ML6gYnhgavwKPiQXQDfNvYJjCtwcEx3sB0qFOfDKoXJ2kWYqR9KORGFqsCq0goBbKpjWvNh6a4T95aIHJ/zHwG6cMk3qIqLLyi1qvIE8XoB4IFMxAnS3+ibH+F8FWMCW5dMptMYOxYu9+M+BHS97HJ/9UDWK6cv2u5uUs1113rQ8ciSQXJeGgiFNyZzdv13E73dg/VQKOiHf1nEaTedP69SUXUIbfqntE9fs3Bbyn3uvtm2hmJ7hk9N7cloVrXO6Fz9PJUmbNmT9AU20624ITZZXwJHEdzZvp0ZvoP635tiwQbNtkbahrftMvBNTWfZko0sFuWVKAxGoBtKEEFYl0WA9OQ7a01ciCZdiyiXEgi6BeQEhUMb7XkHsXndfvlyb9cutNOsqWHdOKaQBTOjij5scHgTfMSaTl22dNzpqNXdJ5Rmow/QOvKfrhjkzqbhsqIGSqo3+6Z/7fHDUXGTK6FzRv7tUSgtUQOQl/HdsiUjcQ4VJ1T8Ai2cnja5TRtCKcTDUmgVK9ArBq2d8pkUKqHaFsyiSvlU7YVl8kpEWfIY3Q4Qc9xBCKsxrZdDP5KEJsPHgM/0IHdnqLDK8L0hhAsblTmrO+AsT3e3LqoDR3T2LZ0PNh+HP1G2Ymg80tHOox4mogzWb3irf7tVydPtY3xYbeSaxOxEkS2fSqJ8Lk9QyBPfZve612oT854Muo8b3QgwGm7o+kKzKWE65yC2qXLkAtnasxfaXbvovk92vofP97Wf8aCW0Bm2sFbAvChrpvLVUaa5rr3Ztp6+HmKbRAzEbarDZv7q7VxCAF/cMLBOTh+cA6OHpyDd31wZnuenGhwDk4xOIDYrGUZ5TSqpz/XM3/GprBILNYmHlbWeq1WGSTFmoVmxWoE6BZWJjSqnnii16xyVIPE5n2ju2+Jfto43c7xs0tsnJdocpnk72ndDrIwirGRJBntIkgiYruoacVPkEnRGA2V+vAJuWzKkLGCX/DT1sMwb8iZDWTNNr6Q9pv8wl01w8KSewBhaDIO0/1cR7amEBIJnP4CIkxBUaT45lb/9scnz0FtzEaCGnFoT2lxH2jHPDqFf63Xe6f3MPYT2rjYT6JE7ErT5oTIeQ03J2LSYGsZadsseaw4exgSSyYBvNJxE/l7y4Mb5glm38rRrBkPC3a8iRC5+dPmu4idlMhdWcqT09aFGCqdFeY7JjjjLhWnXVOeDPZbbmSSeBTOWtKggH1jsja8aa6XDC97Qnows9gY8a9C4zcD6dmKI7MksZXsJmpfjDePMComk/+5w67+dKNxqJEA3hHwSayger6juFjRgcOeBhMF5Y4d3AFTEkIKuYBavsRW+f3ZG/XKKHtpR5pCt++SPTjBkx6c+UkPjnrSsxpZv9gbPG2exHJPU0gQKgRkzqosy6hN1szArKdpKp+GV4RkNaAqE45XljWowiM4G3SqX58cMBb0VOelWvv9qhSgn9l8/J+AxL4Yi6WLgmFwD2rQNXPObKCyn18MHxfbCEn4CuknpaLyx7/Rm5O/wjcaLnqjs8erf2V18K1moogxKAiJvYX9m89/QP2156umPwOLwWvQb5XFfF5jKaMOsG0Wm280hUuTsmzoKWpVWU1TJvUQSJbcV5VP3W7Id/9/9r491rLrrE/73Pe5dx5eM/bYx87M+Po153qus/fab4fYsRMnJ9fY3vGMnXiOj4DWtKpQH6gtquJ9oYoaKCmildqidJBodCEoBEFbRNWSVi1tEX0gUdEIFFTaQkURCgVKQRAgFdXv279v7bXP4z58p5RW/sMa33v3Xns9v/U9ft/v0wZH69XaIDJD5puyF3WmWWq1pXTLlRhEUz4vpo0j63ruqIW0WPbO+wzwWtTLd3nD1CnvFuDnwxb/TFKK0rXRVVO1dCJufLdyt6r33OoTO5hFw0b6EBbw2FQNO0/zWcP2aFW5FztmpJ1vQJ9NQ5CD4qCjzsLgYhq2pJlIAcJv2eBTi+JrbOs+pMCLZpKrg7cufcv8yU6PFljm5y0zwZMhamS5l+2hte748joI0EPrTOmpAnn6blf9ugdfbIqDudj0sM7nGtfvMU8Rwy2XFzixXn+jtWyTtLsb0RoUmYay017PT0h49fu9/julekyUhimOkWbAocC1LEDNBIzrdKVtoNwyTDAlGF8fvN88i9cddjn3MZ7ZmDgd6DodD1LXLTZa3942V4swzGm64iSmYYjvw6BnqQGHh5khsOLmfumF6t+wVtuVKVMg66CMixZl3L2hYAQEbb02bx/wjyvjqNWkTizZvNn/8dV+bnZxDpEdWLdljLJ95jM23kwnBlp3pvlHS+aHltQ3LNY0rPDajmMXsBMlKFeHSDYOobsjHiVnD5sISu44AhRdjXzE+HPnY0B0Tz2jWpqGdn7hP8RsjjhuC2zJRofSFRIO7RwASKnD8+huwmQoGaBa/ASeSsu4mEIhWmm5TvA1K/YGLJQJSZ3h9iEqNUrpDaC/k6SSIX2aZd2UdOfJ2lVPL5EBhSADuFNTuj+pVcI0IiiGxfCk+FWYyAAwWj2Y/HLsLAnVKQaJeYInfBLVtikAw7hrwfRi9N6SCRTvjNa3HzRX1EuhZYVlz4R0ZmT7O0+YHTgjWMUxbSiHYPqAsqMktCxyjc47PdUz1e8vdVI29PS0ute6bsgFKP5dQfFnxcQpk2wjnidRg+IOHKS/tdx/h4RWEf1tLTcl66vWzPcE5jvJUZVAORAPusBqYIxKkDrKY9mYhfN+R8KMWJDIImShwaipTWjdnpSYr2xUl11Ja4Vk+9icFqwTcEEJWMriFoNXWWtg0OLom9WSPGwt5ACiDkDAIkznk6XD8AKfoBovbjWfq+Jbl1AiDasfNVZ53mTyhp1bfyGJY68It1euhQ9HQwjEaZ1fRGXkHnhkkThdEWCme27utpiyb8s7sC1+udd/0NyX+PoitMVCoQ9Si9tcNgM4DELSPwmKlLYdJnOA2dU4ExZq+3w3A3QuHmTLrCeiUqKJvV71/uofrMyLR7b/eIPvJQlKKJ/Aul9P6qaPrmpxPP3C3PdmT9+mWaPBM1o9xmzrVP9ur2+FpzQqkKPW8eXXLAVGhSDhzCJJ/z3mK0hSpJAMm5JgEFcTfLnQIeA/hjhNXY6iCrHBvcKzlyumsPXm4PxEolrjuTlL9IC519Jdg2/m45Srjuf3etUHqu9eubWJ05NAY4knPCvc5XFH6GGXb0UFrG9IgyI9NMUpR5AAehTpiDDbayfe6Tr3/36t/4x4bGC3OOFX7NctCawEWQTjgLLs3SL0QqWxZv6i+VomijSe+5gAbNBCyOUIw5zQR8xWU8mR5kcBcWnJkmQ9Kdjgmwovg8cVppfPDl4xH+KlBfAfFj3dLWauX97kZJnBR+icGkeKKY+UTF4cMhfMJqM6MmAJUc3ZAyooR9XTvh/m8tygdmCdBPvZoMOs0dEtn41Z/qqsk0xpuVA2BDQzkxJdh3qSIYUwQW1lIIHIypQlQPXYwZ9v2gjruMzrJCN0Cds1TgXumtRpNKzjMmv+YOsMTGKlEPCBQDRS4ihrozpBP7IEsKymioRUWSXAFQWfI+gabnA3O7l33sa9O074vbwuMRiUho4H70DdGf6+FL0pDsFqJn9NXLPXFqESVeS305sd7Qj3IrPezUKATHzIiz4tMS+i5JDHEXAJ+5e9x6QYxNTzXaF6ajDTj271vzcwfzOIcKTguAAqzuEeInJKuToxhcT8rFOetdJk2GayEoOWE0zAanhwHNB3oXVe9BTxow52UbReWUoOoAuqNfPtPfMHyv4Z4fBTXqBwQUYPKb6J2ACkSdj6ZT1/vNgIQAYV0IsRTlPffnad72Nf0WiBTEogK6zICtCnivWTJmRRrrNwjPTLGiy20LwKohCZ32FjlgGIEY2AcEOAg+x0DvKdE/eMs4vCZpDsZROqHrr+gVVH5JlMR7U+QD44/UEJi/vSJkp5+YmEutcYVe8aX1a8P3x6tD7anGvj4jLjZmgdOdXz1etz6JnjzvaEU8Df0J0QIQ3gZH9G1kW3gy/0OgZ05sfS/nrwR2w9Bt8dlO3lVRbDP9w9Yw/tX677xQmq1ztuttx3szV3cMTTsmtPcFxc89sdEe6tay8t3ENdR7Sv0is+/Gs7Omg8/U9Xl4xO3GnXkyf7D8z9wNzvkGSUL0+L2i8E5qe0DkvTn0UbQM8oexonLehAe61bQwktZHsQywCTUUbT7BZmjGA1jrlnovrQXQPugaGmOkNTO5nt8/1r/XeZdyIm1SU/YHgt8w0iKCSqm6lX/NsC8/EgyducYJm22lLBhXZIetyELMs0nzJFosliKzMfpEEJ0Npk6AFZcLXBgUWrG8oqbKbY69xwiJzwwTPm3TZy5Gup40hsfCFJ2sK4BbMB/RRuLQJ74f3ePq84DwgySOydi+Z8joR2pXwQ4dra1viJeuKmWYtZU3xjr1d9ZRXemptEF5XTjhOPuWs9imob1llyO/iXwTwrsGsCv3hHZz0avHRn2yvBJeDfNwsiAD2M90NHW/xPIFWnrLtLmdBpzsUUcw1nrhjeDkbzhJN2Ylpo3M+Rj+3sWEBbcYzAAWzlC2YjS4gr0cPiRI9snIQb52Sn9dfhqbgf5JpuT+dhIUUnaM+uuqoyiRNPqcI4wYc6aAoBCRKV7lCYGSHAGhOviMCx3BeqYLxQfblDJH4YAJ3TtOzVCTqOoyd3QIZ4utW5K3lKyiqd8Z9D2ZUm9Mk6SXVekIwCKe6mAYPkoEqIy1YbRX3vxX96m6XpuCxN1cseS1PQIYhb48yeeG29A/Vrvf4j5v6xRdYlbSiGYlNSUcg1V62Zxh1vrVapAFEyDPIC0EXcaUIsKso/HRgI+p41/RTp9nq5nHEo0qxl7cUvc/fLqaN1o/p3a8fKLuRBgdrTWq7DY5ystHsKk8OePfV5+rfL/W1zOcrK2EmwseU+jZULbUOp+IlmR/6IKA2JIkBSTTfNXIawn6+POhusWftVZoL9Ow6zidQ+ixu2osbJIr+Qre9iL7Gi9qMMN4vo7mWs8BPJW4VfqQgZkOyUws32d95lcDR4ZHAxJgjRATCS6zmCGxH3DHww0ApJ8Jfu23vNPQUC301ydKQ1VNP9J405XxTQ8xOWNk33n90gMRNKoVQ3q//CjMJrHeE7d5Os4ABOHO1su9azS96PMsnXFe6te8xFDMLCxKyLUtOh3G54zDwi5JtxgSunTsusbr2ueUt87TbMA2agibuYbaxRTCa2eP+ow/vPpNQPFoZuD2yVJFVSPThLzauukH6agkVIHkiKQiRRnILrpsiJELd1mrgYIoKUOYnphUUvz4Zty4PMkOk7ycDO3whAeD1lffgUdU6twv1meiRapvpw9ZmVW6shgjATF1uOZ1xsK2N7B1K3/vta/z0mTEu6JnEG3kzFSeSuFZdkT5+3p9DAvVOtmN8JzBdhScE6QBa15UGFxaQZJ+RaaQ5l6nlqwJIG0dn8g0MCXnnHGAL3ZlQArQfngKSWw+0fafUksc5ihs6QYoUXE62dhf9n960rewlZArLNhOwkTXROTtYEeEWShqDnqWDb8UiRFsMOugFOrcH1piRTGuEdyxygjGqjGwS1p+0LZrOkJ4eGxVzMnzFnixxYBuk7PP6wJF6rPt/h/Xy043KJfb/yqmwN9dw814m1eRb+I8daL2d6f6TzwQ5lc2YFEIJVEpMrGceQ0akcKhxmaVN2wxD1BPA/dZG6pr96PjimK7ESZuyKMZLtdrqM70R1ng6Z3jTb+0eOFolS3jDsnDdvUkWoo+wW75mc2MNjiVAc1mJy2C3b7cosavGSATFeOQmnjuDJlJ3/2Oun5nHCAzTsErKCoGIFhHxDEauy10ebJjMWJy2KxDS1lhdlwpIQcBzUEdklypLsyE1MdMxQDJpb337UXPa/jw/KTeG6ASv+MCzsh56pfpK4HULzvSnkYq1ZcRwVh2JzMMrPBmdNXx5mqPHEktSb3U8tsT6yzYDK0XRUHHWzba7azNUHiR0WJ9TcuGR/cMt8JCs7z4zDDBZZRmQJES04ASGKDQkFWUrKYOBoxIcCF4kQe4ifaqpqcr6/c5c5V0AyxS5Uba+ay4ooYbIqgDFF4io8cwki8050MAauGjIiyUuPsBhSgH2jQgPG4pefq765k3JenMSIW0WxOCqzPBNvpR6rLtEnlvuPmCvYtFBovCLW8Hg5QDpM6NgIQh0SjSWN9caKSmQZNb9Gim1Er9nrbwxeMs/noQ3bkpqk00pCB0uSUExNdl9xMkCRAgE1V02LSHVr4eOnnbtA2YuNoJC6+T4oHpSXX6qu+fP+zkXuplX4wrPJYMPqqJyk8q8O7/Cs2qis46grAPnYXIG2grmMDsty7ArMNSqccy34xqqORakEgY4L5Z9QGv615f6fM6/HxKtpblzGULAU0n0zAU44Lnj1U5dtCXtjUiU5M8URAIgW5StKoy3Ez83XmwlOWkT/Klq0UlsW+dptMoWa2UNia/Ec7WjNAeZZKybOaE4QwZ76YLU6uGIukawcR6lmHTItUTrqb2+pwxPD2XlYnEe5WCDYsLRn447iN08yv3yj+l+dk55M6Shlu4MudsYNJEiLzn2sf5e3n2Qi253He33evjhnzuSQmBA8eOlku+GXzvYfMBex0pIvHmslyhKZq2erNfP9gfmegB4X5OCy5ltKGKMolRk0RmYPwiuQKpQxkvRJSFVkoCgT1m7a0RWxPMqSFLVJkaJWZY6CKhUFOWu/naswd6iGFiCG3m+7eoCAP0ZhCx/N4WACJCxWB4XCwbe0vK9PGffyK9XaraUiCv9YUHw0KD4RLBffGIXTPoaus4+pIKvNybgd/FjQf8C7ke3MOx3xb1hHBeMGlricDAc2d4mpuzDOLSJ2mAYyYNXWo5naLSd2OBw8d6x3gBIVB0JmKaiHIPRXGKPdXi2uXnu4YZn7yV7/vjZyduggLuggMm8Uo7ZH2UTkbj2O5O9QX6WgF6t6kYsID+Ev6nhHXS5cs4PPBdoUDG/EhWSrABbKJrFp9eZJQQoBt1UCuiuhWWssYcfQsQsDS00lotDFPFOCSPQ6lgLBNVy4w7ZPMXwqrmdy5UVJbeMht25OmrskHTJDwJvRbi3nuddIkPtL8FL/gdkXZhaBvFT3juOEkQGIUXHAYMRDv8WsszeTTsMz4cWg0FcP/j/Z1Qdv7+o7vKsPTrqrD+74rj54q7ua12uTARoDqC6zMYan/MQKuHfR/oyEsCCuMhKuaiV7tQABtm3SzcKsto69kP5cFCAfW7Ve5pCuOVgMfpqrqHy4+r3AV1QIhbXTk92dc52hmcRbby69VbXHdA04zWdanzmpcfMrS0Axl2TxxyGEosCkPiDFJVz1LpNGjHBYVnKE2N71KiHDi5m2hRZVu67WBrtmmOalS2+u0yl6mk6e9MYcsnqbepZLaXWVuutz45nqxzshj8Xoo9JpH7YzyVMnTE2P4zyr0ZDrx4n19gpteu6WOCqFARMkyA2oWSc7Rr/V618WTR2K40QJjtS/PloZrZmwAVojvRMHV+xbqAYAUCNFHyvdGOvS8dHK4OvMn0oKPwZCUtVCeWDr0mfcp/SNSZDY0iW2JMgMzIQMozs6Eil534/GMfGFo5U5Lh8JnolpJU/s9W48W316RViLownCwQtcmwNwuiTC6dJMEG3rYjKcXoGTHrKP91DT3XdhjW0yhVc3Z80WcDoJ3UqDc+aMJeeLPNLUhpIcTRn8nKHfZy6VeeQ+gkpBTA3d6914b/Wl4FY/jMIabmKrgPPZyGBX1mw26STlOD09Cdl/DfpIEI6I+xxtmEvmbggWeNCLsSc4yNQGVcHSxaEx0kOq/KsoeF/1u8FiYMHcY7Y8ju6QRP3hi/0XzXsY2sDhmmEfANFYROlH2JTG5zLF1XtegC3zC0vmC0ttAcwxnDl13Oo3WCBEHVVw12Xocq0TuiAiEiGTywhoRRw6y5SyxlokH15t+bCm6JFtPIxiJvkVPtMuVJxaS5jAWmRwn3g9ly9HCmRZT7E0WLMqYkoAyuCgV4AEURIQQFfQu1hSODSRGuXCiAhBkY/iLoqbfiQ4YFBkUxaR6AgVx7kJS1gEk7wDlQih1pS+0ZafgQOwCYuEe2A71Wgbd45chJjjmIAIFG0vJq4Uv1ycw25oqNFQQht7Yf8LZsNO++6Rv50lsNIReYNLNiVKosX5ajpeXNZJAqNJJEjsvjXq2/NmS+rGKY/dxpP4TarP+jjgG89VT3QPkx94EU6zrukOqdGzGkranedvRxbfOb+XdamRkRfnndVu448ecQi4+9mg7d/vzBKvs9rspjZLRbZ56buCzkW+YJR/IULtAsTjcTJtQkIaOSYwmUP+ClRSqf6RZkRGCCkRCtx1WBPND6Xwn9pRw2714ukOxp0OnunsBL75tf3L/hx4Q5s7JxGcUCzNHXLSo86sp8nciacJwXaP+Moykub5YriIw37xen1T/4G5H5n71gsMOc5dNa4LDZfjr8dhMLN4ugfkTAIv6EOzb820Yc56L199OB0s424hjQlxknOW07veejY8CK7NO4pX5xzFg+CDh5zCqyc6hQfB7pEH0LckD4K/c/jZu/p/8eyBReuwY3d13rE7CL76OCfu6ilO3EEQHX3Y2g/IYTsIHj/inLUvYFk+evQRu/p/6IgdBE8cfbq83pYHwbuOfbCuzhyszwa4jJM2xLPv4XDPm60o9O9Jpxo2jJMWMGf42nM4aBu7ttwfbR1lmn1xhWQZtLML1jUPtdZnPY6dykiahqfMk1nGyI5iJRm1QYSrUX+U6IU6C9VCIA0HT5knizJtC/fVTJZsxldzlPIigDCl5q/z/e27zTn0tqiLobPYR5ugGI9cXZnNjobeJNTGDH2RDAl92evd+ED1zK116HQwOW8HT/c3WtAI2Ak3XW30PB88yDJisbI8+bwf8MC3uIxkRt5dGsdZi/jmUMvJ8JgGuXusbXlWBgT59GPzW4sOTt7PA205Pqzl4mCqAwv6Of3Y/NZm7KIzZiMP3SqfzA/xL4L+vcZgBS0LNDkOqRXzgBkwbZDMJHWmnoTX3xjAgJR3gBVZTEK9adZsJqCI0cpe78aoOuhBieUgxD+5Ih9/y3bezy73HzPvgPOWZlxBVV2DwC0TlHlIyMBYftXVuk9at9d6tQammwxmjfiTBaQBkZ+1MHwcT6Qxu5IjgHMIjixhhT20tH2X2ShcD8Rs2EEwD7YCTtab9nrq9bEzazcM8jjSsi02ik9mijeQLPvGDc+yCruxq3otAGJovXHhotBJc7I/WH1s6dZFJLuHzCfOpCRXeTg7lIekWivAhlir2rSQUWI5LV2hknfP02G6m//uudN5VP2SmdtuFWZYrsCxt+pA8M7HD8JhNPDcRQldpSVXGVSUYN8q/2iwS97Yq97pHa/PBb28/EMhfNQJ++Ja/3HzEFPj6GKxNI0l2dj1Fgdiw/xZ4XzERo4yn+iTHkwJMOn1V0SY9ToDvBbBVXWX4lnL+BQeHUYNvhQYt/a3UYbBDd5l8jIs1QOEA9gK9ZaCAFyovK3T19+YdYnjp51L5gL1SzJV8w8WMzZO2xAHD/VlM4BSEFp1RuAeV6T7aGOvd+P56tOdRJxhy35uF7iwrbqw3981P/jP9e452/TmY3DO+wEzxoYe69yCHtRoqQzLwXIZluEx7cN3uoMZ2C7cKD5EFqyMHWjMVci3neftgq+syIBojh1/7g7u1NwdnHxGDk44IwdveUY+G5wxG1g8ecsDS26atVLpgk8mHb8UkKPXRfTqRpGEJKxWzd3mribjZuJATvvV6mDHXMOJTbU6C1KnKJTgVIeaq7fokR7mr6x+ueNh5ua1MxBgB0o73d2gQ//UUv8dMvQwTcoa0OJWAKyj0J7BvZEmEBAFLgORJbwNEqB8VgdnTT8jehUTxrGeM5s5NQ9prDPsc2YTCCL3x73ejReq3+thBj66dW2suSXDhz8WrKJnOEIXutziy3gKFN3+NrKH36srMsDpUJ43u0F48FZaPDisxdMu0fcqolcqrsF8ih2iNzVxzPuPoY0iHNa2sOI+dyUFYOQM6yyKWJb6afPunBeTsm7YqAGhNo7wSBjsQxSPQnAsBkghVyUslbJCeoHsnDNnimLcBhzseXO2iFhR3AfuPiGRQHQsLm3NAC5uwlJ+ByBGWJe2pKa317vxYvWfWBfnMHt9ZlGCYnpfxIe/sDKOT8GRqAv1i8v9J03I4qoalrEsz05qRNUfpM5qJzBjnjfPuZB4SHpoCWKylJnEEOQub1PJAeMjFYrVlFmYCIOvM3+cpn+K5+IxciESZe8D056KKVf+BQAISwBfDU0mQcNc8drp7Xg8dFFGuP+PFG4vVV/uCDdSOdgZ+3S1Gcft4GL3rK/IOOETdUxH3hF7h2ARF03a7eDh9i2PNgKlECz2fCJpPLeD6OgL7OwYy1ZnyM+qyxAd8i2M1OccuTeqbdFy+iKrtrwj6VI/7mLu4tLKCjKYcUlWR2vmcTMkqXzcTEg+wQImdQYvEOgPdl3hpcFlYYrOWb4D0FFX2weleRau7qZZSzPuuL3ejZern14+mjOOSMxemk2nlVFBQyQHmSrporyz5gFIrdNO5Odnosdbph/x8sn3B5fkYowgqiLrDt4xwsU3qs/PCxe3A53dWXcuXPxTQf+CuNGsVasNy2g2zBryJSH+HzOPlGGomn3c2g6lX8HsaJrwGzerX6d8Jn1cd6lwpJcBBjztmD651H/CPDq2mbpGcLnBY+AyIZtgZbI/fBr+idGmuWVehQzLRbQhtzb3WXzrgr9H+X3v98woskS04HdyuhyiCIajuIy0K6P17YG52FyGxX7tDGOkfY42d+41pgzD2S5uzp3PV6vfWTpyPouyPRrJzN9XZJbg+/D3nPecv+eK0y/N35BkltxGDaYKYgZsxbwn6GoWzNcj5kE8Bjk01vJjMSseZRRGDbqLj0GHaGLgwAk1NTmgg6qbK3mbwuAEFAY3PuJRGGxea5Zr+HAdfSxYyW3kBM/ngqXcRifeD5459dNwNt0D72CrBjXuEo/i9kUzshEzQBROAoIiHDjSDgnociKaLHRDpDANJfyWaKoD5ijbR3uAO2j2ApXjI6XXa9W/6gD8oo71HM83tldkLGKJAqz9eOeYzRXtjM2GwWmP2id6/YfN5bAL+4rzSXvSCFs15ixSndRJhd8iBzIfUzHFL7bPmA3X1Gj9ELyqkvLTTrtVfRsz/K8skkGroMDx7KxZbsbTV8T4q0G/b1YLevnNuv7/QP581NrffKbrv/9cEBRvuSvfv9y/Yu7zBV+WlFPC7yFzJRMj2kI6Qaeggp2yyjCYWhIT8iHGtMg1gWwuMOvjBCCT2e39tyXgsSTgwNzTzGuOiN+kLhvvDmZvr3fzvZ5cvOuaDKic+NIxg//BbZSlLClPvFU86fixXr/JHbS2bjhZQAjB82UeNFesaB/iiBK2dkyHVGemvt5UdslwxhrAASwLddocufHfV/1KcGsFoZOMURICTVo9/TBLGamwd8BU/u2gf9b0tYIDrgWzY65ZUhjmMFqQZJIgCUI2NEw0zlOqYE7gBjUMne0e19V28wPVL1JpPaGnx96Bgf/YUj+SqHwYspQkAj3dTG3gHSX7k3jNMyYx9PDgMaICrRLxpY7uOHdJ85DVg8zYrJPkk/CvZFkGyiryUJp6bzZMU00HkVHfZsX3R2e8YHzWZuo5pHRGODGcsHu9m6Pq2VtrYXPg9Zr16v3H89RS52MPj3MfrwJ7RdNpQYB86jqO3uoaeof4S0v9+8yZNqIs4ONqCXwc1ZrZM++hPEVdyRSwG4FwCEwBa4aFwKIBR2obQZpkdS4kMNRp2NbgT5g3cFPIMiKJSVAkklRfF5ED5ZI4QTuE1O4IMELRoHFlhKyrgTOD7KJiHOZ+6YEjT81e9fNN2qWXcGnoiNHl7AKqluI4GizHcSScab6aZOct+/nEDQ11cya57hSu5pxXVpFZlU6cFtbdKd1n13VuTnuCfzDoNzwYLEsFFOxyE5g3A8mFgAhDPXa5BYcMkAN+n8sf+IsjZ/z56odWPBXlLXf4tqiNLTemdl2Ofaj+gdG6aSqMwWvU7FWi3cBZw4caLZJ0zJAx25ukdICLYQcRGP1hqqITfuVG9pXVd7G8wEOLzNw+eglhl89k3vhqZH7ayfnkklxELBAkF1ETyo3ImoAtphgFiyxf4LRhgIpd0vwGAmio1OY45IVixT1i8zoda9UVoZBhpnS6P1g3q9nxDuEL1S/MzX3uxtIWXWRM1+tFdnv1WnFVWG/y/n3tAhz6Gg0f92a3JKkf8ci2l/HMaZfmB3v9u+SgqYyV1QFLSGTD0Kt/JnEPJRTYHzxvPoilQW1DyNvEY8GCYsUa6om6dGQdIzL2ZAxoR1yb7U1gXyJ8LZEq/ihPhxgHcmfQOv80tU4vVl+gijFXMnUvpHUd4mkn7AeWWNUCdp2c9FT5yBo2HHq39AAzQYsVSMkcQb85TOwQ3msFVCbwhiFikLaJWu+Tey5NJS+bQEEtUG9ZI1lrWSOWMNZiGB47z5pniMbzS19cMOfSVKFBfGuvd/Ol6nsoRYrOVvQcyVfG9tCRTIsWvgoBFITOyTYrnRBHPP16/eR6PzbDpC32F0XXI6syetaJtobcndEZ8y2B+VhLGg07HzqcLSbIrGLmMBhrtERlklFaNQ8XzHR2jzf2m7xaUhtBOBd/xqNCrMtnpRqEY3gYjMz70gySMoyiOgP0xjPNsPqSCxyCcCLhfSieGwSjShSG0Ja2r5mrjRErvyChMkAfT9dMTRw2owdaBd5fXF6Fb2RzywzMPakwLFohr5NyFAxVPwvYUUqAYX+vd/Pl6pbv/3mkb9pDOuegLiVJAp9POc/n09U2zqURaomEkXiXCTTBu1/Xf2D23RmRS5PjbErYIqJ20XBgOj+Pw2wyHFyY+V00dF97/0m+dh41mQQJwkuP8LMwmC7Cz3+yKa5+VF9qvnxwijk6+EOdo4M7NUcHJ52jzwZbZj2NLLejkyJbbRHX0drJrJKPryiOZVZ4wAP/1eb1IisoOYQNJt2dzslVF42aEbblhwA0jQ6LJtY6rAt4XxLnixp8lRk3X8BHREjCb4VbNuFVDVGV0fGDL9mwzgtIPyWnityHPR/R286uYzu7VAe56bm1zLWWRrP1axVZ4fu1imyRCxQ4wKzIwDkG5NPaUfvwE73+/SKlLXieHL1ZQvyyOW+2rBsccqoH98njrC/VPr56SPhZx/lq9fepBvAut7N3ub0d3N/5o3eX98qZV9M7aWH8xno/kflnaKLYx6S8WYIcSoGxSNpluRRN0t00/23J/OclmpN4qI7zsIYiJFmfnuVPCtiws9sEXjKUgxTTDNVajGMbk0M8YUXASR1Z2YVikDChRtYCPes8ZRPoh5oKnO43VFGUKa5GJTQsIUVD7d9hHWfwechN2LIDHNUu2yQ43PUWaopku4eEejC3tkk8bt2iQ2khbpXSiFkn6njHj7g08S51QwXjKmyXXjbgJQpm7ir5JuEA7Jb1qoxybdQ2gSeAuC0i74dOFcdaDx4SLsAkDENCuEotMJHs8zHxySG0jI9TX3Q1d0YbO5fNvTbU/BWureUZWnPhHJjnJX1zz8ZmNw8Fo42PIraq1HEYnRLwp/u1mO8FfH+be72bH65+e/XWebwWSqkYyGpF3WvRLB6jOVf+kg1P6NyzaeHK/3y0RcIuKHj8CGlauFXgTsJuARkvlotLM7jefWz6qWY16nzoJ58eC8ORuMcf7wxPOztVajmYX1UZ9k6otwgjcidTBD651L/fXMLaku6hTgjvgyqwYc6gKLITPQCy5nl7hWDnZnrRNJn8jD7kjmecNwlMYkHi5vv2ojEQBUnYppg46N+GWUsicoTfvFX9QSBeYcT952Nk2nnrzG8xvRy2sxzTj9tEH+/Kdw+lFaTTn27/6bSl2OjTXgefXO1fNRfHrkD8WKuieWW5TBMiaHmjYlLu4XBiZVBYWxeTfuJrUk8jLhtjN/ZJlFltkM9vg3o1dBmHYJQEGysAQc29ICQCnasWZNBoJFXpM9rY673yTPVlj2jxE8FS+I3h7eCHg6Nn810YwwTAoLo7TNJCYIAy0qScDC2eSbM6S4dQvgcvdV9O5cX8yPf0S4QGZkMljuEO+Zmg4wnwVIBXtIM4T1GMQBTCUmMSScD34Yi+JVQH2glmojZ/ZH4qWMeal8FS9jUnbBaPgbQCDcesMTM89BMcWX70UTnH8oQlK1PyzbkWTXx90ak87dH4zaX+0FxRXDEWDFskhONAUenthjffYP6M3Hm4fZOwjkM4O2BpYCogXOAVjPFvMvFKKug9iRZrNVEg+6m5YwHptnIVAPB2us+DBuy0XPdJMfFEHW5KLhEB1WEdZ+pLKzV+m+1vAy8t54+ZSDtnzVbpfs7252m5r3yg+ss9yszcyczHFm1Z3LODZRvahCuZdjxZnrP2klb0EpmPIJV4qYfdvLhZubmMybkjq/675/qXzF16+5T7MBghYmCw/tiK+ecrOP5hyjTkmJZTAsA5bvQ4tMzwpVaJKwh1+8SDCc1N3Md4MKvLImaKRxs0s1SbY63eqrRSmjgVjyPQ2yWIbEI47ZKSwJJTxmYs7UovW6ikhn5jEAkEJ9sGyZY01QSw6SylRkK/ZMZoQkgobtNQyor5kAYQAFgshMzEP1enwBoLu2SO0vlNSwlFSKjKjbTE4vCiP+vQIhlazlImCdkLwNw2DpHV2hqz7TwlOlEqk0B3npBZB3aKjBMu9WaqCjQGXbs19Rtp3FJ5kVA4Ek4cJK+Jco8fMOFC6J/TkVOM8R0WyRfkF+dJ/BWh0vbYlJwMnCZU6VJDgTw+tq3gC1ZcHR67lOrgHKi85X+IrJb82m1KfmEEoJXyDPJqbfD2Nn57G/+/v43f9r0d1/f2yg3P9ybVwhbEkpDLsBRHYRggYWnBA/fRdxJzhmRdSS8x13TBu32QpzbEw6zSEwYHR3UCjD4L2mtTNzo1f95KGQTPTv27y/2vMDthGCIIZkFjD1HBYC+kGjR5RA7DYSdXSovBm1SKhkbwHeahbH4bCoimZkgTDIiiFqpgFWaEc2bTpU4LjOFBc8XvBYRvmAqYP6Vze+e8aGnOMzNam6unvVb9zPKtc9AxwzqibdclJXhgio8+6cYcF9brX8NchHVxGInoXOCx23vJTJsrmO5FFSXtlKIPK521xbsP8p/m+U645PTJj7+61W/ykyDnY0lQxZR6lVLNz/XMz/agJKbi98oUvIFytRgeMSws7wvpmSUOzxE7yFQSOaRG3JhDKNgkuQZImQNMExuyiZXkQG66Ysr4AJodg2+Q5MzYAgiMK+JDOAuhzgn3ZUQMCfoKsw0AQCZDuo45nh00H6ck+E94PRObwoK9bY1N6J6IchGPQgRLqhT9rmqwRfa+1q6D2exGk5LyhuiUf7xu/uG6w05MZBnAtaETR9PKTTvalosYVa1j2lSIuiI8CVAnzSTQF+asHpPWpVhiZMFGUxFLRJCj204ifDKKvbLHUgeY3IzD5pcRnmlYoqzk22V0xIZ1WrJFxp3wEfkobgKx6uMCbrLU1lnYvDRWuth4oiGsVOqscQw53cqA2sXjEKMZh7jygbXDZQ8G2AZvV6Itbg3pXVKLDodX1M/WbImUyXhyDaeFUzMA2IrLCT7Dax5bchw27SpqHEFvGYvMQ8pYHd3eKUxkndeMlJwWRXYQYmOR6FApJyLytkcTXqYYmZAEiE8/h3iDzlgWjuET/2GgoImt4zYGCOUJK5HmPEbYDtEY86b1ekCNmSRu5sDnMmQbhLACRYBKYdRVolSd7HFdCgLFZS9zz41l15caWsQ64jdYSVgBWCAZTYOFpJ6BSbQl+mFTsnVqmQwt6AY3Par6IrAl42tXKHIZZTK1XAiMDWgHrF2tAZyUFFq5ChJEv1IvBY8fQ8yFrVv4Q2rcAwl4ceAMwg5MbeM00uI+IhO4LZEHkuu2ZHp/XSClrS47ewfaDXRN7gqUS8PEpbAMROdH6zCHdsUcinl0sxCcB5jWGmVQMcKhKlRHhe5euVX90lw4Gwusd++2RSF5ECJg2u4M6OwnlvqvmOfg0U4lQBdrLCaXH9OUaX54wMFUkHZYM3c7FmATSuR3lRUzMFthluGwvBk5IqXmr4N3mEux6iPFPsHdvN9G/e37zIUC9wlBbzGjQqujjZ2vN38SfyhyEmYXrN2FfZfQuSi7ldmesqbOJ9aU/sFBh0KLzPUJUyPjAsnmWOtSC0Gtkur1irmvwN3CIAAAZZFj714dbTx5ydwVueI9SjC0MepLxnDKaNJe79Vnqsc6KTgrMj8Llu6iORtryEJip5jUo/TLL85k5uRFMgWKmJjXchkPMnMgbzKeJlJUqRUrMyKZBoUWOGtOKIpy4rBPQMxQZ3FR50WigIXBs+appnkFQAhcH76bolQ6cGmGh5jXWZ1bBsHfNryOYXhdMOfyIkmjlnoQqT2v+qk9566x0FkLgMiLxEkMpD0WyWmMmR/p9R8zD0KRI4qNvOyYKNAvhKyAAMfmU0ZD5hHU1KRRQce4lOTpgnWFYaVgA8r9pttWanpfwtpErJ1gWyxdS82R7++cMZulJiTn+/Nk8KvPVU/6GLgnOuYHHb6wENe1EonYFDurD7/3vXPELSBNYcReHmP6dO5+Qg+qLZJ6AXopMaGVk2RtqWDrEiETkDMmNU4IdLcm9uHO32vmFb6VpPRrwV2sjBoZNNk44Rln7Uj5ZaaKpv9k5jX99rE88ljqJvuAdw4lxS7Ni85JtN2TaE93En9rBbup2UaIsWKOIfaHiv2s1sx3BuY7gjgJeQwRJ4oz+tzge0ugZiHjTDZNbMF83uw6qFSgNc4RAqmLUpVC/JSyuo8VWAsqBZP4o+Gnz4WfXn25FiUQGjWrwLVUso51tTb4I927tzf+sTf+B6cvoLKz7eMkfBjuwXbnx0l44p2v2/771vuZuQ53AqEFEcMMTYEIrSA6jhwQUFVQZcsIx64wqNJFs6ADWKDalEONtqttF9N7zEIGEhlQyT34iNQ+T7k58WoJJbNRd1DENYo7kQyAyNJxpFzOjIf75VOoGW8/Zq5ETGFHUYqIMZB4Ultu1mZ4OxfMJnMWhcN0tnjkllnPciLwNvZ6r75Y3fRvxUcXOeVWYcOWmuA/HS/1IrIt7hdJiguLWPeytq3L/fO+185nLw3kgfQkZlKQuYaPQXS0Nm5iXO4dlktl654nsX1nxY6TYhEzQjLvK8z0DIOD40zcwVEzcvAWZ+TgLczIwYln5OBEM0KJ4GTBw+YyhKXsthoZdxRVWUnADo7yCWTFj/RA2zLW1CqFW0ghpDpR9mTUb8oMjlXMks6JK8KkZWNFPkKHhTpVuDcHD0guZHMJ4xxHmpuMVECUXUL6Kv74+htzyy4NzD0MZlEkKWvxCJr9K9X3rnRYKNpZ1S2xOp6bVXUPGcNDIKNCzmS8f4K5+3SP9ZuTEOm78tWORwBpK1K/GdQZllDmwd3mLljFyAuxSt8wWt0G+22o7LcQP4sdJh9+f/V9HPW1zu5r//F33zjJi8mJqfOm56t7CyFZEsAX9v7QefoPPeQ3YRNY5Ic1M8TpGqsCSM83gjuSQzvqg9w6U29IzI2JGzpTWPjKCOSbD7MyWOP5hkLSuO1xIuJ9uq9Ha6N+S3cLB8Uh03uj+oGVW5D39zf0c0oUv8XvS6duB1emIjgdWExWtLwns9Ls9HDyn2+sTAlDvJmzKHMyVb4wbcoXbpurcutqgWNOOKhhlKwRGb2JYhBxNbaTlXZoYdJpCk9O2keer/7AgwG6KzCeGbsP3yRZLCconicKV6Do6fPHgmja+M4gkn61139ICCY0BsGaWlrbSJSmatV82LyUF1rzKNmvo7yVpAJRFEwK3PwoxQy9KS0cV0MGIE8BtcnSd8007GqVpZuihu6zUXWOcKW+9lz1rztcRk97WOn5uL17xbXAoBa6IE6tfDLsBhgPm/IgvyPz/SnMN+RpEbaEBJhFm9VZPCQl+YYwhGTkbduFq6SOUgfaI1EbRcTgKfMVWazP5rxAoMeCHa1xjyKy1tAi0BOf7FJtTfa3N5jXnu/PmfSr5nLJBIGyTvIhgjyTOs/Y+WR/r/fazeqbvWNx2in68mp/21zEHlR9vtBS6ywJAJXWfMi8oBZjHId1Eod2WKc2LPIhfccMXzCnCzsMgQSAPZpIXAMdaXXswXf2zLf36CSOk9KVYUtQ58MiZ7ohB7YylZFYaTCM5dFIo4Ngom3AlKT7asI+SV6nyTApU6936HwCpkwhFhdjhgkZmUazlOwFDcO0aF7GTg4LiehEaVHbguDOKHSGHdofY3ig5oQ7OWO9ngZWm47FuztMSut1yMJuTCcRfu1lMUEIOPoDTNVOU6+lQOFSxg0t8VzYvhaCNSNvilKpf42ZFOx3uItgXx2l3LAWxzFB/x18O9mv5WlkV7fbmnUuY2piop853seNvd6tZ6r7fdHwaIeztANAAP9qK3TnpmhMs4l3X3n8aKGxVGThYbfAlArt45HniqTu80ExVySdN/0iU7o5HJWTOZT+9hIoNHxkso3qLK4R61SBBV47yWyLFFqfgST8PsnDyBF6k02R7hYzntpsnpDZMGtZzJSJWy9Uf6VzyXaBPF6q2wp1lONdmDROpu3WjkKTc/oPTtbmwXHaPK1Y/NUz/W8yf7pb3OXNDEpR5hUxkjzlmkVegTGKWQMVbpF4t/WLaIqeEDQ3BULlWgZdc1sippW31VK1bH502fzIMgUXvReIeYExuyBvSJufB5kIN+GQtlMED2Ac1XD/NTYVHIeOChHeqVCj13TIxGCWgbGAmL2mn+Gnhqu5jlhFgk4rBtHRQRvLV0v0EGYLC0LJ13A1plkbdgZ6CTwxEJVZjaomVr8EWQsMBB5DJWXevENHYiUeUGkV0tQKD3KKkce0G9G5BvGI66acyN9jB9KKPZ0WF0E58bMXecFxTuEZamIfEqMXC5NFwph0hxuw0KAoZwMsBmoniFdP7j7tgPafuXHAyTqG5TrOlUG58EKo8sVmv9SFulD5LaSZpPwLyhw3ZG40lLGFYCrnXp0eUs7wpti+Yu6X6C+TYwFwc26wBYwZO+bRInPYIqCJQvpp4alE3S9tvr/Xu/Xh6ls6wuUbpuwbT4enlxDhS9zgSK5LykmhIwUlQ5QzG8Xipziv83QoKeaYQ+I1wP0Pewr9GOdOCj0+o7ROY9D8FDsS0Mwa/uoyuh18R7BIBu3YBFtHVoZnsZHQyKBCUAmwKIiJoR3syaB5dBGeyiY2FNlvhzWo7GUH2Tq19K+7ja+73n2KdAHuKrP7/minrjItX/nxbv3CBfr8dfQywtGqVbBIwQDpb4sbA5ykHA52j/t0jMfZkS7hp+99U1TiZ4Mp5cLbO98aRHUbzICqIn2R/vFr6nemTaSxAD4tOiFnmIfK0wd1a6W6r0LRarG2JdX9zA4btYm9LecpONPzes6qixrlF1PNqEk6vsjOQPv4ZgrNthis27So06SI78h9908DloIIQ0dZTbvdyINkP7177kNzBIX8Zm8pDKPqU6uCU76/s8b+qXr9jdN2/wcCkm0puKXba9/G3fKfm+3sZ1Z9UPVsZxs16LT9/ULQv89cgFUfRVAVGvgUYvgboy12/WGzjU0KxmKEoSE6hszyHzPOciSxl8z/L60uUHC7GtZq043TDu3vBf0tsy7sVcDSbC1cCL+3/faNZhl+rVkGsi7yHJHtdyrV+dR7/38E/YG5mFGFowhxibPs/z3znzjO/P/Gquav+5PfHcW6slDdDh6auavghe8MOTvtkL91tf+8eTdYm+T8KtwsBdyMZQty+kdTEjoMff4kZcNXCJq0Oni/eTJOrDOI4zgH9YOzbCCIbZ3k8H1hFmoa57RytL2thXP6mcB8OojYUojQLK4iUE81FfGgw6aagZIJbjpMKMszsoY1sT5NO0ecQxF5ojnHCZCtXlIlfhmFcr3EdYFSZyS8QkKjqGX4VcKmU6mDU3AT/89Vd7O1O1gFyWqU1XEywx/i3X29pLwd7HROAP/p3iNr0RiVd8vbwbXOw8m8U74Cr6Gw+HduqPmsB70omy5OOPe5QRTWNm4nJkGEf1gndRq5oh3xIe/34mSG2OD63NOO+oVxeoSQOm+24qRAH1jy8/CzsNzfFl5aepK4iBn2xMwWP1yMfdT8JarsMOhhMhHvnTPYlEavvxGSpCPfr9OIj4opKFFvraJPBD89NgqRgrrRVA5k/q9439LrJXfcbzZik9XaOOX8x14vOlP5IJSxuU2ngv6Csf/o0dfGUhq1lVm6D04tsm0dLe0umH1uNUUZ97YAV7fN9p9ueGllbI/cF0fLxc8I9zsuomh/tHmsNfcE/Zea2X9w3lx0tvF0N9f1myfo6j8J+neZzXEUp+6yquZpPec6D1XzQi3S+c+vSecfbS+q+avTNHbKif7fAwDopfotN8ACAA=="
@@ -0,0 +1,3 @@
+package gen
+
+var CarrierNetworkData = "H4sIAAAAAAAA/+y9d7xsRZUvvmtVnT7n9Dnn3svlBtINJJFwoHvHbhxEB5VkIAoCOmIA+sC5CAroIEhQMCGCMkZGRP2hQ1AU0TFxkTBgAsxiYjAgGOanGFDEeZ/vWrV31059uq9cHu/N++f7rVq11qpVYdcOvbv2TWs8z2/5s612k8kXCoRCoUgoYWqLSltU2qLSFpV23PRb4WwLKqGYh7MtK0xE2BHqMrVbQmJgzdui6UuZLwYBcrHEGUtIsbiOJbJ4tgXNeLYtmr4lX6jLFARMIco64qwj4XYk3I647oizjsTZEZ+d2bYYcNs70uiORN2RqDtSbUeq7cz6ouLbMvHJTenMBuIsEJVAvASiGYhm1IqtVtSKQ4mvIxod0eiGTb/tc3ztgMetHXK72mGWCyUXCcVCiVBHqMvEXkJuF3Ki2RZNNAgkuUB8Iui2DArIFwqY0Mp2PJtYQkgdCUn6uN2Z7aKsK+ZdKevyXGh3Z9sIqcv92O6yM7/FmqBAcpFQlwlj47dFpc2xIBc3fV9mNygUEiFa6/uzIZz5s5EI0a2+P9uFT2mYLy0CRUxsF/Nc8GPuJV+mki9TCRQIRUKJUJcpaguFQh0hKYvFPBa7WIToQb8zm7SbfiBdAPKFAqFIKBHqMCHOQDokkA4BBUKhUCQUN/3Al5yf5tjc52kdyJQC+UKBUEeoy8QGIfcLcpHkYsmJl7YYtMXAFwMMMUh8+iH7xIEDoZj7Yu6LuS/mgZjjkAaJHWYkcmIQiEEgBpEYYACCcDaSGiLRjEQzEs1ENBPRTCTARCpKRBMTJYiEZPgDGX5QLJQIiQp3T4endyBLCCgUEgPuEFkmAlkmgg7Pz1AGHBQKxUIJE1yD2kK+kNhhfoYtPo5AkeTEDh0ZtmYDKUNHItdhwhIJEmEodrHUEEMoR1Uo5wxQLNQR6jLh9BDK6SH0JQifRzqUSQSKhBImdE8o8wXUZfJjJo5ThjiUIQ7D2VCE3EvhbBcBynEbyoIUyoIUJiJMRJhkwkiEqEHGL5TDN5QxiuSIi+SIi1q8voMkF1iKmDDBotZsNxbqNv0oEvNIzCN2DWEoZbGUJUw+NCXASAIEQVPWyKgrZbJUIhdxDr0bdbm+WMKNJVxQILmYCccmSIQYHOQiIauSCHWExCcGLpZpE0ujY2k0SFQwX+LWbCg1hKIZokxmSOxLLD5PWuSkrC1lHIvPXRDLDAFZYcTCUIRdRCZDHMvYxnL8xXL8xZ1MGIowFmHCQgxq3JnttJp+Ip2VtGaThAk9mMi8TmRegyLOoetAgVDc9BNZFRNZFUEh51hTVr5E5mAiczCROJMONzPpcLUdCaIjM6sjM6sjQXQkCFAguUgoEeow4egHiQo6shOIZsDXBR2JEwQV6bqORNaV2kF+0+9KtV2x6wYcEqjNuVByoeTikAlLZVdcg0ImdEFXpn5Xpj4oklwslDBxRREvXV2Z+l2e+kGLRzNoYwoLt9st4VA47AhH3WbQbs+2/RZzmAgnXOzPdqJWMwisORbnyBLEndk21LCUtoV8JvgK/dm4ZTmMmRP4Cv3ZjpV3WA+ttxwJd1kez7bYaywhY0VBbGFnNrKcgLG2tBLhtpDP1EYOYw+lcLYTg3EZIsz9EeGitNUMcIyj8tg2NA7YGchvBnGIwxQUgjAy0I1mOwgMhxECjq1PHBe+UNgMcAggh0CaAeY3cuFsO4BqPBtErWbY4g5k8iUXCIVCcTNs8aUIky+UMCUgHzMAxDmesCHuEqCJ7hWKhESl3WIVtgtxIuWcqLRjURFNXzR9ceaLit8R6jIFohKIsyBgZ4H4DMQgEGeB2IViEIpBKK5DsQvFIJQgQqkhEs1IVCJxHYlmJJqR1BBJDZHYxVJRLDXEYheLQSyasWgmoplIRYkYJFJfInaJ1JeIue3rRLwk4qUjXjripSNeOmLQlbKulHWlrCs1dKWGrtTQtQZSQxc1YB2ILMeWE8tpeVfKeTTAbcu+5cByaNn6C6y/wPoLrL/A+gutn9D6Ca2f0PoJrZ/Q+gnFDw9ZNBuJFY9cJCMXychFMnLRbCwGPAIRrxKctQ4Sa5pYI6weKO+0pLzT8i0HlkPLqX5s9ROr37H60r5O2/prW39t669t/bWtv7b117b+2tZf2/prW3++9edbf771F1q57beO7YtO2i7bG53I+o2s38j6ja3f2PqNrd/YxhnbOGPrL7b+bPd2Yusvtv5sf3cS6y+x/hLrL7H+Eusvsf4S6y+x/hLrr2P9day/jvXXsf461l/H+uuIv66d1107r7t2XnftvO763WaIE1lbKBAKhWKhpBm2+fQLwgC0/Vn0d9ufxXRq46Qj1GmGuNWHM17dmSLJSRnmAe7qLUVCsRBiiSSWSGLhK4IQN/CILMZlSdjm62UmXwhlnVlM3TafRJHzJYfjtd2RcDuzOJrafC+LHFaJNl+4hm2+cA1xy94WsrlQKBKKmyHu3KHSFpW2qLT5JIEbeF8oEIKBnCt8OVf4WEuEbC6SXCy5RKgj1GXCsQESFRwRyEkZ5r8f4aqUc6KCQcZDAYQUS0g4NzdDX7rOl67z+VFN6Hdwb8Jkc3DN1/VMvlAgFDZD3I93mNDJgY+rbuRwNgpkTgQ+nq6EuC2HgYx0ICMNSpphIAEGEiAokFwoOTiTcIMky3WbIS6Q2kJ+M8R1UUuozYQFR66SkMMyEbY4JJDNiR2WvrA1iyMtlEGV6xumUHKxUKcZRnIEgHyhUCgSSoSsZrcZRiFHBmpLLhCKhRImrIcRrliExM4XO1/sfLHzQ7bDARvxDSdy6PIokorkyEEulJwti6UM9UmXR9LloEgoFuoIdZkwoaMYd1sh7vWQk2mDXCC5pBni6q4l1BYKhLpM8IKLOEsRU6fLhOMvjme78CJDnMjxB/KFAqFQyKokzTCR4Uh4OCI8+20JtYWsMLLSyIqjVB4LW0qEOkLdZtSK2CFWv7ZQR6jLZMvaUuaDeJiZfMkFzagdSS5Kc3HcjLCOtIUCoVAoYmp3mLrdZoQVAJpijlzEOdSOh3Z+M8IjtZaQzUVMftiMcCxByIPHFDSjgI9rUKfTjHDY+M1Ipj9TJLmkGeG5CYTSwyEv6xEefEAoDcOTCxgkuFEH+a1mFEoNOGzazSgS1xE/t4wi8YKjAGXcvrjlZ+QLBc24xZpMvhCEoQhDEfKqEresFw6JCZq4wRFqC0HIPcHkC1lh2IxxTwdN7lYmlHFTmHymdqsZYxb4QkEzbnP7mHwhKwyFomaMcyfKpGHIQSjta0v72tI+TBvkwiwHL9I+zCWoxKIiLWpLi9rSora0qJ1g7sY4FaKMHy+AfAilRXK641wkuVgoacaYim0hXygQCoUiobgZ+20ss0wJUyC5UCgSikEyxL4MsS9DDIKddIEvXYDTJGqXnvClJ3zpCV96AgQvvKowISc9gTMbDKTtcpBwDkJpu2+bKaOJI6ct5DN1YqGOUJep22rGeCYNFRnbQMYWp0KYS8MCaVggDQv42icO+JFFjLMeNKVhyEGFD2Ym2EmLcKRCU4Yx4KMKuUBykeRiySXQlNbi0EaAMrbIhUKxUCLUZWqLZjtsxrIIMAWSs8JIKBHqCHWZ2u1mjHXCUijUYULUYRu3vZwToS9C32dhgIqk67DM2FwiuQ7nOtCUgwRrD1QCiUxmQSizAIQy6To8UYVdLJp8wonx1BRxyixADmUyGbBYwUD6DLm4GcvSxRRwDi2ShYzJb8Y41SMn4y7LWizLGhNUJM5I4gQlnGNnMsSRRIbHpVCRIJALheJm0vJn4y5TF8SuE1nWmGwuEAqFomaCFazVtuwLBy3hsNVM5LQFSoJmgkUJFGYUCkXNBBffsVDC1G4JJc0EB6YvFAiFQpFQzAQDUFtIhL4YICafn25wrtNMcCi2hXyhQCgUioWsZpcJNYDEri12bbFri107EkqExNwXZz6EvnQYDl4f7nBJ0bYcCLdt3rf5wOphaPhqGF7xW4LIfVsexGDpKhz4CIgvhROcouFTBjKQgcSVbcsSWsKTJMGx1xKyOb+Z4OAJmwl+qoBQqgilClDUTOSQYAqaSWid8UkBhJ7DRG+1my8+5eSjX/HSl6T8pKPnX9Q79pQTT3l587jesccxOLITTjytecKJpzmS+Ze+pHfKvKW+/Izne95NRs+Zp5l9zU2K5s0X1VLqGaMM9cyU2o3mzY8I6R/QmyapZw7TinrmcMbn6tOpZ47k9PMYj9CbU88crYl65oUs+ScN4xfqralnjmHJcVpTzxzL6WNY86V6CzrevIzTJ+rdqWfO0ctp3pyvEdDrGc/XqP2NWuk58yb95oy30ieY9+p/ZdtL9c7UMx9h//+mJ6hnruRarma8SitHcgz1zC0aTf2q3oHmzQP6WdQzj+gxRmj+t25Qz3gGtSuzJfXMmIG8YTaneTNhdqKemWSJYNOMsRy1j7Nk3MqBkwYRjjM2baku6bwONGX21+vMCWpekWVl+cUYGW8vPWcWe5t4z6We2cwjmjervJc15swO3hO9Hb2dvJ09lc/pdWZbbztP6zmzm7dHlk95wsrJyg/Tc+YfvX080nPmqd7TvV2oZ56q5qlnDvSa1DOHeE+knjnU2x/DqNCgY9QSmjdP4Vie4qHDnuKNU8/s6aE9e3rQ2dN7Lypc553oYeyO805gnvOO54qQJ1v+auqZl7HRyz2M4svYjeDLHTyJdU6ypTegO2/3lIMr9Zy5wbvZa1relnpmvXcRArzXw6z/ifczb5ZzIlNWpjmH5tzrRdQzqxXcrubGrlFbMJ6JhvNIHmNWUM9sahbTvNncYL5vybNmK8YtTEPPmVVmtXkC9cwn2cf16mjqme0NQt6WcXvzZky3o802es5srtfwYbOap/LW+new2ZUzs1oVcBebRlR78Pz9B/1uiBIuSPQhes74OuBDZjfd0kv0OtPWYZafsJzmVaaf6gmTLW9RzzxZT1HPPEVPU8/8o56kebOXxog/nQ/Ep2lFx5tncBv2ZsneHPw+Nr2U5s2+fPAdyjEeyvJDdZt65mBOH8w6h3AtB2gcWAfyMnCgfj266UaFzHqLcH0DjxAQSxjSN6qIlzNM2RuUsfp5zfXqDBxwPCZTXCrpMZvGuBqFGo2aYXm/dEytop7RCj2uFTrCqO8pM2e+S9+j7xNlKSjfTd8A/YCWMyL9Y0Lc99o0/P6Y0G33EGr9IalaxJKcl6Q+f+BIfpyTYC7/KLMFnoKI9fUIYo6be7x6o1o5ZyZokpo0RdM0Q4toMS2hTWgpbUrLaDmtoJW0GW1OW9CWtBWtIrXyOPNU2oueRk+nZ9DetA/tS/vR/vRMehY9m55DB9CBdBAdTIfQofRcOozg/7vq++on6qfqZ+rn6hfqfvWA+pX6tfqN+p16UP1e/UH9Uf1JPaT+oh5Wf1Xb0bzpcTuO576Z5/Qc4wncf8cTr5nrqI8nMp5EmK8n08s59zIHT7JaR1LPvILTryCsjafQKzl3Kqk050hPo5OpZ17F6VcRZelXs+R0wjRbznN3hd6NeuYthEXkAh7Md9EzaN7cpN6Env8cG3+Gx+JzhNXlC9z9n1dYnm4gzLYv0us3pZ65Rj2ReuYS9c7F1DPns9blfGKW9PnqPUtQk7oXHt+o7sFU/DBPyAvUodQz1yp00bXq/aBPqK/B9NPs4FPqNlSwhNevGYNQF/O5aRHjjNlDz5nn6xfwQXeUxtFzlMYcOkrrtKTA8HGU1ecFSy4DjtYPQvZiPtBfpIvpl1jkFes4XYeGUUpPxLDzyXmOS3sWcUKe16oGqSTp4wm1SCVJivNmXS1SSSLIU/VE7WKjJHHxH6lnTmbNkxx8OXfsKVzZKzRmzam81L6S5afpF2ByauUg5P+slYM7l3T6mNfs4+maCpou1lv1cUNtcQlyJh9WZ3JLz+DTyasZz9TKQUR4BqcFX12Do1vtTT3zGrZ6DV9bnqWnHImLiOosPgWK5CyL6PNzOP5z+Gr2bJafzRJJn+NgWqoGlOI6uS/XC+qfrRPqmfPY83l8PJ+rP4zMBXrMXlBjUr0lxZxsZ5tDM97Ky8BF+u3s6sIUU5lTdjHj2zjQt2rtalfaYCgu1LfD6D08396j69LHUc9cxunLOMT3WcSF8Qe4Vz/I+u9nncv1XtQzH9JYS/8/rRzEgvkhTn9Iry2VEpeSoyN4JM2bD/NMuII1r9C9mnuNmSw9HOI68Sq+K/kod8s1+qPcso/pa3At9gn9SXZzbR7TEstYjq/rS3HRaRFLzse1cvAonBc4/akCzpt/5478tH4h9cznWPJZDuoz+vP6Hih/gZW/wFeeN3D6Bn0Qrry0cvDZuP7i9HrWudEiwlzP0+JGvR/1zM3s5yYuvUlfBLpV41L9P/SX9VqaN7dz0ZcYv8J4Kw/vV1KJ1UXuNicHS0z42/k69St6itOvpJ65gy2/xl6+pbucVgVMdYB3sObXc3I0+Adcyw+4Md/m9A+5FOme+Z5F2H5bEx1v7mbJd0v4HUf/+/pIPWd+pH/GXn/Ki8pPuFWQQe8/S3iv9vU6c5/+pd7JsrIMH/dnOdj9Qquc7FjqmV+xTPA3Dv7a4udAv+XM/8/4X3ppSYKz0oOc/h3j77kBD9o08A/6F6A/cm/+iWfZQ1z8kD5Iz5m/6Id5zfgztxY5jN5fWeNhvQXNm7/pHyJDZgdGpDVfvRDfg2nDt8vm22PZDbaL7i16irjPmDArHInO9N1b/TJWeRukP1mKB4jaU6z3meJYTr/Oql86maurj8sZx0vyI1nueigjMUJ/3NyFCqaNGhp1STIYcXs4ZX6Kwb1K4YHMlXx9eyVfDF/J17VX8e3blQqtuYrlV1mdSyAy/BDE8xQ/ewBfBPG0p806M+Vt6i3nJwfT3ph9xiI5leZSLmhn5ZyjKlnJEgvfIs/0PVWlHB9LPZXzS05ZsbZCTuwqvS7zVtSVOlpLKvAsnEa28nBwbca4Bfft5t5qrgC8qJAflr87MehpVi5nCs+y8qWbD7AcsxZU8JDl87aptMT2mdrYnNnFm/ViL8mlq731n8QJb+dYFMtC6pk2P+gS7HqYz20ehcgbr6m3Lq3TeKhnQouUb+nQORqivmsb9iGismzsw0Xkn+Y9I5O7eVeOxu7l0QhWsEZH7eXhJPssT41oKzW6WsNas57U6aLjYVhP4MlC3tWv49S/Kydr3yjUrwv+qSLv6hf9ufJUL31sXJSnedirrH8hvQdD9WReqZ/Ma/ceFpWDfN+8Jxc8VSHuvdQzFO6N9+ZFf19e4vdRJ+s5s796Jsv2U7iaPJAdH6iMkyZOY9k/WKkS6kzzgArUjM/Gbx8czaHqWJo3hyucip+ncDI8XOFq/zA+AR2mcGF9BEd3FHs4kq2OsLijnjMPen/y8NDwl95vvAd5rCGhTALNX/Eh/ys+XH/lvQHU4wedx6mz0bKDueRgDyvyQZw+yLvC0PHmL5x5yME/5xAHyF+8GNdSvIQ/zI/eSZGDuOJUHLynVEFCaoWV9IyXyYH74ITA3b5CjZs5s5naXG3JNissooNWch0rWbJSPZl/T0BTj+VnWMcoXNgdY9OK0yrTOYavAJYr3IBtqr6Kvl+nrkYHzqhFCrfm0zwUS7j7p1VakjK8LuHfujbh+pcyLmFcrFSlTcZcugfNmxfxrx2CL3Tw6BySo4OrqrNVg+bNWTyGQEjwa8oxPC4v9UyWPsb7M5SOYEdHeGto3pzMBi9Xk04a3fhy1XDSaPor+Kk3dFLNUyw+D7/YeaqAhzl4eA6/Bhen8gQ/VakSfgv197yg8LtOo8BX4BnpKd5k9qNN+mMO7rlO9tTQeAcmz6mechDT4DRPjYSn8gn1NMZT+QA4g+WvrEAqSYqYj2cYvBhOz/M+iG49k8ftdF43TudROoMn7qu4i/+ZJaers/FL1sXe2/l3uEs8zN63eZjrb/PeOUHHmss9Y4417/He4b3X01mKKmRTWUpV6eHXVE852MjKJrIU/F7pXeVd7akspbPU27T91U0txPyrXBmpTn9EppLnYXDCWpuCt8cqiq1r/IzKj2Yv3uipIfEW24oN6/u/32ry7/YwPE6XJBuvrgVHc7D1wNI6pNLv6oP19cDSRz+2+tI6JAcHa/79tQzWKaNhlPR4jU6d/+nCGKVYF0n5jYm6Y/r/lDEdrPO/36rc22mfzxQ0qzxsjKgey9VrxYjeirHdUoHE+P/m56MzP+tLH12r6b/7XL32MYz2sbRa7MxqSG7JrkCHOWs1+KylnZV77YiRDvY/jIfh8fN4CHund5dnLGvLuOe5w+JmjFmJZbK80jJ0v5pJHzbOS31kX+pTBc7kVrdnHvB0QeeJ9kVALnNeCiz6TO1ogI4q1Oe+cFiZczD19Vn7ZqJeZ37q3ZcZ0AKc6VVUM+ZUM+2kpaa0Yc0aj7WcqymzcvwPj9/BbFmtfgNaww+VVvMzuFX8xGMV3xuvUmu4VJXe1lQ1iJPRKoUH81uptWpbtZ3aXqlHWRb8HbbDyvDz3eoNtB1WtjyvsfFzHNeg8UtHvY8i37ngaae830G5inaTU8ekM7MOKtSapmlBj33tVeoiHBZtFaqmZVVglPsq4Efe4LvH9TqziVlqVIH3xENQo0o4zjjGKKXklBouFcS7NCsNHiutZNsVjMsdFMkyc6eyrzOTZbxpuIYL15jD8b4ypwXX8svN2xhyEC9SncOH8TkK+tdz511vX4wecyRFlJenP6lUCT+Flm7PVW5vEOB25gkjM5xvbyiVuh5rEBbbGir4SblR0i9qFKTsrYiuh7J820rrsVxLxss2A9JFj5SVp1pZex2bVGZYpkVW2Ya01+pqvwBPvgKzu7keP8LHpmu0ZWULlJN3Wafl+Fkz06ZUarUoK835oHkT5WpwPHEZDSpjnybnIyez6MaF82tkTN5bPsca6MuQRzQ0Oqd1La4znmmm7d93VA1jSJ5hJmwOp4v9zIfwvPwAHokDGQ8yE076F7goOJgLDrGIAA/NIWo/2BxEx5vDDH71OcyQk0bHHF6B8PbcnP5p+DGMG3iEuQUVPN/08YVc/QvMVRC9iEUvYpGkj7aSsUzyIoN/qryY5YIvKeFLHUw1vwxazS+Hrda4NthaqxFR279uKP7rBnALp3y1Vk55KsPgbKGXpiV/J2N+rNXKQQzSVvzu1Vr9hWXUM0/QykW8D6CfWGbqmR1ySCVbTJjttHJwjHGmpEl1taTMNSytrHN7rRw0JUmjzmeBL8bIzuqjsn/M7FL6J00VUkHfxVQHzd41p4m6dq2wcuVjjofxDPP6/X/8uJI0XZSn0VLJCjjLs0BqL+MuFfqCr4O7Pbj4HypQSskpxR3sHho3bLvrJ+kH8PNUhyOL2CTmdEcrBymTR/z+p6RTHHNsgZHjTdKJTfcxleiSraqUxI5Vmu57Tmwk1ZpujUmFfzdat7Qvd32mOibT6Tg6sdNjHY0rjI7VbGS2VTH05a6kql5V6aecjrUueEusxB3BfmlcMzpJbWlfnuiZygjrMC71cLm0nI6GsKqKTXFsvzEV/6OrYnL+j1fHg+xdrrNPeVC96f/5iv/zS7mZlW8Ya8sXFAvSCqo6Iq9X1k8bkpYX7VPO2xcbWpQX7cvxFeNwO1DbvLHlqsAmK+/7y/st5lP7fnleXldep5dnGhBHkYt6dTzIzh0vMJXiSONL9fJ5N94q/668WF6cL64/KtXXr7cY9yB9Nz7wJoXyIk9avWU15Sk3bH1TBf/1PNhfyvtSz+zE69iOGc6bnTNMS3fSl4K24WV9G30hyrvaxfUo31OXEQ8SnqIP4H8Hk8V581SthsYxx1aVkC9K9uHK9tHGSUO+N1+/y1+O99bK+ROym+7grodLD9LbM76Y/5aMEPfVr4Pxc7j4OVzBc3TDkZRxfzre7M//z9hf34wans2qz2R8lv4a6AAO4wD+98KBGi9zHagvfqbzj2IojSldShtOUwnVQNsyTpUkLrqe/wqRUXWoGfFkxqjpUmkdUslWcIJR5EtY3mA5ngvJH6+rvNXFI95OHtjUOtyT8fODlQah5je4tE3jyBxXTX7NUKvN3BJGYlzLKOmdnFGk0ojumMnrO+HmmbE581W6k+6i7/BfpO+w+AJGJejo5NKNOfM1+jp9g75J3+K/KH87r+t4IvHkpG+E87tpRfbX90+t4D+0q1rsmf+0eB6ZdeYB+iX9F/0ZL8T+mf5CD/M/sR+i/86YLDsaZV3qmb/RTnrO/JUeoYZlVeQaD6mNW6vONMayFKV+HF3kyxFWxJza5iTQeRuuEcb0uFYV3NATvIBg4lRjw2pTlmMbxwdKm4V8VidbpTW57GpnctamonYpppJ9oSxnbZnyrR1g+fZFeHzN3bKYizZhXMq4jFHkS2zpI1uOuGcBjbhnwah7HGwyYjyNkeMfTX/0PR1G0x97nMWzsfWDzebMreo/1JfU19S31Q/UPepe9Vv130oRkSZDY9SgcVpNa2gtbU3b0LbcglvUbep29WX1FfVVdae6S31L/Vj9p7pP/VL9WT2i/qa2o+3pCbQDPZF2pMmN3IJNR9wVRI8Yv9lsztyhvq6+ob6pvqO+p+5WP1Q/Uh7tRDvTLjRLu9Ju1KI2+RRQSBHFNDFiDduMqL9kxB4yGzTGo9QwMaL+8hFbPOqqMuoImBH1R51DjY3sf9RjcumI+puOqD+z0Wfc6PqjrRKj6o86g5ob+bw9/jg7D68asX8mNmgGbcxjsjF6RCOfOcZGttiAtX1kCz2yBT0GFqOOtxq5hrtm7B5d2rIq8IWbOJtu1SE5uBnjRGGTroVQNHGb/M+kavC1KDiTM2cMxDMr8I2gs7ngLMbX0MuoZ17LacFzGM/lW2nBc6wcT4jewOk3k9InmNfReXzDeD69PuMrca9+Ie85diGrvpWUk4a7izh9EWH/rH/h9Nv57vgSege7AT+feuadXPZOwqsL72Yv72Yv76IZp7SP76rBVOdSuLiMH0K8j/D3x/dw697L+B5Wei8pR47faP+VK/4Ayy9lfD837jLWuZwl76N99Zy5iq7hDUqvZtnV3BTIUt7cMv4v/DGO4qOEWfVRUg6KfIrl8PYxlot+ii+heXOzwtPTawmPJ68l3Hx+nK2uZZ1PcHTXse21nL7WpoEfL+EnGK9z0JWnpXfi1bMvcOaz9GZ4vYVUAf8jh1SS4476JrqZd5e7lVZmJbfQpC1Bi26hxTYHq1up4eRuIXL8NbIabsnXllo4lrdy39xi02kJ/u77FVK1OG++XIF4AvYZtRqbESk8ZP4MPwf7DL9O9lmFfcI+qw7Qc+YF6p/UztgCiLai482XCC+03E6LnTSivo14GyC6HE7WE96P+CLjDRyG4BcZ11vUVjJvbqR3fgF8tSojMYrc1Oi42GCcZEQ916gfwcXblMohNgMU+TjjNjiU1SM4Lj+qsKHfx9QbEeC71EV4Knspd9H7+IHh+/hR8vv4r+r/ys9sL2XJperfsa/aBax6gcJUu4BrukCRk96S5s171CY0b96r7sBf69+vVAkbjHgR9zI2/oD6KtbjD3LxB9U1b0Br/l39HAfMh+w+hT/GI7Vz1Xnq9epeVP5G/h/5fhrvEu2n78dfgz+sVjqRoJIPqxlHIvK7UPBxpRzUJclnYfcRLv4Id9hH1MWgTyg1BKJN1/F/y69z5Nc5+AmlC/qppMGScZZQybMaIL+uIgZXgrH8hLp1Ftt0sargp3KIlerTSjsSFzdlPIDmzRXqMsyQC/m/3hfyxLsw183lqZFKXP1qq2GQBpbmdfp11VlhF9kLlR6iRWX8QYvmzZvVGy5Wzs6WglTY+9KWFaR1O2W6ngqyKo3qXTdZOuNoGCctOF1pM2ZzxLm0LJW69pmd5dfhtecT9aVd3jcQOufyb5av1a8rMfbvPleTzW3q7DroYsP1lENH0/qgQg31VpVxObUe42rnyrfMydBD5+r7NB1v3qDvQO58/SUsaJfw8+Z3sJHgu2wa8kt4A85LnNJ35nT6adF5d428yn9f/9364EzT1b+kpO/W8m5NJSty9K/B66Hv12oAXl6Lg2w/6KBrlco/epbKNtX7psYBe5f+Fab0N/RMYS8+7MI3b+509t/D7nwwKCM5Vu/Di2k/5x9zf8p7X/6Y8R59PjaM1PweqLZbx5EjKSKVMN12rq60iGkpDZBU6fdRm+/cD76ST9hXqg/D8Ep1+9Ox55zn4ycS/keT8YyTLuO1+9K8WZFt9oXzw0pPlfCH6M0ZbzzdE6zAjzzZ7tyFa4gtPFWL82bLHGY7huEFA4toyRqPcnbwitPuGu8kvMnKf4mDHeLd2vvUYe7uU5U7bqW7aWlXs6Bh7azmdvnSDcw1sr26qvBv2fZgRaaeaeXwkMK2YLXsNnDE9BfHh25XPpc2Y9BOYnnfGN4uN62bS1dgzi5XwukpJ132t6zUkS7iCtTnmn0+Tnb1XpUOQJHzUeRykwPKHp1Z5M5a32ndpXvaXbRw9sbeWuucPbjcPbnQ0L3SnbcYyxJlfSzEme+Sh7TkqZbJKXuxjXSpIzvZyu6E4rOzreHOsGI1LLPLzepKLWfbklHP7O9tVw4erwZ5ykHcx+zlTduoULJXuh3dEN25rV5n9vee6U3Y7nwVXivKOjHdFs+U7AbjTmkrHD/I7zLQqoxta3+bKsyUT+P6Zg/1gaaz45qyO67V8TcxmfbmF1/2Vvyw4llK1aHdoy0n4/R96NsD+Fr9IL7vOEj9VghRHMK3rAcrcorJ7tXWlxiWCC5nbDAqB/Hc4QC++TyAb9AOVGc4O7yJ/OolvKHbOG/opnhDtyum+nuyZanpdAc3Z3825ezYRrxj28N4yHCc+gO69rnelbvbndmKOF3Yn+0h/obKQ95UYfe2h3JWeXkeXW91OikSI9J/9v6A6P/K0/xhLv5rAbFhHHr8b94iJ93XedjqjLHO0kwOn3nNvH6aTvGRnOa4ky5jGm0/nn5dD9e05WFPF2oseqtC10Meb8bkJ35CQHyzTUrl9tIrIqanx4+tPPXIuLM93qSTfhdoCzUY70elm/KMXsYiSW9q0w9g89yXcLCCL/Z+jQvrl3qqEo91MF9KJckweMumvIFdw9nMDsNzhMePG07kDfFO4uP6JLU94zs2y+0zd7w5rQJxh3CqugBL9TyfQLDP3GXYxfoVHpp3iqeGwN8eyLu1lXeVwyp4qremJBc0jHjKeJq3LttPLi0lLl3MpctKtoK43zyT9c/0sNi8ho+b13hBYac62aPutd7FZRd1iEvrV3mne/+Gus/jOs7j08/rvDeU2dXB90U83MKe56F73+RtXmc1IuNp5fmeqkH01Hnetyb0OnOBd6GnannOvNl7ay1TSV+4Lx9snzIeYFzEHXBRJiOWqQK+JYeXz9gd/ObNqyuQGLE0nM7nsteoSz6lKvbiu1FVbK63QArPfy1uU9rAbylvD6iGxokF6hI/8GzTFY3Ia1VjeavBSk9ZzVWbDpajT7FnrrCIA+hSz9RpZr4mskiuyKKqt6mPszJVbmsWWxphvrSuRXnJv7Cf6wvyPmrXqh9rTs+tz217NU5aTQcHt7wi1d9uMufHYjoThvGUT6U+izFbXNC+mJrp+xy4aWZfNl7bI7pCNtWXlaN1UObHDM+PyqOgn8r1ZB8btf5Rinui6z38Anyps1Go1Sj67pfnUhX1Z+XGtgJSwapahulfq11rM2yKqmLPpQbEUJGayWzxV7BrvOtKfdBPTWap4nH/cSd96RB1F9fFSgv2Zde2qvLaaIrYHz1XYtMV/tK5C62JrDdsD3O+roWujq7RsaliL9uY+pjOOjfiQXXnt8Ht69f1SK6078mVFmIc62tlKSr7crGqdxZMlSwyX1WzpW+Xag+MyJ5TsW40qqxrIhmQwi+2hRLxtSSTjS1Yk7VwzlQy8v1j7ipcIN3u3YwLtC95KfbMrXyD+iW72y1wvXcTls313vcazq5qxd1wyzs63u79tLh1cLp5qy7kU24U9HcuyJ+LLynZTeHwnOJG7zSnuhVOSBPciGbh86cpS3PHbW4P9vF9OFzv7ThwvzbcltzuXZHGuyK3UfLPdVm/0KCFGGeI9d6U4wH9vJ5vk9Z7TUc+yfJxlovOQayTesrFYmVuZKjjxtxeq2Q7F3jVuJOB+xu9MUfi4nShb6nQpJTx7Ge99/bxgrjIiOI2jxbQKofRDzVtz/Do9ndtjUXm+tba3FbUM1+u8Fy01qWIBRfbcsR/a96Dg7gNvyk7eLbS6/IRFQ6WRsnexayHh9iBcnF2YGVyay3zb6yyrXnN4ojuXsinvIPlB3aXXQ8ZVbrHoeVPFwV1jHdovu5NOpsplhHPJr/qqRLSQCscDnd4eI3uu55yEFen3/BUDqFpCpHVMvulorSG62Kc4hgNx6iyjvxaql/wUrYXFO3pktxFsphvb74nc1isu4a3yPdDLu5v1DofqYrGwHCXlJqqa/yo0pD3w8b0GNyBqZfUe2ZrWTt+m0N5WpAdj+L3K7WqI/LyUje6uCeH+eCu6V6YNTxeyKc7cDYKcrtDZkFqctI1hdJ6Xud891w8T+l1ubLJQh5T/97aaKlGbgp5VeDLcaT+gof0F15Y2n3V7obqhsk4Xto0VHDC8bY8qyrdPLXI1SHVsVtn1hyOFdPqAY9G9DdV04YKTC1Lm7YWyhfglWVLRrxWdr+nSphuAuvqujvtLsr6PJWsKfmuiyVlKowKObYNZzTrR6NctqG8xKlb1Uzs8ixK2ZbnW+3OXys7Z2tn0pTxeU4Qm5Sm/6alzl5W7vIadP1s6OR7vFhnU7PIVrNnfp6mawcs5WpPWzl1FstG9aUKa2kdb1KaDbb+gbjM1nKMs48zLtju9QL3ACpjKcpHh2WJaPISUV23O7LlFvdxTW0/F3iglyCtawHexenTxbZPV1heZHmywKnt7oV8xSLtYkG7jm9fkttM2OS3Fs7lrsQ7t2uUrtqXOJfLl53VyGV/Rc6ux2UcY8RjgTVKDagjn3P3dXbTP8El6Rr1gOTGnRLBK0X5Kc4WyuM5x03H8fDhqKE7J1+2R02YaoBNPre0ZPtOXejjdH/p4jikBpGT7pfm0bW1u4XX4dChD8qFzgCVEV+GXs07t69W19gN3HPm4475IjYfY/P+VFm1wKbbboek+F/L0s21zTrmJ1Vut+3mcU8Cxnlyd7U5zZuOwiVTpKbshtzvPaRm420XF5ckggmjpLdy0oLLGXVJLjhZkpRxLaN4nqjxcP94trN3PWpnV/Ap3hUcB9oygxeWVhpyNHer8dDHZRuIxCi225XqFVzKKGnKYl45MIZ0h3NT0F+Zq7HoYRjM1wJvK83PFts901UNNwt7q6fyRYV8HS+pkRd5opAv8lghX8dXNJzN34fHizGl1hq8y7qNuU0yKFlrpp10H7exqBm3YnTlLk4xGkbtyD/tKn3ycGc/ctzDYu/yp1lWNby7s0s5LLc1OxY0Mmbvs46mdupDbNvb3eHtnvOM486u6e4e6Ztke6TX7MNeYPE/VqpRZz6rvBVx+4rIXW9moNzdNX4Tp73NAbGlkjqfI8hremZkruwTF+tKq0Z8MpOXd9VPR79RGn23jZrbuMTx2XDSrmfxNlXRntRXEbetaNWkk3ZxgnGqIE+96ZJ+WUexzlSmn8rL7Sl62NbMlCSCiytam/Z3/7sIadrt4yOLlik7sVa3IcW1NR5e/yVcPXTMWLqzv+VivuprBWDc3UZmvGC1wlrhgUjHrKixLbL98kENFr6eYLH/9YKzxysU/ioRPISbu465YRVH2yh+W2Gh7zWMyFXtrPI/UeN/sqC3g9Xb1WkYZs0enN7DrMh9w2HDccLx38enmi0LkhSnGCW9RU0bpTTrEc7tmeYW4lKE5RiqsTMQM826mIvs2rhYiHe8kJ/KRV6Ni63usqGOgbL90kKdGbNm07ZgMv/1kAVbknGpvkfTx/jwFi7WWdUchc30KMxHWd+aVGZ5lVP38g2Iw2q7NQy2z2k6WDO7SnoWh4h00Hdj8rmF2pArqcZSPSmXNKu8Ui7WRhrrQux6LaPVogLX6heic3tgwcg4/vzXdoBmgM++FDo6V/bZlWbOPN3sbfYx6lFJ6QrZsKmxCpmTonnznBwuHqg/maVMRSn+i/IMo4ZAU5I8PjV3KXwPaVTWA72XcVT9mVr94rgKEqOqGLsFUiVPj6Ci/cww+O63qOwzUClSSVLGqZJEUDOKh9fiIvJwc0TpW1EuGkb8A+gwPk0cbsgpTb85Jd+WKsuB+GHscLNjrrSM7hesisgb1BzBZkdaRAuex/3xPA4IcnzOysUmo3E0dYapDhWsnpfV0pfcgCeJ/2TUEPinmezjWFX4aTzMXKlVDVL2Aasybp1ixaen0s9Z9T99lUcqe7E4b7asRbEyhfrGuD41sL406kFttTrWK15pXJvl0JrV3LKted+MtQVtsixScj64ZfWyNkppP6L+B7r66PbJWj2RaQquctq0tW44tulHv6TeProR61LpZTpzvTUP22r9gLIfC+tr4ZhZWVEDHi+s1caRjBVa0++Jos6YI/n89bryYztRxSePipK8vil9VGos+5yUlJZ9up7d0rwfYKwnCp+TivRU6SNCHf6I0I6ODsYp1quzGFJNiSrOPtwUW2/LM2/u56TKMcfOx6libQqfyYp4z5TExtzI9FMr9HmijVNL35vbovwHjopYjtPFqEJe/LBSYls9y62e5lZvVfnhrHIkdWlXkpcbZwT78yGPYwXbsucypqOw1OnJqZIfnflP+9Y4H9Fye8Y4mlOs2e+BFBulSDDWsW5mkrx+EfO2gpOlmAXHsgjTMXJLxwuS4epSpZFtlj6VNp6VprU3nc+L9T965tZVhRPZUVw/di6OVWrmdVQWT9p2ctqunXWj7Gci8xPZFWYyW686VkIs6R+V+Vr6I5J6Ln6KLfXcj6Fj+0o7vdf/xFxqO57Zxnqm1N4iptFuCLpx5rF/7IxaY1Qjd7HsM197NS7kM3TGaHj8gtATSgVrSpKVA4KL9LZZOm/l4mVCVxOTu4A0HK39Mnmsb2llH7zaMA4K+Q1hfNjqKJt/giNH+fIBdqPwqhr5+9MA6ni3GkOq0U/56qnCJ7lSpkJ+IT7EMtUEUsX5T9HlP1E37uSLduD5Qv0byiutv21qOmzadti4LW8Uygcx9Gdq9NN6Ni3It7HyppU3s3jz/ZLmn5Dl87yjLU/1JgrtWVUoT/nZNf7KXG1f5AvqCspcV1Gehz/UBvtJearWvsiD/aScfiPv3DqFUVlbXlnqsGoe/iN9dQ3NczoDizM17bhRjvgqnqzw6+YH8SC/xfiLa+vyAX5dvrluYFI+rMYw/ezoYsu/K/ZU2yo+jLPmk/l8+WRNTtrFu84HH6CHQVOSCP7L33DBNsEvok2oJU56cuD3+8jRpAW/PJeiaAasOcaa8Kkr6hL98ZJkqvSFQfdLdNP8JTrlfJfQta3+2qDOaeLN1CmlCqjt9+sms6/hGd5SzChV+dU77USY6rjRuvGrSskE1zhlERFOKNx+TqizldP1uCefyDW7iBM1FYzZCia4AnK+gejqDOq4Kiw3Azim7qTsy4JuNVVITt+73ytEuFqNFaykC4xqOJ2iuFNM5UhOqT8uqalY8GZyPvL4cLlc8JtCxhGNl5R+P+GEI+E/0ZHg4wIyqPJhxnyYdaPm4nGOnycxbsloGBXj4oLPKXuQr+CD/Iu6xvVgXM6IPjf2OG444Y4N4WG68nuTeR3Bf1vktMbF55ZcuNgoSYwjeX1dbVCa5Cgm1f3jTqPKqnUI10YtLy2ZZTywJFFDLGCDQs9LJkqSVknyIic97/hvlPwvq/E/lh0Kw60RLuJ5bkMpBxsD9d0edls9eEkZHidKEnfJcmvpf+60HFt95JNO5B/m0/cd1Mx/13QDc+4XTyvQ/U5qXXrougo5+RIr19kY2soME9EQaT2gjjobqpG7ae2kxwbVMSCnnREY3scPxx2z6byiE5Qd2IXKXUmutD9oF45XuVrIsYtu6SAvudyEazXYPyMN8GWbk5fVzhL8+vNtWuLWmbcclHOt6tJ5C6cN+eOjMVIbcumaPrLoaub9jTadyt9YdtLn4tLiblIuZl9XHj1FA0txkrmb9ECd8SxlypGxZLzCqiplKmROquSXSpIyTg32WZH64E9guYjv4Bbp2zbR68wv6H5S2ceoUfAzN1+VEp3UlnO4+LqPtJWlrGp4xzTf95lKSiVUWzIgVqtLZS99SZbKPNfVUPKWWXCrK+zKmiUJFaNPNVPG5xvc8pKmzo9Dv1wsU3mR823inNOyinzfV2lc+3pZaqpC5qTKlqmEa85H19ei+t6o68cqf5ns52SszFTpFdjpj7SkWGtVyqlNVdRCVTb17SrHUJa4dZW1ih5l9vbzxZL87C77K+oX82WLvLwYz0L65fKShBZoY3E1yfIVLajsw1RSO0OowvPPqNH3wJ7PreuEcSsPF5rwdQEOTNnuyS8Qfb1s+tTUXGdRnx8Yf7HDs6lbrjGzcbGmprKWi7V6GZZbmbMp+riv3m4BTz+rLcl6ojrm1K/bLrcFff2qVDkq1zZD1/PPqz1lqT/hF7QH6fds8ifGP5ByZJL7Hf0xY0pL81p5S8uYtn/qe6ioY6ZoURVP5gUa2vFWW6/lRbn6NdevbdldeL6r+IpK8XN1j1+b0iwhjQ1mlb5mB9ylazUi3oNOaugJrYrMxZCO6fE0l5cV+L6boTXJsU1ynNNs0XRwinGGcbqEYjWpVQFd/XJ6psbK1ZkcWK+rk0YCnNEzlbVIjTP8gti0Hs8k07aN/bTbDzNOP0w6abGt659JfdNF4Dl1z0Gjfj54RP3lj8EnkEf9TLzeyB/5XjliPOMj6i8duYfGNvIoLxk5ot02ckSjf+Z61BrGHoOZvXSjt9ps7HEYUX9iRP1fm418MG/ARHosLB5nw7ZhLRh13EaN6A71dfUN9U31HfU9dbf6ofqR8mgn2pl2oVnalXajFrXJp4BCiih+fI7b6K0YsQYaNaKN3gJ6LMZhdIvHoJ9GjWjOTNAkNWmKpmmGFtFiWkKb0FLalJbRclpBK2kz2py2oC1pK1o1ckQbMA4bfW6ojR/TRtanx6jNo57j/qeNw6h9NPI6OXoPjT4KGz+mjX02GTmix2AcHn81bPyIHguLjd3qUfU3fgv+r+ij0c8Mo1kcNXov/Y+1aDzO5sf46PNjxBoaG/kqd8NGbcQ2P0YWhXb8L/bOZEeSognCiqisrp7p+f9GCJgjEi8xbAOIp2DfN82Vl0XiyCJAbGIXcEBIXOnpz9Hnsqysu3mEm7u5R2Tkdg0e27Sgh0xjY3i7yzRcWW4MX4hog8WUWdv4m3jtYfhl5fFZuCKwxYJXwn3satsxR6AFPY1qwBOV32iI0QVWx3lmrrEFvfnNZzij+mhgzS0usUJ2t+8tH42Pxyfj0/HZ+Hx8Mb4cX42vxzfj2/HD+HH8NH4ev4xfx2/j9/HH+HPQutDxPKrYIxYhvnrtOIcGC947+F2L2n0O3ULe0ek1YeOxMmiEFhzRNDxbTW7Q1QfibxbGNxV0kMd/uKE/Ug57mIO9rAlbczRnuMZC1w47SqdTxitPGUuVz3K8NHSXggWNVBqe11qDhX06GboDLlnk1cSZf4oj62PteP10v5QxskLvbAZh+LWfCM2GvlKwwFF18edh/vh13DADxO/0l9j4yr/E7ZB68qYyCDtNoU+2XEL82u9YHwOe6t/GE/+n7H/LDsHmQMfv4BxrwVa0Dgu6auL70/A09QzH9awjd/rr1nRHZe92jqL3cQs5qseAN6sf4xs0wa+O+CcvHoJxvbTjGqi7pI+m6HisIBxRvreAHJa0nefG8DOsJm2+tv/bO01gJ6c7usbwDlToWdCnBo2a+IuCJrbWo2l8EB6v24ld2sanKcLdmZ3bHoVl7FxeZ+y7EndLNUwzdsL/O/6WXpMbPX0q7J/cTFcY+Flg/UhfwVs4w7yVfILa67AoXLPwyMrZhhwepZwpg7Xj/RzLeP5+7mkHc78dDF8TVH+4RnlvLM0BLXAl8N7F8kDHXzuext/G+/4zPWwM31Hz3EJWEX72ouF6c5E5+3jZ/w7dRVrcxJG18TBzhR6G/OE5wAxc/AWMkH3CzLvXgXqEZ+DvK9r3b6esIv4dlF2YjkaaPziiQ9bQCX813r6u5jVM/ckb38xXWsUfGvjaeDM+I67j2qsqPxej34SqMCBnjaNhfIanGrL9gfHhu4KSBVNdwcKvzQaL1OxBi7h1wa456o/tPxy/lGHGwM5w1s4rzx+qoCw8PWHpeEqFr0+qRzY+chUg+H2YP2k9Yt9w18bFLx37zA4LOdOVPNjjJ+FnmD+8997SZ2ixoCzS8Jjx3JxSE3uROT59+uLvnfKd+fh8Yj45n5pPz7vzmfnsfG4+P1+YL86X5svzlfnqfGe+O9+b788PCnzhiQM9ocD++HiaX4Ln55++/2T8wplVx7lYpoWeO6Yl/ByCrCXMNzLLcWusjqdnOe51uP+8YoeKDvTEK/Q6k2SiEFc6wwl/Jb7hW7cUX9I143Buc4B4Wvt8xWHj23xnnobSGGz4fxXbtOjYlWDVNvAeYZU0wnprpC4C8XSfqu5rA/kmjV/QNGbATkZ8PPV//fike9ctK9mR/LFuhuXuokGrDA9rnyupYQbIuaQ77pOtuzjOx2KBa8i+vj3o+qP4jl2Pe3Ju+8PxsE82dBiCx98IbogPG5/h58rHP+Gvxl+s3P8T/mo8ri++BmOLwqqaaREY2woLehqahndX/EqWaQay9jhx/pQ0zXZRNh5qyO8sBQZhOW7qprqFrQ1bq4Xq7NAG94lFleMR58Dx18zX/u5Oh0Yrz6FHreMr94fjYb64huAM+vuymMGexgji+dOSdIYhx7TyBgD1B43fsMNxMzDjqgAzLuWAZplzQNftnAP3qWMOfF6Rh6fa68hCoIV+95D/mz4L3+F/1Gl52N3eNP8r3VSfoWTR0VsQb1w5sMv7OxJfGzRGJY9YFjgDhpezDPFH0I86LEJZ5NUzUx/Gl6K0cs4N+LSrHRfPVoRDk+aIT3n/Z4H43b3lu/H9uJS1/WAa75Xj+W6B5Yv64/9vhdVlyypOOSf6FIhHebbrhueswMC+O2v+Qb+SYRXPa6Zk0dFdzDw3KFufga+CnEGHMtwoYWXYUYXjF56B1mcoWOAosR1bpRZU/OHe8tp8fb4x35xvzbd9VZU6LPVIxWNNDVlTtZhSj8iZQd47fTyico4LMzAOx7BDhBEqxDSOQ4NHrFdMnTOdwfeow4L+CfaBBp/OSnPwtYdk7w726Tac4TE8wyPY4v/6NzH/R2ewOw3E838p013Jh3yGWAu35jrwaLck42cDX4TnmrA9ohlouFbHHE6VJlQa68E+nvOVNdSQ4TS8moGG8WVFlDSkcj51xvt3xlKMNmpBc5GnpsQoRXaBxCipeK7VkrppptX3kEtZ82eI0oXP2I9Rh0+JvG28Xz8FfBjjkoXeiblFXmSPIdc2h7T6SczByeL6FvY9zTB9x52gp+FjVbrqrkfxDev/HsZ0yvdtD5nKOwp1y/cymmIkVxCOKsfTLNt45H8pZ8gjnrNSln2fZG23ZE71iGbB9qekI9WjPMYlizzdhUW1MAOM6fqz0MEZW5zp2pgNLPTc2XifcWkGeY+RF6U8j/75FgW2y2Pi6+MUo2vFiFa1z1mewca39NawGPlKzePsxwhz3snnmfY3aTp2h/yfy3tsMf5zXP9i78vDpSiuvuucPrPcO1z2y1UUriuIUVzgmquCfi6oMVGTL4n5otk+jZ/O5RvMm/d5NObJmzcR97hHQZOIGFSIgigKaF5jlOgrGCNRxDWJccdo3OIWFfM+p7p7prqqq6b7wgwNzD+/U3XqLL86VV3d08wdNrB9+hlvpqdexhhl0D7VGmdvBRq/r5tQ00bzyZR9+hXr1505pUf2Zt1oRpmbQdr/r6Z/9/1U11rjM/SDUfprIeUc0nuE7z0CuSm8RcTGr33j55y5qqauURP4bMz2ab8hU2zwmdzo34LETeIKaKj95jfjlv2GtU+/4xq+R9v7xSn9k1VKj02gsg23Tz2DtPcEbPy3RDNXo/5kyNze1j5lFBrPMXvr2ITfLevfJ58s1TXt7zu+eB9imX6IgGX6Dyf+0ILuUXvkccooGqO2aMOUNiq+Q6p6M46KcTFXDcYyTUdUEJx463Cs0KUIBnpYoZ8iRLBMF+McpnclQgIsY5muQEiJd2yNFfoVIk6luUhYpuvkdGYhSM2qIpZpAXoSf5zDMt2MkAC/iGVaiMvZdKFULcTvOR1UnLMlVuh2WYfbkQkskZyWIuKJtBhBQWa8BEFB0wZlnCe/7vXRHfgb+eKFZRtW6E4sYJl+jefx7rhfZrkfQUFPoq9/t4v66OvwLTgOqNriaN+AbwIG0quOtMe1AqvQqxYHqy2otbBM/xdK1X6h2qplyRl5lUjBWE2DWn69/wqXYzmexdtxBeYkcjmWy8VYEcFHBmCZliEpiBK3kJiXOFQZHSxHfZvREtsVTd6Ig4pmB6W95vWBWKEFwOu6AAZLBInDJB6kaNy4yBc/2xrLdDmAgSfwlQiQAD+PZZoJn8MyzYCfn8+RboYzeAI3w8xuvjwBFERDw3gFoMRaO7T0cUdl1LfJWe0r9LMg8hWnYZmuhnt5FrOAF3YW7IZlugruu41397lwEzudC3eOwTJdABey8y9giEQw8NpzuMgXwbkFrNBPYITEIyV+W+IuEnsVzYEST5bYpeiPl7iAS3cGzOfL8Uy5pmfKmp4JntJW8X3f6N5refrz5DTnQZfStuHyg7FMi2ApT/sWAAUfP517vwIVeZ43yPQ3wHZK242XdWKFzoc2iZAYtzM0bpzoHFWxR2mj0vaRnL5um33kqCdHt5ajnsVyK0Njol+BkRK3dVr69q9+wCfDUthH4mO8HecCOBENzbFYpuvlRXY9gAXn7yk3/AHy6gALdksbTnARQGI8zYjjIxoaFXntL4KBSkYfu51etphfVdpDlDavwUXAZb0IDjeY+3P0EszRbVlS+JujNjxG6tslHioxZ1RjzkueZc+b+GWp+S/f4ZN5fKhN9y72vGl0hnemx/t/ugcGLrmHazrD43pdESAfLTNle6b3wMwRWKYCgYIPDZKdeYdIcfGRMeK8Gag4HCrb/6loTOyUmn9exstXFK/yPZwEVLFN5CRyuyg4IIpz/jzEm0bbix1EeyAh30djxU5inNhZfEpcOwrLNEbwTblXLGPPMcKFe1rx5zwwXmAkPCTu3ZWPdGfkaBrtI/YVk8Syz2CZdpRZdhSk8HmO6zdenCoRLHiMI+cULNMEGXiC+HRipq4eL8B+ohCU+tH2xI6u3ofn8MPfgeIw4WkSNHmY10cHiCkC6shbeAcdJNYUVHFnTooLt8QyHS6rwliho8QTvD0OEuDEroBDQdEVLbY+DjU0j4+V+Tjr58V8+cHzAJjvC/kZ4wD4tsTf83P8gQBO5Etwf/lG5CA4pCrbolqs0P8C/gw0BXplaLBihQ6GW67k3hEywxEwjunKGEfBMEWv45FOPAJeeYPHvwLXfRcr9KZ4knt/FyryefOubL8t+Kz2R9+Q+JYYiGV6R7bfE/wh4FXxuviH0gJn6/LvYYVOAlCQt0IZQMFOw8ZHz7D0ceU7LL8s0iIamv55LWFiR8ugRwtem6PFvcsJp9KHYjiW6YMAQSJK5J37T+nxz0ADVWQ9+4ZtHfOGJkT2+qUeLkQ0cC5fAp8ISIwocbLTxobMeq3owzJ9JMCKrgijZeStqvYfi5y05w30kfCcvoz/kl5rFfxIlCQOCBgyespMc8FM2cbX8Ep/LEi2+VnhE1FU7Nukfalqqc+uhqRYgtJuU6KpMQsRPUfIKwxzCv8wJvP0UWXl5+o1+Pg4MrZun4gzTFUccrJwo3C47jr2OvJH949Eh1Juf9q7KpOJx7AcuwbZGS86DsskABR8fDhOJQg6/KpBwBH86AK8WCiPZn4fyzbtgWUNJwY2OrZJ9Az7c6YXcSp9TT7vfENc2INl+nd4nivzHVj4Os/4e7AXVugU+ah6ijwNT4G3eP+dAnPP4tvmqQISIL+7/L5YyTfA6QIM5BqeLiAB8gPPj+Uq/Eis5P3yAwEKzl/F97UzxbkiqUStP8RiF8q3bANencBn8wKeLcCBZwVtVPS8dGeL/Qy9DX3fGWHWWtg0SBL9dpc2nwHavApa/9GjvWl0gbhIwDrKP53Pc/+puPJ23p7z5CWzWIAFyZtGvxF3BVb/Byu0VN7TbhfH4In0SwEBVmhWLJbp1xKvCpDreIc4JOJbQzQ0Kp7AH6AFKJiX6Gu+hGWaK0BB3hnXBzG5/TOpXyK8YN6MK2zUB2OZZssPAtdJN995oTKZheKbUs82t0rNraLdIM2X6Aw56uOvgjYGmgrdJG8qt8iS3SI3483S5s6qfVjipVVkzeRqzBni5ejK1dKjgis43iKRHE/iV35BBqrW4AZxqpLAT/MpWScu7FWCFP3D7DBbHoTXi/mo1SLEJ3l33STe49v5bMEflG8StwkKJATyPKZwp1jEVlfLDTlLVusGGeXagBtrrhJTsEJXCFDwa0o757eDuG8DnUg/FzPFL4S7hc7RuBZ/ppwtnxPmiHanZa3lOUdrrfnBPooZ4pGrY53cCRNPUFZ6fm07L5IrvEj8iFWzxZW8UrPlyGy5RNdKvCa4Uteu5t7d4h3+aPagfHx7QF4DD4iLz2CTu4Ub79zd66PfintFZyAhpUStX9L6pPX7K3NaX8/j1eFl89PlEK2fVG5h0dv4YB37pHKUZf6XeBYHXdrsxln0aeMkLUgoOwLZFsjHv6MlKAQJ9tVW9P9rgeolHqTpQ1lMqA8J5jV9Pdmm9XUZzq9d04eFeQK0ARtBm57q9NMS7G8BvISXui7T5rNJT5uHzk/vJ7UDTZJFf8E45SDmJ8G75avRFQIU5FfIdwt+X323OF5ir8ScxD0lnizxJIl544jfxnkDsGG7E/2YbTLmMRLvCXfFMr5T310tLt+f7pdPzGHvwSDDksU8h9WiF6fSI+IB/meTlWILbxr9UTwsQJP8jLJSPnOsFBNxKj0qwGKZVC6DIGSZ/irvqavF6WixtUkuwzNyOs/Ie+9Kwf/ovVJ+jl4pRknkfwb7c8CcPwM/K19MPSte54/Ij0nn1ZHp8aPfM+K+Khes0EMRA99lgOaySoAF10adGSkMrsnZw52R+PP5kwIUHKkFmO8P8jcN/hRUFTQTm+y06G3Sn9VzQ2QZr6c61o/8hR+GnxcvCqgjv2XRGxIr9JwVV7xN+T7aGraB7WEH2BHykR4k7v3uHi59N1gxYs1X1DjojOjIEd3V49UbB+d81TUNLNNoZ5D7qMp1d2k+tu4ctJ5k4bLgzT8W+E1PN/Dng/HyJdB4uPTr/E9PwAdoN/AXScYAH447yfdU2wap+TjrBia5s8RdAv1C/trCGJ2ZNFOZYx3mVTulza+YtoP5/PK6GyZJZEZjoVaqEBd3RyLy6u4aVJFddoNSZPyW/i61s6eyiozsF7VbLz2MyfPLzzkcoj3PMRZdq8WruI57yYntBadihT4Nu2OFJsEjrNob5v+Rd8swAgMXFg2Vj1tJ9Nuv++J4RTXzC1im4QQJ8LtYpi7Z7iL55b5OepUUnY6dFuyiL2iWJo5w4rr7dhIv6nBCY5YqdhsaHe1MpvMFvwXZ0R50OK16jmW3pNdNkBjnqA6//wNfzjsSJMb7+Sl3BxpDEMjfFTWFLm/lFNsTGPh9Z6LjDM0UxbdkiWlDlFgKKC0PuU0ykowJRqbJkXY58j4aZiqOMTTjnfZl56iPq9EyEZAT2Vmr8ZuqudruVNrqOnSGAWS2BZ6RbVYY+ghj6PdBOQKDW0NLXZ6uEvGTj5bJV1w6LNdHn6JdaFfajSBt2+ujnWlPylEfjaWdaBzBurSCaGFU1PqGTMLQ0v5rIQiSV5TPxSlfLlhCrCnEuYbkXg4V/z2oOsF220QCSUrAxYMsaW1ViVsD/gS1O+1BUEc2stJPFSxJddlmCbBsUMIARUuAG7vWMUC/prOoSy+ixVCXXsxC/jVp1hc2nOGDg9Z3xKVhCYvVQtSKc3tXtbkgtPOUhZrRXR3nI3W8PLTHUz5mVXRXSLAPbg5zrgkv9Jwy+gLfdsbTmoKS+cZu2+Gh5r6wWydmKY4usTrdRUn3e/UQJItBv5f4wUHreNHfpR5/1M9jLOxjzPZ5qpAy6HMNd6jt8scK6zD7xHlzQd5nCuv7wvWCyM8mdVheu0kmnq5Sor8U1sld3XjPrvdiqJmeW+/RN6Dh3JvYZQLtQ3Y5jfang+SisN0grFAvgYGjDY2PO2OFeggsiEY2X+aNOCp2B1aeYYWKlY8q35yWpd2SpWBoalhlrugnK3q+URwoPygcWNVIvZa7oPVDiXX6kZiyTbK9I1ZoP/Jkm/n30C6SPxlsdd+4Cm4nK1j0K6hk7iWvOrO4atdlH8iCwkFl4gXj4W7z5G7zrf12bWdMCvgNjcQK2e1HRZWX0o7fNWF8c05V7pE8oUdUE/Ez0NOrgWWaGKmojj2UkxjahJZx+cLoI8PoutT8eoJ5s3cP5ZW2j0WJ/PrrQMLYGvfQatWBX63zUQE2AoGMLpiOwzRrfqkwMShVzrAOMwc+xoiK7YZGxaFKHhXPnxIG1+RAZT/mtTF1XeLPniHK2ePvo+HRqy2Qtcr3xkTVMZxMZL3CiKptdaksS6b6q35VVEerqEexSenPd4xJcvdPpOVhAfVLqpeKoZN2NAQHhklITxbKuEs6kPwHcJPoxkLC0yuU6lkRaWt29kqxVw/tINMvKVSXTg0X72iTOmU/tB/txvG6UTxbi+Q7TA+N0rQY3afaKC9SDw3SpmE7idV9q+p7LUz9vQOa1iYHWvTxp3iP/dqwyA5Z6uEK32XqY4ynTJA010jaiAVqMvSojisZuMyTKK9ZzCsY+zOCWuCBCgk0aHmadShDfd5CtySpXRMeboMDyQl6aUColUa1GxHf+NUFRvPBJRhZHO5s/S4WzjK4t2usDKn6xSQNqhJaW/dRmLVaBTkxvSa3qQtDSgIwApnhkj9Q6lKnYd7xVLxLD1vSKMbR1e911tSavFd9WA82rMU0lEWTgsUyTD5Ata2i+Yis3yrR9tgYxPXrxWs6qZpzB2dtnx5YDVbbVajR1hOGbR3DIgxMdCYGuzrS06/tjmrsSfIpcKLJTZMLCxZSNeytnkumFUV3eR3pWfShHKoQ9/fVeyOqz/kqpfCcy+uHkxaw3gV2vf3RLUzBz6+91B6OB7L2Ea2HcnWShLPO1R4ZemmIZUbBJwgtRCixTt+WOuy3SQp3FWxhFCJtgU59fh9UPfeMky1yDQUPCaGFLVsgf8phe6gUkxAiI6FDTrtdflx7keH6pIF6Uape5g72tH4owydbT72jRa8RxSJ8igNtTJeLCxpFXqfe4F1HThurfdIzb0G90TtocAy2a3UL7MOeop1Es9TzADUTP13J3LQaRX3B9PHr1JuGjubdul1blAHaOGoSNFm7fai0JxH/oW0vXXE+WPySyrVDnPeMJW2GCoOVsUU0JFZoX8rpWovMa32rlLySRj1Wvq0CG1q8dDlY69sk//Hkp2lQ9RVCL73B195etDdBHTmzoCmGaf1jLTnPU5fIRFTbgcuxik4Ppx7oUWTr36qh49B0q2EpmtBGNULkUGV8JsYkVDAMXE+6ozincOdrPJWjSMVuQ2PDH1AfTaFD6TCC9dzazjm64VsYo0tqt6tzNGmUWqvTOdqMir/J7xMOIVDwan60OpLAhs54tRZfvUcQJECK8d7CGTuuxXfVzxIkxrlX8SHwJQIF0dD4SBKHGHoT/Qj3LODeV8lEvkKPJbCibq/iZInfctqYuJU1FyNHO4bAgfbIk6tt1TJZnKv4T9cvkT9zdTGAgvyVz0th8bv8gNjlgQO3sqPXR1t63fUlfx3aiSOdaPflzbi1Bza08dElf63TgXZmIz3e3KM9sKK9rl1et42PLp014H8P3MYDG9pianIPLNMob407lXs61mWoYqN8eQly1lFGd5EGGBofC5GlZNxFaoqGZVGxLFlKbEhthlt7/DcE23hP81ps4/E3WrfxLssZuaKox1BxzpVjvWm0hzfRw0BCYtlHu3m7V+XlOU2hy6sLloFQ3sgR9vQmyJAs14aNMPS/BmsuLqlGssnpGDQ+Ptk6zXoyzOj3JwX9tzwtlU0utBfe7RjK7pi5x0tbnqicZxuY1WYZMKU74z7VcV+ehZoilPsFciZYDPor/59FH8qndcW0QLYHRf/7WG1OodwtkLNCz6FaJJs8yKIP5RNhkTytSEOCIpU0PVr6HZreJufkg6nYDOpL339qNU68LFn0oZxrBvbleO1K+F04cIEtlC6XohYilNsGck54Jh0ZyFIgzxxoCRnKO7yg8WFeo60v3ARNH8qdtf4ZoCl0+fC9/HevRQAFp3MnBy4srQPaI3c6R1WKJnYaGh3tkQ91jtrwF/zpi2CaMYBONx+PNTTQrzhuS1XvWWzclqRZtskiejHRemS0dhmtPdY3Dc41adXQCxAlcjsH4zQbO6q0yBLtQlv6nDO0zSZ+GjlYYKr4VzFz0KVo3mJvCkiqyP9URDBZMY3HqJeKecXSq16Cfq64jK4sBOOM3ePGkqFxV8vHbQyNG++uTSaKKJF/X66krL0XGQU5OsoY9fFBtePjGENzS4eh8rFDixqekZ5hqaPqFcUn3LW4Y6QSiX+ssgT52EhqNvXsjuIEzdJEu2+nc/QSFkV4S4330aNq72QF/+KrruGjuATSrARFZUoTYicZ4rsdjrtGEUrO0bm8UYrw6hij2D4OMTRJUL0ELk9ipLaXosXBxGES85bR/qGX8nI3caAxJR9fA+c6qqYmnvcNqXpob4e3B7f5+3IF/3JJSW6vErzKvy5Rgg+2dcY30Z/APEx5CLY77U28pMM57ONv+Z/hcjBcUd1YMLgu4PthG/BAG6zmkbaYzTHAucwmLnYPq1e1icdYvC7w809IvP3AYq/i9s5RFVc90J7roz/gH/FhfByPUdqgtGeDZaAR7cNUfb6PHsJHcBU+iqub0Bun5l5P7V0S2CRpn80rthJNfIr/tnkldhoDLx+mdA63ePOrwceQFM2xjgKhY4z/bm+llT44PLWehekeDp+SkimXPJOjV1C5J2i/6de8YND2kVxllEsQTf9vRoQAo56unkrUOrKlaqW08wlzdqh27g2gtE9U2p7FZvYCPuVflLN/Ea/jl7avI9A0+hu+im/Ua+FUet4yUqaXQ/Sm0Rp8Bb1AQj2JZXrJiqVIDrsdKnZyTlihF6K8VKxZJ20FbIfFjM0lt2utxT+jLwm+Zp3IuDoR/MnpXjq+iB+BO9A6tK6oldiyedRxLNObMYvOy9MW1IOx0zGbl7SIcdmHKbHODUscFjoceAaD3V+ht/B4hW90U9yXs9KQWJ1E7VL6sL0WzdlCZ+hr8tq01vZ4ffQ2vof89u4f+A6CIlkfylzMuCpLWl+XnhbPZleVWKZ3Y7DD0Pho41cI+Wke7wdoi6fa6PgugiWbLkcpEQuOiCEWFPsTYjlV0ZJRl3mtb5UGF87zPraZmaUmp2hQ9dLiorbutiqULPoOG99Atim2qNhyroCv0ns/wjsupsoVHLadmm1YZzTWsUNhqEZRMoRaI1OYgX2MSsSxM3rv4wlhr55U45lo84qRal1ssnP9ZFsvUUoWjoZcL9nU3W3i2Vdzto9xLX7bIi97iv/6coB34VgpIBY7rMgPiSUPEuBAw/c4p4Z/JKvNAwOfVzv7Kg7xGFrGo9u3w8tJ39GO0tgRHZFtXm62bV5B07RbMFp6Hzs1DiWrpYojHAzDaFs6mMTh/locNX4cw3PP5/O8D17mv4vpA7DgOw/kU/5PzbfvntJhWVfXSXQAHoQH4xQ8BA/Fw/AzeDh+Fj+HR+CReBR+Hr+A/xu/iF/CL+PR+BW8qSP1fys+OvV/mD05tUexwf9l9vK0DoemtC824f86707t0Q9WKed9VPr/pT61x0/SLl6j7YstPi0+LT4tPhuKT/pjPbVHqQk5Ck3IAc24abZYNZDVaRm7+hpun8mVbobH5JSVwrSVbbR9Jqua3mNEJs8ByCarjf1ZosXHzacZeyibO3uzZtXolxrFJuTI5vN1eg9M7bFvE3Jks1bZ9Phaaz2Sr0eD72jtTZhDW4PnkP763nljfw7Z2Pk0YddtSs8fLVYtVhslq7TnQtbOqRaf9cqnlDE+kDE+nRmb79CuPnoCnobn4QV4EV6CNfAK/A1eg7/D6/A2/APegXfhPXgfPoAP4SP4GKDB9selP4GacMplbReNbcKcxzUhR0Y9uvqoiG3YjiUcgB04EAfhYByCQ3EYDsdOHIFduAVuiSNxK9waR2309se37gkb9J7QcD6pr4AJ2TyFM/nWtcWq0ayasa+yuds3U1YNP98y+t2ltPNOaV/Y2O9jLT4tPi0+LT4bCZ9Sg+NP2di/P7eR24/I2H5rxluhpjz/tVi1WLVYtVi1WNVYZeze1+Kznvk06V1aNt/wZY9VMzwObn1LMvm3JJvgsalUN2vv+dLab5nJqmbzr3Az+rTSYpUpVp2ZZNXwZ6qsPeNtbnyasYdSexQb/G2afjBqwqyzyeq01B7TMjkPaAarlFdf+p+PGtuvzzzpcoxOnSNr38hNzSf1jIc14bkx/crtlNojc/ejFp+Ni08TdnUzrpxmeTT6fVVTPDK2B9PPoNFvXyaktMf0a7CJeGCDvx/R6PNyU3kf2Zuxuo7LGB/IGJ8JGeMDGeOT9ntdoxvNP/UVuXfKn5GGDNo3tKINtm/xafGJ8GnG+6MmeDSDVfpfm29vgsfmux6lTLKCzLLK3rdj0n7u2T/t6ZYxey+lfT/+9qvBM0hrX0i/ixrMaI9+XTuN9ihm7NcwmnImpXz2Tl2jTejsbrFKxqr1TJDumaDFqsVqo2TV4Ddnm917gA3C53/Yu/YgKYozPt3Te7t7Bx4Hx52gcpSoPMQYQCr4SolVPAxwaqpiaYHRCpiqvePQVBKrrFQqvF+CPAQNcEEFhPA4FE0FkQSUgkgsi4fIQyFoohFOAfUMCIiY6p6ZvWZmu7e/3eu5Oeh/vu+b7t/36J6ve3oevSuOpyv0Wq8ZnzDxmHjyikf/c2kIPpTrALiP9J6DohafQ9GLR+v3rEA8NJ7HgNck6BjuBB3DYfxSIjSmSwyPNa+zOgFztAgYf2/w8zbdK8tHw7gbgGsAz4Pu63cu9yc64zH3J/L7ExOPPJ6I7vuCz44hvAWDa5jsbt7s1h0PjuzoAWpA2x3CVS1S+EietUQEMyOK+EhlUg74qO1t09ne9kA8OB7wCJgJbUJLv6hB7ReZ/pH2j4nHxJNXPNFcGJioTFQmKhOVieqii8p8nGc+zjMf5zXzx3lRW4WaeEw8Jh4Tj4nHiQc8o0M/eyqFXzPgGsBWm22v6ttew4pK+0vsEHyYqABRRW0mNPE0bTxh5FA0M/uSjapMc07p/sm5nF6SQvBIMx4aT6HmeLS/Bwfibw8hHkjCvaB7lp9qA5frundNJMGLw1vBGjn8niCwWw2+ZeEfh2cEWKMkBB/LoZ8sTrA19ywUfz+wAXHNVygo/jEgPhlCVqCI9RGKWM7pxj8RsZ2S+vHRyzed+P0xoAKB4sF3WvCUhp9imH29+MeBPbpe98IaacbrjscGdmgiYvFHDQ9dmHUM6bG6bg3wHXIYMYXgI6L7jE1UJioTlYmqeaICXjMfaeFrChOPicfE04LjCWNOjOZMDdYYFMZbGKOhrFEWySwxWy7MlguFLRcR+2U+pBmfw6+kgTVKI/gLCxA8tE/bge3D8KtRGBc8zY1o6fgRwIeLWPOLoEsNn8tPlkLw0Hiu0tzemcgkXLMm3Bqogu5LfQV8TgdrxEPwUZDDyGzJ+LuBeYTB78dh+JHgM5bQHFFK81DWjV8axm8tQ+xHEQ9tLwQ/AojX/VvXUcTrPF9JIP5XISzmIPgnNMdTGsL40r2XAIJ/xIzHrONRJ34i0pygiRae0IOB+G6aJwjo+ZoQxqa7eAg+UMQ6Fv6/IuNRCPdMYdyXVWgeo7ngoacaZD+EPo2mxsyLJWVz+aFcvSkCfzf9fDivuWBjaRAQ3xUc0b1gjbPQRvTSPvdHc1Qg045otSNia5zbNcdzWwh92idifdpd95UIiK8Axh/Gz34MB2skQsikUP6vSfMrA+3/uQZv8UVxHh4Nod1hXIFy+Y89CB4+F6w2r7eb9/X2YyEMt8HmHGc9x6BhBrT/hBmWLW1YttJs/+YcUg6CvwwYT4nuKxMQPxNpDmgi9IxBA8rFvsHL8ZD+H645gaL3tTQMfy8Qn8MqPwQNE5XOqJagCD6+qMjp8QVMA965R8yKrXlXbGZjQPNuDPgIa7/Awb7T7h7JSTgUDXDPtmz8vWboN+vQN1Nv9qkXmv8Q+1Nt4If+qMXj9Z+wKOGXQq+tZoRlH2EQ/OCL5AUU9KEIdEu19hdcQPwgYB6tQmHcXAIbMRu6E20+9Mdpob/8uUH3eIscPoSxGU2NDpp7dhvW7ICAmzxAc0RTwvgZpMVh/Mo8vCF1uk+3bvwbBSH0a68QfISh8V0k/+ogijFFDQ9u8QPAp3MIiC8EL7OWFYYwn6EQNBZC7+Z046HZNDVq/9gCbfA/wA2IZCKFogHtqYjhe0YsHjA+klkxbt0VOEWexIjR63GKTMd10xGuIbOwQ3fst3GKrGYIhy5zaRxXkxUYMdoK15A/4zhOkVUYcZTqvoiLcQ1ZjhFHY0yr7j6cInUMVIeRAq2wq8gavBYjIMe+45PfJnGKvI5RgB6ss0kVeRA9jH6BUAaJpKUeaSlmV5Gfo4dQP5cjEU9rYFdjdLpktFuC0yVj0tIQnCIjEcYpMgp9QkMehWLpyuXHackWXMBoMaNFjN7GaILRNVR7C2vjFnwVo5WMOuUVjMYZbc0heTqMyTaT5yFOmadduFgmYU6DMLk9o0MZLWE0yeijjPbmkDFGG2qfpZ7mIxvXkKcRNTUP7ZpXjmvIYvTMyVcwriEzEALSzw+NkNbztERay9M52w/Q+OIEcdQpWUn7Ok4wV+HIx2mnxkknruIrp2h2Jc8Ov+bgd012DDY4oDdewzwqI3v5M8QfPl97waFzMCvDgQtYW8k7dAtPuGGMv9CYy+pr/06LE9ZGGl3CogdJiyZAwrqB0RijnRj99jJWfZQmX9JaSrMvaY0K0H44RWJWOyY7Bh1kEUPOofEkrdmUxaztFBBnioXW3+iBbX3ZgZXNoEcxqzgdTIxZTlr/ofNg0mqFU6TA6shAfVjJrFPVdhXpYHWyvnl1GdXuj14rZ2xXMWMs8v5o/rcDcIr8zELKFDM6PFB+yqmYcprGd8ZCytRm9C2qcNpSpyPSsth0kP6UlSRYSZKVOOVjcDU5a6EAbRsoCdIizs5LZVyIfvenLdtXe9o68jydJM5b4+nhOYt24jmmfo7l2zlrCR2n592KHkxuxeSeTD40ljbGQlTFQpiTnZKPr8bVBCEaKULtGCWMjn0VcTU8neGwKW1ximCEOLpx7j04RR60aEjDWX88ZG0+u4deKSZZ06xV7V2hENeQ6RZt2FTWlilCWuZq2C4nLp9UwUyU4hSZHKg8HJPaFNEfS2tFdIzrdGnCFTq4fLTL6TmdbCEp3eiFjnzcaxr28ba+40V+he8OLaUuN1oFuIastY7t74ZT5A0LqVO7imy2tlptXL7tnRJXKnX5Gq+gm8ufPHkrrib7LMwo4igWyISTL6QpsteyWYkd0PJKKAZJaStGRUgVC3LkJqfickav40JXoUUM/ztcQ3aw68gHTPeg1U2gm8xgoYbstJCUeqFXk/ddrflznCO6zNhhIQH9E83bw9bntj2G7LJ2WygLp6N5v7WGLop3sqG908IcLWf0O6didzGuJv9mc9bhgKGDRW4lpYjRBCevjDETR8tZ7y3q6DaM0pHpc3XYQsq0jLOgTtd3klY30n3pXqXBxdJpL9fKRDtLa0X0o7e9KGSoIG2QV4tohXsWu0tRIooDJXJ6gC6X9lq9GP2lFNpULh1axOjbVHmfNfkV+RAM0jPTP6D5UEpE9DxdJ5STo13SZWWsplygUcbJ5YISHlmWwSYdjmWErlbaEyShQe9weuTGtONM9PuExH+2KGbW05uwCoIE9DC7F+jCjroQzMk8Xf/6myhWRa4nPckN5EZCr3E9SG/Cl/FykVcv4ESgpyIXKGASAr+qvFRgN8aX+3QKSRXpSrqR7sT21QQ4Z+V+u4r8kPRiViiP+Y5V+YFKV+gqcurya/kmCOTNw3zGuikoLalUAJ0dpgBqKnmD14xevj7IxsdVCiomiipU+e1ceN/zfZEUtEFFFo2mFQtFYWTjZ+9WcKsi1y8SuVDlXRS85CO3F5TbCroq8rTPVFAi+eX1WNAvHr8/Pe0gRWnbMIEpVb6g1l8yUOpQVXpzCfHZFfFruC5qyLU5l+dipCOn1DsdeacMrckk4QxlmaSF0DZ14MKazU/EX3uWruIKF1YC03BI00R2X4aOABv083LOQeNg+FR54ikA9oVI/jrnqW6e/6qyV+X8rN5jZ8gcVekvSMEFL9c31ZW7QgEzqxJa8ZSoQkn7PN+4F/LycRp80f29gtWmkq/k5DnpaZz4FpMiXshp7xnqa4bH14uuoodFGtn4It6iSI4rYFTkI8rnbzmvVigwB5X5DvsEnEoeX1ar4ktFTgk8ZONXS2efE0MVXPPyb12z/tXQzVI3+UjvQUMUyb8W9NAtUvcow6VSjmuU9kBD/4MgRBEvTruayE+cowRwPx+Se6TTPBs9MrS7UTogsjvSF4oqXz5MYJCXx4q0RbxNOuAzooDHiXQ9viHnZYfH71JoWj7yAPfqgV3eoHoduE/BeFPJt7nBvat0sdnkLVpnVwoFUav8/Em/Qv3O1riG9CMoQOljtz7kFvJWHNeQvgSJqAvLxr+0pWZOyqvfJ4H4eNpPqrwYS6s3JaS2z9IH3XcSJKCbbKl2gbS2SNBZAS5twH/fi4n0FPlWJA1zrtz/x8Q1g12+arAU72hd54I3DpXCHHCNC95eKYU54Bles/butn0N/TAh1XToS4MCRU7Fes/ckrsCdQ6i1AUcr0U+z34e9NE1UMJTeYoTn3VVXiAo9/M7pd47SmvluSXymGukbQTludpTxQ33tbgvsQVIPy+Q9FtfZf/y/LhGoOXny3bL5+E+0tppnpkHpDDH1ByVcX+za3DrkECVA/jUc1m7yJNEfD5933QTQUD6MOetp1oqC2Jd543SF5/xoH1FU6qoc4pdxQ+PeSb2PutJJ7A0vHYu7NqAUVrfl1S79UjAse9YxN//iTSM3wQcO+4nefanqiTGUQ/93IJgpUNv8sUl4mM94au/8guF4KJhhwd8SyVCnrZ1FVEW3s93LOIdBeW5Tl4dIz65quL2BLv+egHUzzeILulrvXl87gIPuw6Lhu0R+g7/DoIEdOVkWzDLUI2bSHtfUEIutfLFZ21JFRlABpHBBClKOENZJqm7tFYuHbSl1apST2mtqnSoJ06RgazHBpKldfT8DWPz0TBSt4598jmXfVo5F9mcnInWkDkX0Da4howgiKNJrvbD3V1xinS2qdXOdv2EcfTbshhCCvS9hglU6yRGEvqNkHa3q8jX+H8YKfAGfCorn05chQWffkA3C2xC604VpLcRdHX3HzRuLCjLINXHXVg8XXQw4RY17ptYXeIWrWncLdFo94a09K92gQ0R3laJXZvpd37b8dsTL+O2ILBPrbfgTfT76y34UFywU4GnNkcRtznC5uQEk7c61Vew6o95GwXMRmtmI85pk4CHGPOAmYfCwG6KAm43RZLtpnCQK20uDMSZTgga5dAyRkmg3KFjCRfvjqs5d3tV+g1x7WnNKTuhduZcO37mBfeCLI5xez54bV6W++epqKH8VpR1vAN+y4vcWSyAwZxLO3Di+VMYVzhTRRzSsbyCN7oSC/SOXckdONmzj28bH40jvzPhGF30zkArVv2Rbhvqj5z9H/3ZDpo72NfrA1BtbbBsBv3Uqz/aev5cMvAdO9VC6Mw/Jw8MfPwlpz+Q1tZve6pY4YHaCswdFAlA+cizsKBCJI/2PZXzf9K21/vK610Va1C5pwKGl0t80d2T4aF400hF0lp9lq8RtJyXvyjwdYPHS30vMrPxKwXl2XjDzAJpI/KRcB66xvKlZ7nB/1a/KEvyvpD1u1UfH2cLxiEvvzTODImmHxI4gyTXyMfH+Fy/SvZSbrI/tZaqpE4+8iqTdhrSruVYHptryvr5c1gw+0H5xWbHBpbn65cA8U3tP2p2zqhsojnyfUw6TJpreOqzjKVlz+Y6LdiC8sXNnQbGzqVtp7XL63WvqE6MNSuqS3lFFXXLn6t+xS66SRHVL1BdeRwXbhVVkEcoYJpKxnnoGl/GV1P5Qhdpu+w8YntRRQEJ3pa0EWBE8rbzl9rtQUu0PKWpnmXk/cTX48fDHE3GV3R8/UgBE7WYjS/jq6X4gq4i+M3rs80Neug36E/7L81fec9zSgSXzrnQxWGUUzevtvyfvWuJsaLowunqvvfOvXMZYCD8v0aQha+wUEnYuBBduHDvWuPKRBcuXY93kInAlbcZRmRgjGGBD3RBjMMCBIPiI+IoD5VH5CkhGnwEzSBjCLenm5o+1aduVXVXdZ/NnDNVp76vqrvOqVPdt7stGwtxEVecqw9hY3P/iYu4MFxZxvAmwkaX/nOJ06EI5VodyENk5dOIQ+7iNCmSKxMXcWG4liFsXBxXllzHhppApAzl9lpCZPY0x3exHSETsh3Is4FbELKScf93K6+sootlWV8s21MDIiUvnwXKs/5tFeGUG+eY6b3xZ+1mgsfo9juxndvI905rh6GX/tc1z6pnOrIdSJ5tSD+8pT9hZHkdUUIuNnIwjTyJXY/nptRjPQeqJ5xi4jQAvPAHtV/LbthHDD7VdyBcP8J9/UKu193+Mh+qx8qwP6G3HtK17syWtNf1vmtdl2nqiLsYVcyXfAzrzAAmcenlao30c26XJo+bC0XLhLU2JRNuIx8WV+fV6YHowx4NY9q2VjVlgvPyUpDQ1/yPFiHHkR8S1oqRW0OyU8J0Akk4t3Beocc04o9pYG+sLZzW/pfQK3E42ww6w05zJ+NJYa2dJ8PXgmKup9Frxc6tpPhmZ3xzBedXSoHsT4EIuRvkqnRoF/vC2KYm5zx5O68szlsVmiqJU0Vsp4LMFFLBxdNaRUvmQrshfjc0TA6RtUOI7Qg5G2TsflOsHdzRz54PHgw8yb9sRgm1Mt1qHRQjLxbppgdxERdxERdxFYPrdfnLU2+3qsKFUH1B/dTv5vV5rhxy4nKL67TuPayPtDO3OykHclNz/8z1NAn5SOxFe2PMcMQ13WoUiuiDLkYE4iKuMnCNileq84OmE0H1Vu+HgWcSM2AbDjpxEVec642hbLxsqtt7PLwsC07b5llDXNlzXdW9URRvEAg5DVnPTTiVHphDvjqAXxZW+SmhTfa5MqgekluL5OYYrtXVnCbInIQft3jCFmKOaxLTTCX7KF6rcd7pJkxMzLMMeUpVJoHYrsjIs4S18tof4mr5YeoesEfIhJyKrPsVNGdplQFXmeFuk7e/MOuH6USIuIjLRa5RHxm6mGY7ea14yK28iAnZfuSmM8jZZjVfQXnCeUy8sy3+EhdxYbhOFeWaey0hDEW/zexJaKGH187QOb5GN6K5o5B0bg6FbxB9ETGH4/qmte4MPFvkFSzDxdR0q5MVbpGWlY935LZKwqHqyykY36ZH/cJYa9KJSwNXghsmOaQntIu0LPu+j75OndHXqa+LA5x6iLz1d4x/ov56pq6AsNGl07hoXC6O6wTGKK/OERdxEZedXJSryeRqLZZJviXb6kwv4ky7ODuJi7ggrqP77hH6qoq2XFhrLr7Yifye6fhFrWRbxcvZjJLJGsJ/XPR54iIu4jLH9RzCxsVx2cBVlbSH9EcQNqbHQlzEdepGpeRPAN58ApCQCVkf8p5eSpgoYcopYXoKYePKWIjLXa7FCBu6/QHf/lgDXTQS/3Wx1d74erkIOMeu+0OWXAsQNuR7sO/pQV5RbA9u14EZEf9Adk3TR5/7JO1lddmPesc/eD0LgTMPsPkCAvUAPd54PlDOLAtHZQizvmTbuL6fQigQQqOywewDnNjGlVZ/9yBmoIveR1zElSXXGYyRzQMgrmJw0ZWVbq6stE0vtCqt9tt2x+A+hE2W/TGRsmPsyXdu+g4hpyFHrzi6LPZ0XRHD9VZfNhAeBOn/TFU0n8modljvOKG/3/cCF3Iw43cl2jJaRUq1iqy3IbIUr9XMkhHb8sUicc0Dyl3fy51oQ++cP5TXK9YJmZBNIEfvkdqIuTFzxEMYmQ47xEVcTYSNi+PKkuvSFvnXvkVvB3w0hnThBv9qseNJP4KXz88b05q4q/cLgyZDXpGIyi5UNB1j03OZWTYHm8AvLTD4p6e6fUHdAdvTMpagiVuocIjt5LU5Ql/xhW11j6NHWJsUqKKyH6AZaUK/G2ET119C2MjqPsLrGAJHl84U7JmkvYewH1qT9nGxT2oK8zdIKMO28IQr35KEtp4Q5ayuub8BY6RyUrycll7Mg6xbZ0yY3bWE9CYKifLTB6sxZC1TQPESxrYYaReVrfclz4qs/u/aJlAD6RM9gL9D0uAnz+rC2kg7OlBFjCyur61zo+hWLgDKQxkezQYgH+vIreIRZvvRNIasvSQ2VNGu3tC1z2hjT3XaqQrlctEpmzB3SNZNmd56jTW4QyIrfe5QvSwekUp+vK1VVRgpRt+eNnEe6Mg3xT1V0RghE7JR5DPi6vw7SMi2IleRduZ6oKJdVMowjqoulR8kLX7zhT228ziaQ/5cWw64uYc7+mnyCS6R+YnRmYmdGXPIK5nCPtCmgZhDXqolfxZrE7e53ofYYPeqGFVFayLt5M9PILQLEu64TXrCJnq6JbYjZDxygLTLq3/p2cmuNAf80dfcuyUJZSpHpSJ06b0OfV+uvMiXodmXJkdMXKoZZ/4LwcPB0sBTlGXBGVQFDoDybjuUJmuchOxCWenIBVx/Id6PYo+A/MbP2V263px9JaR7TXb8kIRw5ndkL3fcGinHTZUXK2d1pM+Vp0m7Hl/YxLiZ0q2UxXm3AswwFT3L330w4iIu4jLGdVA2sELyd6jC41bWOzqyn6sPV4o5XUb8UH7n0INrbiNjj/Of0Oqke/XbLbtneEd19v/Cz15+tof//5/7P8yv6lz7Ps6Ox/WAepb77B+Fzs64iRzEhthJXPZznaxwDgPJuzryTs6hIHuPs/NT6hlXHgaIb0uyXG1MC94fU5igMJFXmLCB6xtsqEqTDCjHytlcSITsTPWjkhAameawtYMPR+co/NgffnZCq+x2aK4t4q5zBoDdPNpF5rSLJGQ15BmJ1YDpSGb6AZQsIwpxEVeZuTZAK2pWyR6EM6wbELrzyV+Rm8uVZy2xuwCfk5BdKA9SRmNFRrOaX69DudOVHYiJX+aY6Cdx2cf1n0btwm7XRma0aghXMU6omoPRv3ApxDXwjS4ugMYnNFrETmZ9BnPwuRVzQJuDXFncWTxYGKnM2qyLRXDypB0nQRbFMiYwJjESy1p6hZkpkyWVkREHee6XLlM2yykmwABwYaL15QIFAA=="
@@ -0,0 +1,8 @@
+//go:build phonenumbers_regions
+
+package gen
+
+// The metadata of a region subset hasn't been built, so there's nothing for the
+// phonenumbers_regions tag to select. Run buildmetadata with -regions, which replaces this file
+// with the metadata of the regions passed, as described in the README.
+var _ = PHONENUMBERS_REGIONS_NEEDS_BUILDMETADATA_RUN_WITH_REGIONS
@@ -1,3 +1,5 @@
+//go:build !phonenumbers_lite && !phonenumbers_regions
+
 package gen
 
 var ShortNumberData = "H4sIAAAAAAAA/6S9aYydWV4frHuryu6+7bbdx2673euMZ5rxU90FZ1963qGw3W7ftl1uj5fenroww1vD8vIyJAjCMsfsAxFbghSYQMiHLJAoCkqULwniA0R8YKJBgYgQCEJKhJAikShLo0ihBVH0X87znOe5t8rlzofp6a57nrP/99//f2afnD0qNtLu3u7efO38E2KWLmy/olROqdFrSanNdTG9efHa9OLlP5jgv/7JZLnRe/TT+/R/sx3oUnGXJ8QjSrVS+7jQa0rJrsNXuw7HTUbdfd1MiGOtsmmxu/c5/bK5P1+br58/LR5TSueU2rCV8Ds9X+s6v9J1/rQ4Be2sda3yi0H70Th59pQQrbILmPmF7VdgtPvN9nxtvnH+jHhcXdh+RbZSL7KCZSspq/FeG4xXt8xWWmmxfTeeEEettDCM3oCf5Xxjtjc7Jh5pVVrwvp0Wj/Mux7h8Gle78Z4ST6jg86itCr4bbSbgP/Fvs68ejdKfZYyjIV7vhnhSHB8McUD/vzuZPSkea5Wjs3J4VvON+ZHzUrwI+wL/09m3UuEUmqzxPLKBb7JVDZ/k/MjmE+KI2937nL2vN5yUsEnXphdvdLO6J26WDj1suJQZN95npbI2TZMjjeDd7l6OqVV60WTqEVu2yuCdyrrVW2nRDO/eMbGmtNHwj/na7Ntnp/EORlqX5XWdPymOKtmqLQM3SqrqRux0E31OnIF55tiqrbBoYFKHvRTXa0o6Lo4q1Wrj6PZ2I73RjTRqMbrfvzWZPSWOt1KZiKSkYBlTXshXCtvtYCu3jNsKiwxEad0i61bC6ZjWhkW2rQ1xkVOTk1J6qhL00U3mdjeZK2J7dY9b+/doTNA5JmNi6bzenpgMbU9MBrfnG5BibVpFsaeWbnY1yzvdLM+Kk3gWedR4tHd/OpmdF0+3ysd+rH5AHHJ9fuR8EhrvpIYFahNhhdLACuWWtmHR4HYoT3egdRGuw/A073ZT+xrxdkUxeMVb6RfURcAr3ozHgWtNQ2XXSrfI0TZNBhJROSoZ8ao2cng9zopjXkn4fXfvc+a+XvNKAgXO/nA6e0Y8Dh0Guveho+f50fkj518Sz9dkp7N2MUaXnQO6C8boNaBb+OTo5kviHC7lwvYrxmbrfJMTEOPL/n6j15U2tu/52vTivW4ffmIiPj+px8GtyDqn1vpFk6FHqSwuLbfaAElf2H7FAq03LDKyj62PaZEDtNYyG+INETdMmS1iBE2TnXP9vLLWLgeYsck+NLic9yYfE0/jGbSSvrLBGG7qg17T2tF1eH/yrDiJa662Ra8r5yTsyOzTI2YM5Csl3nzgDN2VeKvbimfEaV69ijnADqpWEe+RK/nx15BMC6soBDic0mPJ+faA7SudgwPOiYS3gqn80ISlcxxI57PiOBE+HorSzZg9vtsNc148M2yKhydtjlHiTZWquqmPVz/u7ul1IN75+uxXYBqPqLHQeZ6EMRDc58x9ZPQWGb0mEcOzuXSxm81nxFfTFyN6gz0kqaKZoQW4bMDLPBAIXES4Swb+EvDiwf3CbyMIuUicfxsHX9JwTojHWr3lY3cXToljKUa4zi4t4ELoXjRfutRNF7QEbIbfcruu9+PiaGt9xL9bpWY/NZ09KR5vlQtpJMeeEieUpHVkuJEpjTScS7269oWJ+GsTPDLiNsYn4DZqyy5yaCX8X4SuErC7VnkQ75rlLXL+LbdosoGd2d3bzr41W7B7jqRwdhIuXfQ5uWR5MrkM52T2sK/GIK+TWe3uNcRDKwrYFC8oYucayANPxkkFPTa7e9A5XGUFa5/9xZQ4+1Y6kLN/XNhKkkWSZLDwQPw+hCZHZXAopXyODfNSIORXhEQ2QDxaNjYzX2+1XSjg3WrRwCEGmgTebKWlna9fm17qddlfn4hfmZRpwBy8AkFAMzDI+GFnlclW2eyIRzk8HLinsKUNShI4lrDIxmcPWnGDq2hlWLRSoZTWqJRpDRvtHSytNYnmiFfH3M+ksofEBOKlzNY1xKWRzlIOsUnQ2ZZdSJD0w1Oiu395dlSsqd29+fT8Y2JDAaHoqQrdves16/rnUSefB7XzhOqZnCEmd+Q8cxcWovRLhlsEpN0MRO+lXqm+ID48Fr0yK6Wa8bejefzRZPa0ON1KFVfqJB8S54jTweZHRVekp7eUNs+Ix1Jhpua+3kiKFd9L825yd8QN3GMJXBmkroX7kx1qmKBHtcrDWaUE6hsIvWrQVjlqYpEhlSvarePD4oxsfeAzjhEWnqJzTm9Ij3OZ/cJkdgq4iN9aYZG12oRF9ijCarlyqTcnPiG8IuJnmicO6uAeeqWIomQOIaeQmhxbHRcxJymHCvoL4sygdZNj5GYeaHv2g5PZE2CLhMEswV7Ca5QDMusi2Pobd62b6cfEh4j5Q3PNqquXDbK56tNuTk+IRxS3Az4v52uzT/T3+xExVVFPVeyG6q2Z7rfRjTq8wXZp54MYbNdr8/+4OJpSq9B+SKnv+WZtYdQtRpP92gMs6JNom8hItnctWd6opRm36QykJW9Au0JpUpqVpoqYPznYDWbSvukartyNL6zPzsLV3vJpyYB9RXxF0b101hFkHHDVBBIvoOQCieRCjhokVNHi4PtuUr119PtT8dvTXojqBQlE0CiApYLcYrNYW/gXVG8d3EOUtD4C1SiTkKRtIqqPKEZRQgFVkYAsEmsbjS6LVpdUJiy2s20NzHybP0JCVH6xnUNLDWKLsg5PPC0akOca/6Jb49hul9puwXRsq7SDfwFz25OAWt4jEFakUMscQ2xydMmlbZSbBo6xUSTZrbTbGXczolLuHC2I7XcppSRhC6qAUg0oqczKngZlRmcdUMnPRuNJgMa38f7EiQvKKfhShwgds0GOY6KGlhWyJlD3lVN0/rO3ajopvhm4hst0eGegnS21e3ia0WaJZu6OaWbLH0AzPzmhQVYKpbPieHHnoStmbCxf6s2Pl8RHiR+CmmiUNk3m9Vm8JFsuFEeKrg13ZRQZ7sooFCE/OKkdG0+uNBa68d/pxt8WsTREvaPVFibikCbo/qKN16KvIbYs6rbCohnaEbQtn50dE0fwTLfn0/na+efFU4loRoHq4z3KILDyGj1NnT12qbddHvDFaLx/OpltiY8MVc0VGiedy/zI/BE0o4C5oYpP+v1Aql7uDZcoFDQ1QBhFryBGgMasuw+6G5gCQ7EwOKhHwdzYCsWOmK/NbiK3ZWcdcVtShTTawt08LtcSom4x2oIfmRxw3Z8WT9D1MhbEcyhTNNWCezPkw8UKx9ba8Ac9FZgDXWufmh0vBiNrBmfEiUraAxMf6AWXe020a2mpqcSW9iA3OLnmVCAXd3e5L/c650nxKLTQfovbjLr70nR2BiztrWXR9OW9utpqmFJRdG2LTienSXnSZMxsbornsL0lQ83FBLpgdtbkaOAarTlrqPdr08u95vn9E/HdRICB/GC5H9K6kaJMviiz5cjtB5Kn4SYZRdiC3C9Sg5UIdNtqt5A5wKSAcFPT2xtgqvJtfYmUMttKonjuCv+mQDtDpcyi4H1/ckY85tDjI7NxjV5zBp0zs+8g3dAOdMNz5K5RqE/DGulgK8Z7uVdjnxVPjhtna60ZMuATYsNai54Ki56KFQqXR6UoJd+Ncn2kcHUtRnfiFzfIL701tuc/ys42ZUCF2AIb2Bq7u8dqiUGxtvmfJuIPJtROkf5hMpKTBI1ALch1SMaGW/jc2rgAMaFi53bb3UOXmy7UZ/B6OODOMLQGPu093walFzYbj75PNDSda9BMBduR7AEwUlLiixLghhk0Q9ETA7ZXanKIoTjuFgonFUkQSZVhDJmNaciGll7yLe5V7d+eii9OixMR/Wcr104qASjzfpFVyK0JoMBEFXNSiddPgyqtm6w1OrMNciLYDM27AVohxsqyMhr/pBP0tghN9mBcW+Odx30oW+EaMt9RoIAlV+x2A3JGZ+N99om2IhIVKQUWuQZKjDrHmLotAZVKGdZxYLlWZ23ZgaKzcjiGc3Z02gmO0yQ+itwat2gkdtpvsmSVixxzSGXG4NZrMBbXy0X9jtkpILdIcQfDcYfz5wojBaMR/tHoqTJ1QOHyThVZOz1qnGPAKIGpyS0GBePHoIDcfnYye1acHqk+L7N7AHgnR/bQmZv1MsH3ts+XkVcJxCvcy1Zq+iInB+yWOZR8b/KCeKpuqEctdNmU/zmZvSDOgtoU953es+zBbHEwk43OzjdjNaA3ob5XfGdxk5NtDZo6aENAixhq8RgbMB54p4Vf4HLhpZRSZ5OkNE0iKZpdMrSI6KzR6OBEh2QLTB2uXciJHHDsSOgZ1DlxHF21ObpmJHp/dkoOBOipZr8nh6Zctb7ebvqtifiNzvkIssaRV9cjcfJJsgGkswm5tX7hQSVEjTAkskaIlnWRRnqhs7HIjpA+FVzuwgCA/FtLPkro35E/rck+GXQ/wDQoiqnRkgMmBlQboLvoHLE4MHBktqnJTskVdijdiR/n+6qrCwEXh9xZ86PnQe2RdHDsatSg2vRHYLtd60MojThPchu/w5CpAhUQtKUGOnDaafx4NJ2lACR6GUE/Mb3y9+YgAFm1+GCm++W3PojpflhN9e1DaqrftZ9Hscj9gUeRgu7VGt4ZxzdW+RLLV2OpvjqYocSX7R8PVBwPdKy2DuIbl99dcr5hJ3F3j0g/mypUBvY7Sit062bbXa2lif7MZHZOPKH2ZV1PqOKoLBvF3LWf2qu9d/vjwpb2brG0QPJfUlwfOGuTlYrFA/eQTuVXrx3GqbzPMXTL0nawrMGOv9prcLfF9V453wJbpV4a3gOvEhINS3KULQYsi5gNqCOgoqBvt1l5X74Wr77p6Oop8YQxZgwJMcZ0s9up4SOr247G2B3RLgcHR7ZkN8Ab4yjifkYn9f5XSD0II/XgLBuEOqNq5YDP6anqONyrA0scOZxzuW8emxyMhC9qWAf8Cf4xX+OdU/XOsYNBUyiSvXidvXbl8gB4s7LtaG0/+ujKa9RF0cYkQlLJZV20aWWLUHK7e9ugM4KlkXQJ8JhFtkpmJxUriUqDFpa9tRTYkUpnpUMGnTCSuzyCPGQNkeJyytLlkpt/viH+6wYFLjv5aB2obtu91gt6sQVTFR1mGAVk+YsRQArsyRKe7/7mwMZrcmuAmFm+2kUmebedLRIVaKLO4ahh0SvRFPCA0RR5Qk1rMGgAf4ADLx56w0F9G7A9QiQW5BvAEK3jsYxlkW5oCxyalLDBbLCwfmBx5TxbZRxH/ywaJAF/TFKyJcOeVAIYaR942RgvBpOAtkOBPoGTAysB9IJ6ZrazioorerthxRtVpu3cGjBUQmj4yIsWosCMZq8Pn5ZGb13r+QDxKmkK/eGZkd2O007l3CiezbqNc7jIHNuYyp3BwOs2WGPcjM7HlOsA59N0SI1Wwva2tp5WK/l0ZC73tJVbjv6YWrK1aEOBE9IEpMJ+eQoJlh9JOGgKbmmPLj/tu5lWF8cn0P3oNwVWMSJCtMKLrzuHx5VeJP3aRPyLmkjJOV8LcTZEEQjSqi1g0aBye/KNB3LdS9TAXQv0AtvrKPjk0UGuUQdvzVZRYDUGcslPj27dVm1hGA9ukUvF57GVKNxOodpILhSSIx3SYEkw/jD738wBIEfdIrnIgpPoeF/vq/ooOZCoZetLSDjKJhtr0dmkBx5gY9kGwJ/nG7M3Z8fEEdX7XoU4hnsM6wsBuLzrxu0dUCtajRb493mBA/25qG5gwWE42pPyTBa7RhZeaTndwL3Z8VUisRkGJ6KtRYtfFvyh9Dmp0GSdFFvROoSspZYNRnaXBN4frxEWDw2RsWiYi49L0r08my2upybEWbVmKzB+sjMCehtAKs+OvjviBho3jPbLCZ0Ru3s58IeRaNEg3dH58X9QWAToMLWO48EY07w2vdJHOL40Eb8+kWx1aV9CuThpye53XebO3kCW8XlZIy6IzW6+rWFfObOy8vco6Y+0HpIUcQGrUsjOgd0EWqMmm6BfSYm6a7PgpfKmyfcmm+IFZBjKL1ghk2UFWhEWFRrCZXq/OKD70eqtxq2afcsowvCcOJvIm6LUNtinCXidQr9HH2+90kd3PiSeXvrAuuqb5UgPmo9hP3/COVDHatNlSXV9rXdxGvGyWm1udHCo5gH4sL9DwEm5RZijZXjNFFnPCXEkYaej4NNrvaZ+Tbwq2d+P7G7LdveKkX9ukYD5amCEIFIJ16kwlplb6xY2B6enS/CrGyMtcCbWEpBtSqmbx/WBnx5pIzFIatTbZ5ABmbSKAZ0Sx4zm8Ayqi1Xc8LWdOqaH4VCpKSK6Km74nfvHL0AziaoYgl3/vU7+5eJjpWWEpmzsWSCkRQ4qR4oErj7S3wDedQyFHV5216GI50fO3xE7nXcmZBA0pmEcMagmSKYJbbkma41AKGOCzME2knnZ7h4jovBOgSBw4mPGE2jWcESAMJ7AwFqLE2Hmt260lDSXa9PXegb+AxNBl6UPW/CcxgwIdfDRRHt/k25k1hY0WFq+vQ+2M5yn7yKhpujdSC9aZytZVL0qkpIFCmczyc9ib2upd/eyNzYH+hJVdZ+9aqBbhrXM19+fvCaCUjGAiNH2AQOTlJKIboXz1NqWw5p9ZnZKPK4GesAUL9JJFYmTgqhAf26FWrl6sQqEneRm2FDm2KzAsHxpbXZOnChKy1jaXRIfJ8uNg0mpt4RC6zzJJVCvCbm7u5fBrrPW2kKBnaZwtQdGfv9U/FmByqleH6/Pu3ebdMdOMEXgJk52IEOFeM7sAoGNYAYwL8mOCLglrlwSLXVWBu4JO9cV2y9gkvlsHRsWLscGZC3QRxdoWGSDoYdsLXAr9OWgzxV1d1D2KFCH6DuHiQtSL3T2YIbEVPz9idNTulO4IbYVBRe0oz3FaF9QZdL9+RPFEiKi22Wp9JqWmpEQh0ckXX31gyCSfnoyOydOgYm0Skc9L85J6sQAY1HM+6VaaA2yuZIdV6/UrvsVX5GAqL49MGB8pfcpgeqKHKJWU6/28eHB7yNi+AnOkFkmhOL+Zo2xWkiveBdiwWui0PWgTbYKjLQEhkUD37OM5mSFbAPc1CWULM3nr09mZ8TxVlkKQtRBxNPiMZVoq2hSqd7d+cAaSPWWglJmczQNGGn8ZTfsh8Rp3tuq3e6eXneWOPfsv3Ok28WlPeKrjLaudrT4ikRbRhvpLYtQYud6mYKAcd4DVo+fFI/FYmFjzAh+Wr82vfr6csi7AFtXuiY7zyTrqt2s8BojKGt5ZrGVBfCb2dNiWesEfSkSkgYO7EkxU07mGHgBjoPbc/GJam6uGt4psIJRRcKhDugeyGo2r/38sz4xoDvtG4NsPRb+qyzMT40Mu+LDI+MX/wkyoot1X92pcnFWNx2N8OkDICTYouv6Zp2zqHSVd6UPzrt6IK3fOhSt//KB/nFGqUsCPA7jCd53bLzWza/eXsEJ+q+NlkPxVqnpIKZBRjQpe18ulgCWw0lVSmFSVZn7fXLJ2hFPKOFS8uosxuHSq7Xpck6pooXT/NpiWQ4vzqoT2CctdUnE3FuRlroP0m5FMp1hPE7nGb/61iiZrmsx6uwfUN6LVEshzKQ4H3BgyFztA0J3xY4klYASuaJsFEha8oH5sAi5BO8RigR0HBB8CTq6Ndm1DqGe3UhS6joCrghwoghw8pNrs7OVwPFVCtf5U2LGSbR6KYd23ps8f3cqfq4Dq7Jz1VPmJLC33b3PSTDoY6sMOip0cfqaBf5m7yOrj9iia89OP1VAathOq4T/qu+z+49HVGaRPfk4cmyKKk+YtpKTg0FfTrhpdDboEIyU/oGGuuQMGxxYw+bFRD44ChgnhI1g7kZ2ElfTuVfhXvkG1LaG9ysrdAenUVrDC0IoyRkrMUdXLrxMrEB9wwH865hYU5wLUJ3EzYHxmVLHxlI6kIj+3aQ6+pGhZjgfV3eCIqFlrThfKpvEBlgyZKTPj2wiv4iFX0RKTZjfrm30/UOUS2LSpBDIrY1u+xgangSMOcSPpoIfTbiwzx6whSfEEaVaS2Rrq13sWdMpcYya9GBBe+BG/sjayhiOFi9xWB1BbpiJgYGOptrGAFp/0Ty60NW8Z1x/OhH/eVL3M/SL1WZKMYMkpVkpjHLEVLKq0GNsfck3RVi2o9wKcjx7dhkzPlZqNHIl0Ihhb78J0CT0+CfoFwci3De68g0arhbUmbToEVzZwxEi+oncCwUUYWGnc4tKRzPEGWwRdpF2LofQsHkORNzt3Lpiq372Nyez58STCKTcR6wKxFFKbZZTDV7vDZItDqZzw2zJMeCk9mBEKytljinGMBRVT4sTF7ZfCcrCjzS3DWw835j95oTV1mXV/jkh1BgwkHrP25Ni5lpTXJEbznDSz+u9BTMXFx/sigPVv7CrCELEEdKfvbnszBHiqFNlKMoven9yUmw4pZXU9H/zjdnfnpAGoEcaQJfaiboko7XrTb6xjNempqNc29y5cpqsrQzk77BZh0bKpdx4VWhSMU1+J12E/aFTx3o3XjW7nQEoZuijw2vr0eaNMTT7efh+cTZ7Hkwm7QJbKHGQFz1/9Pw/noif7zL10MumyC+pVW5NWiBOnfK2UMDAvdhuUGAtmILQxpFu4ZggZECt3lJwp4bqoUMfrJusvSr0I1vMywRFLWEaiefoEJn/vRkEfP0j4rRSnPZl72fnlVfOK31UKY+lFzAn+/VeDv3wUfG/j6guvkZLNLAy4gZKZt9k3xrMVAl88g5zZuNCbtPagd1Lkv9dsBC3ibicIghiyeTES+RNpjAkJ72V4CdFoDMGAhuMIPO+QrPtJgfgpbi9rFnBRUSYqs7aZVuCk5TfR/FkHKiBTVUdhKs401TWcrvJRjtMYqMojnnZ3ecYHqLJXA6g3wEt+tam2pNIqGFlF7LJyils7L2nHDYC8KUEd6JAaqVayKwMtDS5g4Z6ypb2MBqa/yW4QqBNmU2LuTkGeBrayOVu4YLwH5hDm7UmCLA0xN2bpgRbCjDVgmItOaYsNSaQUvaoJU5qF1lLzAjq4+p+AUKBpuMzXTFC8CaktZxkM7zR0iFKjwzB0MVhNCplDqcG/1bQsuU8yMKRNnvlG06izT1Aw7KSQ7M3DmMElIWImeF4oTCBGSPMlsPGNhtNNEE/p8QgQHQEtMYv8O441B3xnqNOT/lDFEHToNDHSOqsae2W70jfYYZKhB8ihRQQE9YFriVGwjBj1WWP2GiCWVcpzy+LF3BC6FYkFRhUKNxlF5xzDbB0q918Y370/ck3UVGJsrvkuCXPCNwsI3OSvEaD3k1gBbgmZFnOKzZEQKtsmaMRMYN4iTUfnP3MhPLN7VK+OSPtCf2hXNbLYuST4zTMunG21ugclFIhpxTjWAU/xaZSwFyuhLJar1trSIv4whqJar0sqjc5lwKNBVasOLDuEJTAKMR6qr0C/B8n4vcnq743wApBg7KM/yBWmBYdbkRZhpUE1ufMIpuUgW9IzMH2HJn0eKeAcMhCMpg/QdnaiI22CAMnLFc1cS0ZqsCuLGkIyILj+ZxaBEZoZsycEkZaaEIyZVXoPEGtHOqaZD8hPoEQ945g315sMj0vt8spdZGY1FSfzY/Mfncye16cUQel4p8lEICC86/Qjl2W5et9ePobxKd77B2owIxpRG3adqAZoF+4w5hI4lwH6iVVeKtAUYAhyK6kAZB0knKEZzsjjiEpYpxCNWCFw8pmfz4lU8yusMJf5EwmhldX+TsxVPHZJF6iZeMVsiEuCrIqt67TpJxrRjrz/Oi16eu98fPHE/GHE7afCfDJ0ERK6BwiVEmkw4BRY1kUhYa7K1vn8Ipr1xWgsbl1sF8epC0mc3CiBldZyhZ4SvK8/9pok520rss90DnpPv/ClK+Bx9ICH7zyFTHLX+b6PfrgKg/7uuCv9Zr4p8W7ldNV97EjwwkWFoGb5JBmXwPJIPRGZONA0ZORdR8gOYPCaT918y+PAi7nwJig7IMhqrIDOV/bGXjh2M5JC0JRD79ZGYP5jYMSRr+MwD8SC01lhsQk1rwYBAlbd04cx8Rf8rlQOYNSx+taH4zmmkmSMgtyYZyJTFhiuYNuujHqXIN9Rpp9Ny6EYeG0kEALme2fkn6td+++LF6UUraajDp/n1UKTcwatXiwBZvhhOjgPr++T3aWE1/eEzzY6wkvC4XvNIHZfKs9SPHeVNwU4miS7AlJMtFOXu9v5uen4i+qwixIzojLAfYmZdOFvbd8LdA0hW4MhR7x/jomOybcchIYEeWKIn3oHAwMVE640hSiKBFxgyUmmAN0YXuVmuKR0xQBwWQeqhjDHhRYPVoLkfQ4mg9injAvkEQHjJ4YitGgtT4kn89PxPeoTnn1mMDEIILhUhUVgXOL/+uZofZEEZ0UkRUZOfvMqlzDfSvHXb868FPJPrA2RBefEBvop+pqIH0Xxk1BiK0gWirOQRzeY/mbUA05H6CauR157kvrA/1jP8ZVoEIa1do4oRI59xJ5GfWaShVbvT7EGpH2Dxc2kf6C35LNwh2g05UyZOGkj4k1JQ38h5mvHRL9f33nMOj/hwbVX7/50KD6u8TZYxVKV5TpFVUaxN+u3xqEsoaNRr1+33R2sjgrO5bzYXFG1T65iKjjqJfVp+u9Nvtd4lvZaQMaj2IgOtmuVHCI/ObWIcTZIdtyWBUJbbjYYm4xSjqypWtEBgEYFBeSsIoqvYywC1Y0dcAbWZdVhZXhtICnKFX5NDZm30ziKx667Nn1Yc5RoejiARy6opC0qQ5hfJhg1fV3Dh+s+rEJyQ4zDsA9qLLa9T4n4iPi2dLUdpXVjLQYlBuXVnsGPZsGKBt/7hnLqjqUCc8QqLlTOm5cHITOqhYrKWsp6QIUW5mDqwRe6fnSOOliue1ojJXlOlaWUr1xealcx6DdUlrYGHbHB6I4Dd6OkzNvvL6Eu6uS5ptsW8qRd7CgHNGWW5kk82eTpbGLr9sv6zA3+vDdP5uIf1SMUqomYzoMxZZFa1OjAkCSxgItB0pjTMSGpenw9KVgABeWKR5+jDFQPRjDvs2CWVfk8qC0AqrPIY0LHHmz1AW7Jjk+sIQq+EGqOYXBjpEUNc6RRmicq5Z/u1aBoQ1cZjTjrJQ5GdN0X3VjPS9Ol/KClLuaOI+cJeyqcqwuDMux3rgzLsdaWoyW9EXK/kXVtLJHTA+LPXL+K0Uk3NJ21no7G7M9gLxyQIBMNaV88YUh0m/aZy3duDuoyvqAHllE7AMkaKDj5aoeB2fKmf0z5W7cW4IbU/uBHcohDdDRQH/b1877txPEpkq1Cpv6ojhXlqlKvko3r6lUtOmbT4ljSkUQjooNaRW5isCNN6uAzKXCWKv8EDvGk7Lkw/h39q6hZPuoVevCwtJeHhcwhMKB1HydYvje4dDerbx4aDuO+O87S/zXrOS/D5F6sXPxIVIv2lG/pxgqoglNO+z48hhDCixdVS1Hnf/rfWFzXyE+qlaHTMF6Ao28aNnDi7fTBwP3xOLQYettVvNbadExlCrKIVQbMDpDag+C1Fff1F9dTTNnu4znvlhpLU12enPvL4lvLLMukTV24RT3N8bYbDGbXUqpS6liBo5+wth0piFXtcueNiCg1ahXr+BBIKmdwwEid0fX5sm6DE/L16FT3neuVoraqoZLmcCVIjATa2PU/U5v+lS/jjr5uQc7JbngyijIOzjC6ur1spkhBKrPxzYIKdZdeoPyeJP3TcL+AUolUsYXQH5V1juIl2jjUfVzOvvQSJmDJfSRDyEHawlgTWD7+RQ+3PyE4GsYpWw08FzgYLqLxWkU9eRJSBhqsFjFWTOn3OmjwD81ET9aJdFVAWDNOgn5h7lWK7GAbBxWTHDZhSZ7RrVjCkFaJAyDUBww9OhpSxUcoqSAM/rcaNol8FQyCt6bvCjOIeLBGpMdKJIBI/5SYvGQwFDU9ycviqeNk4bAqoa6YTQtprM5acACuDzGT5aCnuXEd+prVn590LsJnEw6qAa9c3OAxKybPOjqj3Jadt4YXH3+ddTJIU2bnVuHN23efhg58cnKGbLc7OHS/nduH8bwJ3y7PtxDDDt3atNE19XVqK1eWv637ldY4smVqVndSHcHFSX2BQmtNB0+S8bkEsL7DBYPtOSfcWkMmdq5V+XXny0ehdyHK9M+oPIfmKxyeZVHNHRWbYEf16KtV642xUcUIwhRorWULt0wgjfjnNVw5BMgY/jPVs3Xij9sdSlexLan6jmQGiSy89bA4ASxKdFqqFoPHALFHxbZH/YLFPiUy4W2nxWnJDlJEZMVSyUY6cF2IyPcGdDiQ/Boe4RQaljtvF0fh2THcnZGOgPNPXdVWU/PidOSvMxpIbGEE4dqJRan/R0uCG78eJ77RkJ2ejXzzybif3Q5NliFT5VaBopjHarkmLQKA+YKs551B/EkkAA+DoGGoWUX7TY6Vq3DIv7wnxj3BppK25zzHbez5qoYpSgV1x2IOSgwKLEmEKbza/gSE5CwMBnVh+ygI+ixMjJlzGTE4qeebVIQSKbVxleVuTvotKecNL3hva+h09+yZKd3heWo/lBgC79ywe4sFcSvtLGl70bk9v8RUmv5DDeUVEpp+r/5Rhns5sW6iiLBuZNplMK6qBgxZwsrLZH2vyqOKTcq1mHElurz5BS7FAi9gXE7h1Bd4w2iI0LN5m/25sCOuKp6kIznJ0OsL9gSimZo9Dd2VSKrzhXn2RdBf0rMsJIaV5N0PvSO4v8ymX1EPFMq6+5vhj/LztNYlJOgKelvsIZeM78urqhBlk6NnASzDKtSyezcNimuVNQNVBHnoHNlQYLEgYj7KhHUOPVnZZ/D3iJHReZr+znFHNqkA7F287UVTrGq3ehOPDSi+ebVZURzQNDfGNEcSqAhIGP9Aty+40DqfljW+7ni36aINEJagwlkufRn9PpyRj9+AHaOjSFyvRSZTTBg+bQS66VDw5Rb7cNCEThxSff4D3yXjD04xLwCCtrVO+sthJu9+vxZ8fX7G6el7FMFCqZ8dGsb9MWRiEDdtgrDoe1H3BFay+7VETVMJNCyBxLMfunBVlBZ3NC1Mq75dLNXPG8QpZRvUoFoVM9ttNIybAZVP9T5S1kEWkySK32G37vEhjuHOIEFlWbfV8p9/KCbZK/R8msA3XMXC8Ql8jxhy3XTrNKC7i27C7k0Wh+qv3l7ybdNPFObnPTKdb09e1w8ypkn++n2N+/V8QxJz0AoTA5NQw1hJuA/8W9AXY+Lo1xxDncMgyWKX8vYPAZtI7SN87Vr05u9zLolXpecM4ywSxMaqrFtcrKNRZfvIiDkF30U3bM8aOCqrD0BnoKFNculFGKc2aMs4sx9dH3PFOcAc7JLh61/Y6cqvnWN6ITqZlpGmjuqJepzoET37MgZgvGExFVYTEHUa0wHJk/gEu+7uaJ4wMjHcKv2oj2mJKM9xigG6u+t+tKcZo3ZZUTrNYNs51tXaia93O4h3TW3Dueu+TbEMaHXekU2ETk+MaWWnn7qeh8Uj+n9ygiDJJcTu8KaVdX47i4nHStFMLhR9cBb83GktGq0VHaMA6WVGvNMVd4P9dAGs70HVumt3n/zIj81QzVqFSKSsCaVajm0sWIPf+lBRUk/LM4s5QvU7xz2vPTWjSr55nIlKHrxkGNCbYze28LOwILj4p9oReB8fQRBsGqfvm52RpykokGjUgHH65syn3az2qkO4TH2QBklzWAzTogNo1DCYFn49cM6G27dPryz4dv3i9dxUkLJhaxuam/Sl0KuJdVBZ2vr1MyBrEQ36bpSGlby49MHysqXxEc5OlQ/Cuda5SgpTYe09Drcrd4J8PMT8Tc6sI91pWgxxU9wvz0pCgWejSDHJrtouGKE1CDKEK5d3owpHkfQ9lKDhQh9XISIWKdFQjh3Fy3C9KnytlCrEcqsx2/LFcfMwV7YW28dwgt7Z0X5UB2ZtnVXnuLWOwP8TLGSC2x3KZ57ugYCd7bT42IdBctaSpIkH74sk+CmXJt+8mLtquByZDQ1AjKCsZJaXafeLPG0h/GD3b7yMH6wP+dgyXL+/AHlfzbF813uEKI+W3xNKraWny7rEKVHrk1vv7GUIb+vRwo1T8K2kbmuskJt2iwwEquxzjsZDz30XeLjqVTRTiu+pg4Tvxqe1X5BwG/aP+vxNCe8EhhpmnRda/p2T/lPi9Oq+Muoclb3xWiw/3d2ArWxxTDlXaF/XWYlGwo/VeHY2/cGKe/DpnaxIsD6zXT17WK3flRXaeuGzwncfqsGLta8ixMC2cueg0S3vXV+1c18f99g20viQ2PBRAdtQv98yEhE3emJ5f8XnylXBV9wxNA1Vq8sVQUxpYCQfSlrTcWaFxjHY+AlPf2hEOuOKDXgyGQLUIGF/gUSzIVWpB4qhKUXGJTMFjOlEvToA1bXpwons384wdf4lA5bB2nYd3pQyjeKr1XVg1yE9WVXG+L3kQcBj6SafVywjxRO7RPl5BjXYaUVv7yGFnf/pu/SOf0TdrMOMRFdyXBFzwTJDiVTwYTu9B6XXXGP0VTFMtSUqqApQIhVakrtCGL3AZ8l8tV7YhJr4GBOT3ENf5CYxJ1XDxGT+KulnpAJK+oJvdTFgy9sv2LwuUCqgqIsFdOnuuwkV58H+ouKrl3QDB4OWLweS0jd6RnvFyfi1yYMUADLQJkDYsWhHC9nSyGsn+CzasFJZhjiM0SQkcpyxtjULNCpRhbgFo5sqWIkFlJqcqCtJoOCVnmBimIl2aiRvY9xt1F0YFv4DzJBpfWaRgfy32Jv4JKf+yS+W2EcvVtRveBypzcEPknGWSkogEWOrG2wvlLn3Sk1ajEMQLIcc4zBnEtM71mNE0bponzrPsL91NIL5tX85pVu/3zRHNFAVb2IUivKLNGY/351XB9LT7Ic7O8kVdpEyTGM9tzpHVWfE9/W80yE9+YhQGYkafvHU3DnXKqy4JRvsgPmz/l3qLXYhPxVJa5YoJpWrn4hfQX+qXucqpv5tRH+qWsx6uynV2/UykA6OXtXiJbr4zQCtaKoJJMiVrtjKAT0t0QNNK/voPJTfr9XpS+UIlvoSKmcmnduVGL9ZN8se4klUeXwNWtfgkZ+/8e+gSgH9Unu7Izrk7jV9Un+G5d+0CuUoE1ygznliveZhW9TnNqBUACYGXxGs+D16GLuno3c0Lbnkn1Q+jLVJFKtpyRO/BAMG+i9d1RSd0r3HWZCUOopnMgz4rjmdIhuuMKU35+cEo+qlnHuXYbV7uy4mLWuktpCPMYecBKAznX69J03KvzRCfS69xEmbDnazzeW7Eh0jTl0BVd34PYgOs/tP5AkvHMISbgEOxiVbbpzdwA74F+XS9lgJr3d1x1xVhxT5Q3lYpwNyPDNccp/hUxEjdOTIiE58wR0herZ9d29rMdPIRy6KFAqpn+/dW8v+Qf6Rh+Epb1zSJb2wDN99xBnenjo8t2Hgy4/AB1x99XDoCO+aYkOulfb6E1VTAMbBTfv9jKf4zT4XFErR5+M9/OLG0ujLcSbtQWBSYemNZbCx5hw2EpNiZwhoiCwNntKR4jo5wCjNlLdRq4E7hbOYZG6zRf5sQ5lCBTNGT4yZeebBh3AZr5+bXq31xJ+dSr++VQNy1xInJfiVwlhlo7f6EfrBQunFHi05RdwLYfAMWaDal6oTRrSJHU2qKp6z4URYgfexhigax097ANKummyDYEWQG9uMZzCYf02eu2TBuWnOyOVHi85G5gmz8n7nAXFb1hT9XJPallopXbFqOnsAYJHBk6JTDn0Wct0EH19u9+ciH/ZIcQonxm3jTfN+bJlvGOl9rnZ4qk69uJf6J7GpsWW51L5ntETw1hGFJbgeAlYoqKV1RJwBeS+LT4xrKOgPaHkMQMOsexeQseBk5+VNGBlPgFcwsJBwd9snK/P3jn8g5d3rw1yUZYbjqhkr+76cax+pN0QlH73xlIwi2Eamu4nlroKaG6HcQoOjXIVuIdE7vG4OIrQ5zTwatztVZNhgwdx3IR8YIBlvntzjGUuLUadLWpmeVacZCZI5vMSv3yjVtBWNh11/3v7ZXAGzuDEXUSqxAIrVCQCbGQiasUIBTOuk3e31xN+Z018aa3GkCOr4uAIvztNrwFg2KiHPnT1nKiWk2LMsaRMK+AOJmSgIY/lA2zgKiYhh8jVBKReeEqeBMPd5ORKLDmErH0k13HAKAJ8G/AtiagbWJtd2Bw7i8jIHFPTv9YhXQ66ycZxfVufjczeNtlJnX2wOdiQo2PAHJX9lSUrn0rVGvKk2LDgp/TIEwF0pZpsTSKQkEHMA1ZaMZrLc1iXdZIFN4ZoKp0j5+TBvqmcND+eoY0nj7jOJuXIIWZ6aDBR3NxHQgboSK/hO3Ks49RdgqEafm+tq214RhzDD1x2VjdSr+voqgfxVkl5SrWhF3hj58++e3cs5YftRld2VTHzkbf97psD9AUdoVKrqP73lnOOnhVPogpUyk5G3Epe9+YJcURJEEIYgpyvXZve7X2SnxLvMO9B5xEqUvyKNF5e30p8XZrvNHlFFe8zugNLliE+v41sWmHeUReA53x37zrN0skcXU4Rm3hXJdhRuuxKO++FAiehNGDa7uJGq+n43XGwCNFT1Nx62xXAHZW79EWz9aTZ/hHXDovL/iw2iIcpP2QXl9y/XhO/1/tav158qnxn04FV5cj/kLusNCpZ2CChkbNsG2sUKaJjrODQRTXUqOoKlmTHD/kVki595oHq8b2rh1CP/2R932dwn4ebiVZH55U23YtT87XNH5qK/zXRVkdJ1VBVTj42xlGlCQNGoHMmR61VUwoM2ZhyMrbR2SUdmxy0DJEeKzc5edlYSW+J+2yVbTzWZtIIOpc54Ms2JsEl8LHB4mz4aKDROQXfROoJWjufo3ZNdjrZHD10HnRqSoH8mK0yPgdvdY7Jq5xSSI3H4k/QKTKiDOwvanr3wiUFk21iTk6nJoC9riOWDLh3p0KqvcrOUtKTykNB6L8ESkuxf4TXrHhFutrh9yZ/byJ+eqJxxgY3wmptc3BeU6WU7Ly1IXtfXopVUsmsvXc5WGmLTei8dL5UsvUya+2B12sLG6A117gPUmUTtc4W32viIJOJGgSP9o3LNioPRCtT0hsa5kTugwviGWM8O+C2SnpMq0Eh7J6+xhv7Pav86yeHcceeGdx7Zzn6YqkIgzalGJBBRTtgeTniuZYCFSGiChliJSV+inME7QFPZzxPEN/yCkapXl8S2rrJvVslRD696otsnZVyoLEBq3KFVTliVUtZCoqK1gwUzjcvDvwgdZMPbO6++XDm7qoX6y5w2aBmCTby5pWx0j1q+MGnffWhpn1IDMSbrx8eA7HKsbjlho7FN2+OHYulxUO6nN68dwiX04OwSG8dDov0fZPRGZ8RJ4p3ngqtDTftrTtLqdgFRwv6SGuwHFfVQ2Afcb+jCDrA5vTCzyq7rq+pO9iZt6+vuGJ1wwelA6VWWUrS6N8KeufKgNDqJqPubo3gBifEozwHN4AavHO36nHYZNTjD033qVFwjs1WUi1VX2RiY/Mp8biF34yNoP03esMqYyOIpXcv1nDCAm1hTSWEJoNEbTi+GgLaA7o87ROyp8fGE5gojGvlF5uUDO9NXhdfiaZJiOgvQRHiQdaEJkel+cU/kyyCXEJwQE4mNNlCew8ixMgQ9XwDH5HsUZGYT1tfQiEe46LaXBy6vwLv7gyQgKNWo+39N6yhmpUa6kfE0+VdTcdwW4XPyLk+Br/5jDhp+sRQdqSbUoTp3V4z/3+EQ8SaT+xKqr4YjMEA/MFA3bw/JM4qZVe0bzix6P8EAAD//1f2MapumAAA"
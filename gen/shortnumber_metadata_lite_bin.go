@@ -0,0 +1,5 @@
+//go:build phonenumbers_lite && !phonenumbers_regions
+
+package gen
+
+var ShortNumberData = "H4sIAAAAAAAA/7S9abBl11UfXve9HqTb6mmr1VK3ZAkPsnXaenjP55x28X+lbsn9pB4kNNnSeecfQl5CUfmWoqiUtZvYgCliJic2BicGUgmDmWKHMBUQCjBTmAqSDxBIigpFpSpJhZCuVAYFp1K/tdc+d+hz992v+/FBeq/vO3eftae11/qt31p7+o2T6b3icLu7t7u3sykOiY0bT52n/7/tPjFtn9i+qFRo2+oCfabp/xfp/5fo/89tPHX5hyb06y/FH78Rf/xh/PEn8cd/miy0dit++mb88dEN+jH9ehJFjYgyFfco1Untmz4jyNNFgsy1NS7Gd02mQtzXKdv2u3tv6CfNzZ3NnUML8pwUx5TSoW27eqvtdzYzQj1TJNQD4n40aK3rlO9Tw+Py/chk+pAQnbI9RuqJ7YuQ8ma1vbO5c3hBytPiuHpi+6LspO6DaqusnO8rlnO+yWCllXaQ85g4aqWFWDuHk7ifnEzvE/d0qu1HJvakOM6romnWLLMrRQLeL06r2oeFRgfxDotNVfsk2Mdygs2Wa9PkpHq2SKpT4sSCVKtE+tDG9AFxrFMurjxHK2/n8M6RBdneLR7HvOI/HXwnFXWgCppWYzD4frCq2tncOXJhKo643b037M2dw6MduFbUgRfEjfRKj/mXMuB36YNSQZuqCk2UwbvdvdC0ndJ9FeKL6clOGdpPQXd6q+1nA3BUbCptdjbTEHzfZHqGdl8Tx8DyGCyMwDFxVMlObZn83rte1LeHxFl0LTSd2qr7Cv0oWNZfu1JZ3SuOKtVp43K66vki0WZNjauCD29MHxInOqlMg8F9Qz1pb+5sjAzYRWGHOevklnFbdR+gBa3rg+4kVozpbN0H29m66UNbhVapnY3s+L5Y1ImnxPb4u7dWv9uYWoemNaaBGPMT0bRmcSK+J6pD265Thyfmt3S2Xy8V9UuIU7RMxrb1wix938b0beJ8p3wzE3EmJynuQ0tb3AqN8VIaA6RNgxGSBiMkt7St+4o2lfJoL9jONVi3mR69XNSj18UH5hQL9rfspO/ju2ra59WyQNjbUabgOun60NiqCk9sX/QqNEpiU5m+ksPICHGfVxJ/2t17g47WI2mcvnZz+rA43sktXWMx6yfrQf/tHN25Z2GA3ikeHRaVDxgn1zSNC85BC9XG0DePXnhcnMMz+onti8YG63wVWmilJ/3Natb06Ji9UjRm3zgRH5nMi0LDFnRoO+v7Kmj8W1kahtBpAx34xPZFC+VYsX0RfNP5pu1Djae1DLTEq9DQ4CqzhbUddFUF59xM/qC1CzV6ZoKvq1uTt4nzkEJ1Mn7B1sbwU76mATny5uSsOIWH5scLI5Um4eO5UxH6SEpsycxae3/RuJ0VZ3ioVBNqTIvqlOlXHIyfjJtc1es2OY4TpbO7+wNF8uHIVjrUDkcZXjq+t38iGYvNSmNRiBPU07gElM7rnteLpHuLeHixTew46KKmkXOb7bQ4Pvf57t7OoST3Lch9j8qZGefE/UnJvGFu0sFt+2hUrBT/0lNF4n+Z+P9j00s6plN1T2ombptO4qjo6KDw0AvYUNgTBp/UtIGw3em7Dewf0oO7e9vjk/Wdk+lJcazTW74ZXd4nxH1t02CXurbPrfFLl4q6CeuW2qMXzp1j94qjnfVNr1QS7fOw/I53ytVtxu65X5xUOEO17wMs6rbNraVLZQ7R35+Ib5tgCCWGVm4Z3/bBdWrL9qHuJH40eGeLU6dTHjamZpOODvAt11fBYA5297aD78wW5slFQy84iW3U+NC61rLUIb3OyeAxg8YEi4+C2t2rqrnN9rh4DM/CyoR6oOl3UqGxancP7UKvpVH8p5vxpN1qi09aL+ycZdJEywQjAMUKyesqNMrQi5XyoYHSNjcvWCHxPR1PQVnZwCdnp22vcFyrvsLU04TSUt05NDpLZR7iz0/Ez06SpBDTK1XTNlEab9vSFrOgTLDKBofdRcexI2/AY/irANtddcrXfTA+ePiaFZ0gnaz7TmL3qU6TF6F1MCZ4h953BnNP80mdD9EZr1vetl7KYF0Vz0BSE22om6oNiv4hYViO78gwhcLe3dvZWJiUo+KwwmbOrO0yfzU1NP72n5tMHxAn1exIMdFuXFwhrGvZKopPBSxc2EYZ3/BSmcf6dvHWZaNLBqVUNfeScfG/aWN6XpzppGradcfiw+IcLR2a+kbFNcyb8cJJcaxNJ5hZ4Sde2inqzA1xDR0BPLC7B7PMYh8ER94VTPtOeayltoXvAZNnTqxOufiIJTX+hrk59Pu8OCs7j9Mfy69pMEZt45yb+QG/P5neL45H/bTqKCbcptOm7oNXKqs7yxz7Rnj0V+3usb7DqtjSDvvKK0V7S8lQ16Gt2yo0nW76JrRydkSfF2cXHqxCAyhEyplL/BOT6WmgAvXKfgH1oA0TajobsaIzfXuuqG9vE19EfaN2NftrXlZ0KLhFm+g+cY/iR2Zyf/X45j4kNlSTka4MkaBWxvfFQQA7l64fKLDztStR1nvF0bbtVBYtuHSjSJhZU+Pj8qlS4BDwiupkk4c2L5VhGCfFcW5sCVVZkO1b1rkdSufdjktfWiQNJowPdl8tYAuLE/bLh6YPQpts+bbPGclOvCe5MTroJjQRz2hhN+EcrciucXVoNOwcYCqbO4cy3SjDVP71hvjdjZnNpvtof8FUxqmsO3IaAQVqi1/I/3TYyGTY+QYGtTItaV3bQmDAE7DayA5qe7IVJKwh/Lvpt2HpKcBFMG1M3W8H2xl0cZu/hCal8v12qLv4QNPhm2jbuLavYD5q+kR3xjFWKbXdgji2U9rhF7e7h4bw2O2DqZ/YvghVaYwMTd1UoXGta7fJOjNYIZWiAcdC2w407A15zc7FDjEiKaWU9CUFy1OpCl7erQmsAamDrskBD0Zjtg6/OZHiCeUUntd1g+YYJUSLhAtuB0VnQA3/6NDsUPrWlfs+Id9QU2tUURkctdzgwegAnYdYL71cKhsa2/JZHfDzLNtaa0aIE6xxY5wk61FfKsMj3inegf2kOvhWRmlTBR5OS7tjixbsbFShI41ahCJ/ZiUmzGpn5q9n5H2tSN6LokktYserTlsI7shzMzD5O6jcuiOot+nYtNqq5/qwMPo/AA18hI6o7Z2NJfnPi4cwGjBMg9bBezJpgDPlelKGZWTaHpf0TybTLfH2RS+Pf8wWDbt7wA4XkUPAMThMyDGHm59bPJfL8AwjFMJ3BmolmdZQm1jJb9gn3U04gnD3xyyF4+JeIA5bNcmSOvkRTMc9KbizNB33iqMSR47SGdHL4rKzpsYH+6dKFcYD4jQWpOqMbXosjzUo++UydOKRhGlSs9pwywURmu+YTE8kiGvEZj4tTs6ZtzidM7KWeZtDkxTG0FruO9BNlk6nsq7v5TK/8pi4V6lOab+1yv29tTE9i1DbVt7EqWYOaqfRseQDI1wDgEfDWQGwcuFx8RY8pizsoC3tmhaOXHDWhMYoLG+0P9qpMv8yiA/iBRI+CYaPEQdIZd2S9ww7oJNmyxGqQbZLxY8EMoL6iLBLDVgLyrLTrpehhvDQlm01Az0QiLw1eWd0iWwno4blVugzFbwEyLO5c+jNyWlxzMFakDIYV81DVJ9hZ86udObORIBeYe8HdFTm91GZp/qgeGC51WCtNcPSuFccttbOY8TrnBaAmhm5rhbJNWtqfJH+3uHpmflFOoqLPsZRHmVg8m4BJ7TG7u4N9va/n4g/JPRMKxVNZRNIUUkYrwq2aVri0vU+dLbpFc7LZgjh7O4BGO51UkBoRXYOZy/eqnEKe8/LTuneBuMpOEewmnMVgXJAyshSBJqBJRZXZI2lbHC0GqBKBC+1VaibOgWBekVCNdEskSrAYpXBGMClK3dVmS/9mxvi1zfYjdEUhhkdI+oa2CSw41QdOlO32EMKQdqWxwmPqKC0RuAJCiIYUtrQ2ppHDR4R8YCCMpo+0i1a6+sqeECO1njnabzSkLkqgppkeQA/SmimgdGgg/E+eLRYN3VDYyaVAk6poRoaHZqmHYZOVSoowwY8umt10JYhaB2Uo3c4Z5dWRYtpNy1PWeiM6ytJjc4mQ97iqAvtfWNodpbW9A8BqjqGxUUxe8Mx+4Ul/UA6SwBWYWyqfDD+8vX9hN3mmg1NvRCZONzUal4L/OZk+og4s2SVP8lI6fJZwZQjikAGvUZxlaEab40RABhW2CSd1HBwZRVa0EsM6eXz4qH5Z/TcH5eG/vs2po+JBzu15ZqiDj3IQbCO3mqC0cH5fLfKYJEPir+dIsZ0xpBPDFMdqgTusIWCcJ3xOGgs/gIEn/aKlDqYVkpTtdEOCq41cXwaZ43Gqo1BrA6HH3ZDjbBxtYSqCnECJ5cMjauWjKff3YhoKhpZdVAdG2CZ7HiUIRq/MRG/NkShpMQmpIijJx3Di4qhCR1MHRBe96GOPeygfQG6RJWk0ymvex2MJe1LakZBYSY9Bi3W2RisQvvkO5GR0BpCbiEGOZjAPnQfoLPrKrQ1mmuco68BgTEy2LYKTslFTGlh6X2eN5Ke20hYvrTujuwcXRhbmL0yTjsHnjSIdRx8Wj3YZaSFd4i3YUT5BfjVKyCiVbCyClpap90KfyDPezIw+3JGwatF8s2a+ktCCy+//0DRwrt31j5wIM7a54oCWskUXAhoZcmXl1/bDythLJS1kvT779YTEZ4U71xNS1JMSyIeS5abcPn1fYV0qBMNQFv6zczRdQBRwtaARMoHu6RWF/r325PpOXFaFR01p1UKnqVpybMtni4LHSPEzQ27/rYBjME3DGJocIBWQalmNeHljiO2Tz93ABHbgtUyDKO2pcNY5qlcF1fTwsDB1CwOJQVsvWpJAbIBStYKuHK6CQaeBixrCoRWK3bDp6IiMaOK7X5x2hgTCgnTT5cZhGONjsuWZYYxXWpoJifY8/shYM1aHJcq2dJ1xpa+n6EpHcghcTYL9jxdDFsqzLJzYdZwU4XazIK7R8VmbeYiuZ/KDSFLKeFLRBs2d4I9UwbvjTU6PpA/d+/arTUwhZbVVHT9XNDJL1Y22Vtud28bXh3ghlYnYorpg1US3CB244Cegm9ibSSkSKWD0nWA14bNBMooTD324SL3SNmquvA/D4s/OwyBVDNYfdbBtdpmuaKwWxZIGBhKZEgm3AaxJTzLTIug5z9zQISq0BmoSLYaLahXsOEQAIOOgZvoQBO0JF/ycDE+eAj+NEXeTGeIF4APbD0LqRtmeVosYQqzaaiJhqEE4/hdxrKhatAAfAOgUBhbRh3Y6rXUc5ZWGcecJkgqQ01/bBH9ckwmApRFI6E0MSzQbSLeNfDh8QepYCVDCrj1xshFyewAbaR4KV6BJxR5D9uhMw2Atbri2U62tQLoxjg5z5amAEzneQIxOEBFwFYyGL2I8mEGue2BGMgWu3PUydAg/sHLhXhl24BU+DEIJ4mMiwmSmJ9qoO52EsPb2XmxQJBCE1qGtETBgogftnA/GEwk6FDjU0Qv62YmQovu4xmgshhegLPAGTvtB0nnFo5vEQWJf1NAtQCSBa0qjjGOaoUyi+BnJ+Kn5zdyjBrPW2wMO2EEAJY2QMh0Z0CuCSb6RLaTFFtyHTYW9g9ImcT+A4Kq8be2Qxw6Di2YgpoDyHgGMWmLLmG5AUeLeCqarvEovQSnJbt2Osvt/EmOU5jCrCgNMC80eYjimTKo/bEIe8cmO59Yco2sgrF2juxzTBw1dsnT/jacCUfUeNzthLgPLRPMWNeZQ+uZMvx8qb3xofwjHkrdr3EkAM0Qxc8Tj0tpOgU1nWDrPNVnymCB94qW8RcsGW0tNJCWvHS99KFVdRV0qxis03UdtNSyatvx3n3zoZgzQ1BB7rS7LN5L74HqcSmGmrQE5Rx0ZgsKgH0EsEO1TD46R0RuiGsYDc3JOaFFk/Afav5OEzQmxDi0hPUYDP+DVnuAamlx8lGroyNZxg/41Yn4/EQyjgId67GnYsckR4d10oIcN2GTKtzu0aV8r6FjtNH5MzydPm9k/DB2PJ6SiA/GF5kOqhZoK74THehZlxNNUZuex4TQq8fFY2gM7FW2tmUSXsN+oRDBkTdT6HD2jvmZSMvhM7mw9znxIJ0fdVBqO+hgWih7Veei3s+UUSIeEedva9o6bn185f4KA0h1X+BNnoFBPu/h532g95VFkb5YPJlaXUXdr9bmTPybCbG0cXy2/UjgfmfjNqU9FUdaekVOW7+vzMN8n3hackQZax8897TqOUnH9YhmdBqHE4wdgljxfgLNrettqN14374uZ+EfFptt22Y6UOaIIrKLFRDaVTru01GDm3adBj8h7jOaKQtZd+N9Zd7kSQp9GC01ALhqhavx2cLIPKzrRmWdtfeVOZOVeFdqErwyxeANSGfQP6Anw9yYzzxYkPi/bk4foigkh2zckGa5NKDPi+sDiF0HxJZMxYmWsHVJ94FrB09Ba0oaMKaWobaV5ENkd4+zBzDHF94j3mV8zPMzHIyOGWRKNaGz5NLwiUPSjA5S2Vn7NYIGhYMo0LAs9rLix1pa7kvqtAy6kkFbeE0kHPh2hr6T0g9sH0xy8+B0IexpwZXYFq2SKbPEYgeayAcE2Kal3t0L3thQR7WDxqQPHuQ92+O8fXPylKiVamrYANqueV80IyQS5Thuj/FLM/7dwBWOq3nNtLOxtFCFOKUaGjMEE2uTzcG8UkYlQpuxQWpShlXAxxc2p+fEyWQy5wyZLxHvhYzSMVuinfntdefg2AH5k3xwY0AsnE9rbciqqytlWVB/MRH/O2WuqJmvOb+uZkDusLw0RCV97OSQH6Tg2lXB1ZEwD1HRAcnQJVajS4tRg9dh4M9xVFexbw6kwSNfhZrULjRVBdqA6TkCDM89GOIFBGs7uCyObbzol4IBD+eSk2GwQuG19zp4J0PdtCnQPI+lXRHb6Hl8JY07UWNqleSdLbSoN3b3FmZCMnvmIFnuV54+UJb7rwDrvh804XVO2FvEOWw+kDGgHxWfvFL1Opu8eaXMv32reGyk+RgqxbRqnWWOfc04xn2POKJI62XkKyOKDS2Nb+1fRhjnWKfy2zqFX/NJgFfKXNikIzA6oENpGHPBqoCEY3j2COG0bOpxInuwNfbdykPzFyfTs+JEp6xrcuQdJOWAYGv6tX0pc3LhjnODccrhedjQmErKBZvpvDjDcz/3yO7e/C775GbkyLkmOxe8uaHLABkGvaivOqax6y0LNMQ5NzvrKTGXz58jyMVqEoq2KmPwyrP74sqltDzXrzbaB3dukJt2MeUD3C47yH2c1hgYdLXsiQHKgWq8NTklpsrJ0NSpd29OLosvmZPIzb3UgWyFXEF+wXijO5uzifk7q8LQUEm5UOuVMk4UygSxvTe+uj+Vw20YdlcRGqP/59RwcajmtkbHZftkKWmXHs8IVkbRuU9MlS6qmnIXmvWFg9Cs/7E4GsuJx5JO+gSAsG3iPaJkeYf6yov71Luz1xgtF02jOd8apiHsi6oN3le3QHxWiiuiLPX1RzkyZjO6N/G1IurdZ8HQK2XAxsPinFLJaY4d6hKelV0bJZXI8tZMGfllobU7IbmAPJddqmXkkllT40L8AWLd93VSZRlYrVpbB+lKGXvjeXEdRpMk+pjC0V4p2J5kahP1KSQKJQL0oEaD/82etDXBdUjgAk+GRBp6BUW9yCz+hc3pg3MGjh8O1SUW1Akx5TprOp+LuFOGnHzXhvgHQ9och93I/9HQlXCjJeDOplMGHUFZGQ42YQok8EwcXEjzU2Z4nsNBKiV80HNaoU6LfFLf5MAQvxEUAB9RYvCt2OHGYA5U4w7tBK5pUelg8DqNP8c2KH5CJhW9WGO0oT0QdCEZQkusYKpVEJyk3gyBN/gEvoLTU/HABtSUQDLgMGPnhVAyWnuyCQ28n+Qhpyn8ztIjBgo/b9btlJ0ygL1aJjxmFcmHN+ZWVwareQ/XUdOD7dESVJyqngTTRtMMUM/hnSMXSNk2UdmOdqRM579PPJ1ib3q9SWZapENhGVIdlAZRRJJzfgyUaRfH4LtLp2cqjijV2Sa/vcpUP6JL1FbBJP305lq6wZZ4N7MUEaWIxQQoWJ+YV5iiGp58Pt99p+xc+LOJ+M+MUyCSz2fXKEaRwBKEz7FgsH0olIkYJ0c4kY5HBmzMb0WQMya4EtEAEMKW5tw8CZJ6i8hxFQyHsU2NR+oZ6x6Tj+wNA4sSpzVgLULILBwLxFHZalaoBlVFzj0tKa7o0UmL/MvQwR+bYyC9Kyb4QFiAoXXF8B/UTxrd+a3/65PpW8QDlKVUYEgdpzwk1AzLq/Bny7CIJ5gMCF2HEbcRrnRSe2B8ykoZmrZp6qF794uTT2xfrJGU1DbV4ir8swm7d22fW4kPCbEYTIme6s6RCyfE1IFGnymd8WwZZnFZPLU+tAIfPOnxBscx/DMzRAvJHnSKhSE2v1NayVmHf5uNQp0xCoeCVeR9rSlY9ey1/aWrxjaXCpulNCvyBq2s6fCTNui6Woyeq2U18llei2Vs/qMUgMl2p8wVA4F69j6sfNpqHuYSFl+1Ojbz69Ppo0BFNLwUErYZFh1q0e3cuyDyD0zEpxN6uqWhW5HTAlWjAurygOXCVVagqhRW6DYqjSAGH3UAoRLS9Y73taxRkKUFbRU8FV5P6YkaeETQXiU1IDuqCKVk0C1wUsSO21TlJJkFO0cunBdnlOIiLfZmcF555bxaVV/v2bJT/8NHxf89kky2NAQGPY/6TsngK5CDUE+LeTkgXCGbr+kliFOUu9Rio7iBjAKeGIYx6nEVWmauQcXjLX3wJmBsKx5cx8METYycDOLwVET+4nHHY9tVqCU0ECFoLSNqZgt8C1iTDokdZCVuU44XU8HoRRUGXQ05BSk4oYKW21UwGukJXE7qDfOku8msGkpvcKGGxY5MLN/ZIQViQPRg3soqoIgB8BXvfSwzgwfgzGLNMDyO52VQBk+aMKRcebI+rcfbsFSqxA1A72UtgwE2HqyBEibUK6096pAbqnsFrWMKnqS0OdPDVI1cgZTwhTJ2CCJBG4IBRVkxVKsKZ6GicwxVEoG5D5Q4MBpqTqfzlKpBQjTRePJA0KvFFS8dmAboFi1bBpukxsJ3Dkcr/cZCDfNBA2GkDV55anC2UTDplm3JKL3BWZuKoyFaoGlB4ZyFE8UcP0npgqAm2Jv8Z4S80DOUzABJzve0doDFYYijUAp5g65mpoiGi4bcaKgig8yWQTU4yv9v8AcyK3F0tMmbQ8eC5oMNqwOjwWmOGLJbk8fFYyQLhNXRPYEFSgPsatTD2Dm8c/TNyd+IBUTTiNKbSJ9ATGeMDK3kfhlwzTGE+IKqsQOgMtidhPHesZaj/cect1iuM6nSXwNOfrxTtm4zxxnn00bqJtKK1hxoX7qf6kjzrQaL3KtaoTBb2zbNoP/PifvZRa6pTEhLdsi8SfVLDDPrvB3yOKdikwPI1iaz48Cl4Poymb6VOSh/NBF/MBl7Eag0gB151SrQveg0GuikyjLbtGZr2PTBtAE6SVI1Oc/MHU/rFZuSTk5pEHXAq5ACa0C3hf5mQvpcDyloi/RChrUlfFvVSXqfDy3odyBvYM2kiBV+SClbvK26NXkkZgvCIrc42QBnw3rnVE0pLrCCuP2J0LZDhLzlzNshZPwNG9NHxdmZXcDGyKrKg0Lcx+4nEi6rfF2gZ8tIXn9N/NXkWtLweU5RgfVIk0a2AFLhSTFQ9joIs/yX6HNsJTIr9Cs9jwgzdDLqks38hlPiPmVZK6Lc+ADv/CjDO3YNvPNFkaClOF1xrrRAQ4V0jlww4t3ogCbZqTJ1omcHUkMYa+isanBSdo6ODl+ZA/vHE/FvJwzJkBXJoZNY/GUppSkaIRCs0ciuJKrcQIVWpHE1aW8ulWwDeJxV8LAPoGJbTu3moujBQiO2nqdKI3HaSQSqeZB0aHlh463MG4+MJHS/CmtHaNwk/fNIyFK67UsXb1nw8bky12dXvD4XF9IzDkCMRsF4QW0tsioco17xsCVcLBgHi1c2bORBS4ArY/vVVvg/yUXPz8Ddi4nGM6Q408synwHAOHuuYPP6KozA0IsB9T9nFGfBuWHXZkHit0YGssQ2gjoktmzLNmo8FFA0CWoMLPKMt/pcGaGKC5TTC2Hb8kEBPa0tjNQqLL5tIfFkRJbU5X8WuyzVXJfr2OXpWIGqNajCc2Vho3eJx6WUnaacgjf8TbbnQEBLtesAH1Tji+lzhwpqVEjxxTNlB3AICdnsw6C4LU5Dj+QH5Glj4QJubCXjemN9u1q2uz60Ib4wZFZH9U7zBc1kgWRxEgByPOYPWsDKMLzBCLC0Bx1rHNZZaaqJncPFZmd0MXiLHlYgl4OnmikwU2H2wgji1AOOIyvkZjA/JoaXqSIE5MToY7XQ+JBrh7RMNs3xLJDwlo5jbjeOGoJ0oZk3xD40EV/DBirsdyrgwNS6xV7CSiPdfddCkcVL72jaZncvLZlPri0DU3bHxdUyegeQWaa3ApodBgRFX+RC0ZcfRIT0/k5Zv07ngLfB6fHe+6yQZbwNrmK6lSpFIrMpiyH/Cy6+XrcrRxEVs4Huw2/CT5sfzXIWNJYQ7EOoDLJS6SURdeU3UVyFqi8hBiLNzuZB5dJevX4AubQHmGt69cYB55p+Ex/PzdjxDLqBosBVozL0xKtlqn+htXFpfmxjeirFKkZ1+yPiLNOLaTsg3RcFKvUao/5qmTv2VeJvMWAKO1xxbiWApR6JA0NZDOsodQ8K0ncOnk1NAEfTUSUu6HYkU7GLndiYpOmAjZHoFlRoPU8seY+o0uOpXgYuF+JTgySC+lbAC+cJOt/FtkuzTo+sv7Lhanm1hqR5F0MAx8RR0r4HSje4+tpB0Q1+ju88MrZfvcb2dY3E1bIc5kfFI6lNS20ytEX0jbl7JB6gEIrBSUF/WQQv8mwJrLpcceNrZbzoWVNZbTZu0CfmFHjfAdVbsrzma5f2Rceab3RctvVVYNer2GuX91UFNq9eP5dPwOClpriwns0utWtlZ2bKwJir1wcklNLMAljTDhcNqJWUts9tZEVOUUa/Jsp4rYwo8qMT8UMJAYsVlE1y64nDybkJhk0qC70LLMzXfUsj30kz5PSmUohcTDkFY1EmHmoZ6AAHcVI6LPKtUy159AiwjAHCByQKGXRbKScWar5aMWQ/jljfcZRwW20fISPVuXW44bWyg+phcc44Bw0hCdSz4Nsas1h04CFxJt1JQz+BZ1fzFug68pV2dU6dlAFls6bGx+7DsU4YuaFzeIiJeMjGbYlUF0UDYJJy+fR2MGY7nbK0QjiwiwSaKijFpaihcXPEhWtlqNVTYnvduzu9CGAthpVXrJ+f30+xFVNYbOVaGRkjJRrGhhdwNw5+wzuD55bBtf4PTBAw6NZlbb1VnEuDxc7wXHfwlSMXgNaqBkadSlDjaPfKClw9LS6lQzcpRRCsF7uKzU4GG05lB/TcIRIHEcCotLcm94hDSjUK3GqQ7Lwr30c4S01uH722r2PZ9ONTcDDZ7defOrjs9k/kJDrJfFjirGY529cv7yedC+QlxW2Oi/URvvqz7XP77YJ4xwJJhLeyQviuqpBlzYhBbgteL2Pa/BXRp8WZ4aQA9kBMNUIdncRhOchBz5LTTmcZKEDI0UJ8dNWe/R/rlU4yT/TsTrJMZ8tAsr8pvjJ1NnFTGPNPEV6NYsCW8crgwMRJBT/4aKdwVcMaCpwsKChA+pHLUxPWpld1/M6p8dcPJOno23P7YkjJJeLvmp1RBknd3uS4XB9cZTsjmzqXsny9DHWKzYy/+/cm+4rocRli3jEcxGBu6ZotWWaWMiNyrjYdEk8TezXF+lJC8HifPoNU5hOdMgjkMvMiHYsLvTHi3XHl4fgxToNeIZGpHBnivq5DbS3oRRL22wYauFAL3ppIDENOCOxmpwdmDTIroSQANaNar6ttteosvV5GLfuWifjoXDGbOVaZZgM+BnH5AjnqUhWMg81kXHAgjXBuMPBd1/YtkR/AP6hCPUsJteC+INAkYZf3FICK3Ut0E2yeW5PHxDmDBq0xwcHbrGEINxI1pNHXNyePivPGScwdZIhf5lS6eYjig6sOcESJch7s9TJ8MDaz3xr1yIziulEZCcoQwbm27mD3Z4egLI6F3b9qCO4aIbpejEDOWhsX5e8dkNFSxm1ZbvCAK0Fef/EA0Otvj+g1h6xvH5FCWOV6mbt4vzitmUiyBlr5waISrKeWq7NkJCxzAlF7ddwyNCsdvHTXejY9FyliChQDBFBcHl25XubcnRMPJjA9zBhp7Uqb8MfXBss4jw0p/6S2s0KWuWiPi7dzfUC65Qq1NnystREBDhqTmd2APAr6ZGdzOZTWtOsgcITS2hYDXG+tGeEyKBzYJAxUiZOHmx1EBRq+HEr7PSzb453M3z/7oLgfpyZKvYHsiWpVETmKALFDEfJQ1z5zJcH1skrH58SDkgPqwRnpcIWA98tAEoQho1wGxHKZKJj69HWb1CdlfK5PZUyW62Xu8H+fiP+W2NrKgKrMvgA8hMhVUangAyjgYLBQwTw9JIuRRYDb2ijcXGNNMW0VkWXr6OZweFywUQA1trj/DK6IalCjChXzGHmk6jZ41DeocdN2kqqpU8lIjW/CFYNppEy8JoqNKBmjR0a2SF3XiCPBXCTnTeJyDfB2/exiW0o78N6PpqF+fx5rHm47QW9b6Pu8O/f6fipRzzlh8y8Y1zLfw6kRucUCe0ziIpvDq0W8UQZWPCLOo99ASCsUX2fWQZvBs76Q4kQuU+z2PWIrkT0ktBQANMPcZjBO6E6+KhgP89PVdaYjZRDHs+LK8EIuqk8md+Job2GZWw1c2w03Q82JobiCJHqNdBooGL49aqn//2hj+nbxcLrKkDXqGmD2IQ7NNmzLhFqbNaUJb5RBBlfEM/OBUdrdQ14WEDG6okAG57ajfxsvKoFTAMqlNgrB1jl2yntFvdjgqvYWW2qaZmdzP6Em5/Jo240yPGG5wfE1e4B5ojfKgIUhT7RezB2ysl7MHfodbCdk4uCqjVX6Ka0fpnNSjl5t6hxsf+PZ/ZW2pJYBDlncXVNzVoupUboGfE/8HQ+2oUMuhJrPiVoY64/x/jB2zuJYg1mMpLYplQcrbpT55V8pvmI1fpjK9s9lUYJcDicbx4tkJx7mCzOR4wcIocUzDE9LKRdMAqhnLedB8D/dH4KTRmMxApAfjef3oy1S423iZs+AVN1Jy9R61BYlqnC63gT8DhwOshqf+Z/MH7JDnD/mLqG4OF6OFG+VjUDdeGE/d5hLtpcB7nBuO6oTgL27ygX55pUwAyILas11Ijde3FeknloDq7jVK8T56GSK6xjlndVvvFHm/pwSJyQl5AUl1QJr4LDYlEomYf4lhDnKt8TcNqdwvrFTLxzFl5qdzVGJysylq+JZyZXsME7B1BUZJBgqW1kKP+NyrsSE53VGUQHlVdCE7vehtqsG9rfiwCrGz5f6Ag4Wl5vLrcbny2Cta+I5rG4YVTgnLec2g58q6XpRcodwXQ+B9sQGicUONN2enswlt7q01UdybJN1wPQLZQbicXFMSaaxKzUux7eu3D6M4aDoH/DFzHH/wjP7AYWGBg86ivHCgUQxfnhCqScUt19TWQSHBxjkKutwv1BmdDwm3jJgLmgyOmkUQ0Z9gzU0xzZDc0SeSHY17eyH5lhnFtNnJ4nmuMK1mL84CG6lgzfhs2vr6n7ueFOwj8F+AQyP1CT0P7JdxiX+U66IvACwrEr+BklzydJJeEvufH+hzNp5RlwexoaPdrJxQtOSD1ZRihC9FZAX3+hGEAf10ze9XGXXfQpVCk4hfJEtRzpNW2NnI9OdMh0K5UNtBaPkwiWhRi0YWHcNkr/w4kGB5N9fxGrjEgTeZ3GGF8pw4XQJTGwT69daHDHz8DDsG6Xnx+zz+0sUxNXkkas01D2B694pIpU1dPVo1op7oQxC/o6J+MSQKGKRbg48jflDtBZ8NOFTHjZAYVz+0RgutSs1zEtDeBeqHA9kY0WZ7S3u3eykb/q6wS9Nj4f6GVkJ5q/kcom+03B8Gl3J8em+48DwC++/68DwR3OKG5arbtZo7TI8EYyWBIOuFOaHGRbSOeTqHnEIaf7RXmxxY/OYWF9aZpo8Lt7Ol5pEE4hQSxQqQCWluUjoX2Io68VnDjCU9SNMvskX+Rwvqf8O8SjrfdUgmQIsEeTPog5Syko9MtqD5/dTxnNlbIdolJgMxdAvrr0FVGB6eMdGV7DbI/wyy/eXgWqgY/qQYx+3rKOaPhULv3oKv3dSVmDrFJdvIyM2f33ti2X6FtfXpngVlYyI3IdxMT89mZ4kZ64fX2xwCxVxGWRQsgKumLFPXyxz7m5vdJV438s6xI7pEATGAQHaXJD9xTJF9i7x+PwhxTWpmBARagSIqmCdHxfz+4uIabgZfWFvpFipqUO0stbAKC+VaZ6vEH897QakGAZPREmrZLplieJ/nATYoppAS64psKaWczopsILa0HSBNU4clEOhfHIEOFCKqLqF2knKEmMDN4mc5TKZFngJ6G3aIgAyf7P7H2O5obRSvdWOTek69OClsrSDPfHlDCLhUMAa6YfgF9VZoPMCWYn4UXd8jVL0dLUHt6UOjXFD9jm8ZKRwEoqbjW3/B47Dtv0qG2u4LllROR4vuVJWptdlQYb3i1c44SihgJrucwMkix9Uvz0VDI4GRI3tg9haA0sJxjayR+Iy8CnkfAemRXYOn75r1sk/T3X9Tb21bs8lMihihvA16bwJVlng8SjWgH9fIJJ2o+LOqDUnXI8fSi+VHaufn4hfmDBBGwUOlMkwROu0+LieD1V6iDnDcJSpDBIoocFEjYQDTePGdt6yfK+bqmRKUaI3W/CjEWjCfsXFALwx0dT8JRmtrNQShEwcsdkivyj8nciGpLU0a/+KbbA6Z4MhobvtzBoSxUtX9oNyMeeNSrIDPEeGtRvCGazzSCOwHVkhRxW4WMu6LqhVfucPlhiWJwbHjGoiZ/pVBlR8kXg0uXCEK6qZ5aIWb1RYkPVjG2t5w0AZeMjkbLfAUGISc8bcfKkspvPV4qtmxxMlUZMhttJugw+EPvFKd1xzlZS68rjtsEYJ9XS4dQq1veBTtVyaVVWohTM+JOsSaRDjyPS47DalWVPjQvzWej73KG02RovXGQ1X91OsYsmE5gwLUk2dtk1iduPF89phoTs/wFdccIH+TDzzhJgOl4DkT8AygEmIU7P2gpdL9wr6ZUbPutmHpssIdX0/NazdKjj2E1yKV68pxfvOGKByyqVQOVtzfLXRBtcYfESc1WzFoQYfjIwqf56V8VC3xZdwaf1YEI7aB8gCc28WpcSnuP119l4QLAiMPiNOaK7lMZPozQnyEhG2392bB4A+DqRq2nEs5bYJOi6OcWA/n6j6UpkHeVqcRHP1jDA0PlnfkMfPAOwrl13JZVDedPaSgza/Xrpr8+uDd3qvxEtlIBs416uu7/99LmZqi/BsykjzQ3GdNZry1f2UaWVNSb6V5lSBdG0r3wbDl+KlWiltla3UsR4lbvMo8Usf2BdK3K5Gie/yVHztQE7FO1/hr9/1Cj+IbPSXL++TIpRzK++YSP7y0wdAJP/Hea33QOIOAP+GS1nnCYovl1nvTCuSihDxubbHhfwvh7NCfkC8mrYtX2ANj9igLmaoEa2F84vDC3enNWSNIk5BlEjMDFD4KjQN7GxAgoQMut65C4+Jh9GqUiamvHOBIzBDfVWN30/0cpmh/zMb4qc25oEpvni2x8u3UMcM7DfyFXEdLWSlSucpSR6ZhkgrtEyUJc4Q+YQpPZ6QnKD5CnFyf73nOr/NkMJPVD3cXYGYA0IaIF7Zuo4dBQlp4IA7uhEIL1U+vlQhiR8QA4yDocoKVX3lWrRcLAp9QQeI7QuHl74utUv4zIBRoF/pSgmLXKRZocw4V2Sc/upE/OKQ9RSrZ9KI8XihN5YvbaLBSvdqmy2W0jEJgmVE6IX62RB+smV5TeKeOuoIbj6nG+zJJUan607OSY82ZQzWpqAPVQTWHsV7aT5dR8UMvETDdaq3SQRsi9mZGUgfW6mkTy2XQclsxTIP5rYWx7fgP1wpEigJiM/lNNW1ffGomDAO4iwIgChxWhOSuVJBfGgCJSpvU6L3iqOIrNk2J1yxrc9N3cnBCuGzurzMUJ81NS7EJ1YebkKc4rMIysCsOd/KLOuRNsfF+sbDBRUCNVcIpNlHg7HWOuqC0J4kgNqD3k9ArcmfQ2W2+O9sit/cTGoE+gJqDSAXUDLsXpwbQPgozjJH4x5usUDlO1qjUfdB8UUVa2rcNNF7FC4ItubK5nWoG64CLHVPkXJCQrwJrUuEUNzf6psYZa4BItJ3a1RHRFUtaCDb24jaEDZiJKozcgVztACCNZI8+UpPH4wMuCDCSeSx2lDbOjSOM7qolpiXqUQulJ2j0YeLWfea+0PgJPQUIg6mJZlw7REyPFF93Wgu2W1d0K1MGUOUEqNDw5XPMG4qtAjLYRS08TQaSqNOcsO0T4/c2KaN5FffRFawbmgoG4cmuHqoa/EqnNc47BCwOyXuQ7ONC87qSu4cWlqKa80/OmRkmwu+vvzyfsy/ocE7vDI7F0d/ucyvmV2ZvSqI/vX5+kIPigfAFdPplraGpnB37wJIN8iX6ccD6i+Xheg68RorfqwZmISa4H9YcXglMq62mmG/4VFQdwM7O1oPhd8a7EYk6+Ho5nteuPKsd4O/hosZHUi61dIw/Gwq/7gW3zqfiPFEyOYZXhftebnMfWFaDSTldq23S5d34npBv+RmfnxjenY+oTEPOKYTfx53XAM4vlIWpfxy8WXpBXa41m8AHvnEmBXPIPoE4UigDRLiBDUTwzfbdGsDmpA+VoheJFfE4vIIdMTv0F+35+uz3bGz+cqVu3c2D0/Pzh98K2bkHLYXIQ4J6DcGLvzO5oUvTMT/mmirG7of3WDj+aYyjnS1Qb2pGhdVNFqrKl3WYHFForGVDq7VTRVqLeuGvg5Gs5eVpcZ07REsqzzdc6EplR8FDnCRkGmx3HxTtfibb02ojQ5t7asmtoSnnQ+NdlVwurWh8Wi81m2VrnVvglXGh9pbHZrWA7qt28rjrxAeC0K5gGOj0TUtAtcqCFs1oXW6rerxHMpXymAu3DOGdaPZoO/D7PpqKrvWNsPNO3SjCK7hafrlObg1+fREfPsEw2OQcwTpcXV97bxGjBGj7K2tg/dNukVF4rYY7x2qRdgEFjkvnU9XW3oZtPY4RbXFEGkdfR5ZSxVMAyad935gt5hG40jXvnLBNgiNtK1sWyykNydvFw8b4zmaw925icCL8z0BY3Ob4bNrY9jHBvpWTpO98tr+uBfw6nBKm3SfgiHHsaa7gnAowbmoG6DES1vo85NYisz2Y3TBjRHFfEbOtNtwh3qef/NKmWJ+RJwfaztYtxSOsG5JNefrSWBgsvGIV8sU71xbK/TRAYBfrx4k+PXxnPXDrildEVHlCYWvPrMfZ3fW4l/iMF05wGG6a5rxq8/uC0BWdw4gI4UkI0e5n6soG+WAwxSvvnLXYYo7z/F4/4HkePxYbsucFid5Alv4X1k35v1l52gqXZoycmGhg+kp2f5GZj2BkLMFg+mDkvd9MaI1ECUzAn/g6j43Obd4JyV+Wlzcn4ONXivTOHNtjYvxd3OEYDhusSsuMy6vlfmj842Ny/KZjYJKzGe4EjONHChJOIIvnBbHLawtYxsgC9W46fZ62Tl2VTybiPhMGQGhS8tQV0z5q2EsoQ4Dys5a19dg/EVHTPuUH0sXit2aXBL/H6wyWTcEeeObEhe5eRSTVNrzfTKtRRwg1LWD9jM16vNq03uYWW9OUr5hGqpvye1CpI9gQbedMrk19HoZ9LjY3PjU/QV7rmat5/qoOA9nA3vXcaIvtjDd0gdM7Yw4ZWYVADmYPyp9mXNfC0eJTFRTY6nhBSm4VkISZejnw+JBVH2//dFqZ/OjG4fExo2n/t8AbvKXdcjJAAA="
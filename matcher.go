@@ -5,17 +5,392 @@ import (
 	"strconv"
 	"strings"
 	"unicode"
+	"unicode/utf8"
 )
 
+// ----------------------------------------------------------------------------
+// Golang port of:
+// https://github.com/googlei18n/libphonenumber/blob/master/java/libphonenumber/src/com/google/i18n/phonenumbers/PhoneNumberMatcher.java
+// ----------------------------------------------------------------------------
+
+const (
+	openingParens = "(\\[\uFF08\uFF3B"
+	closingParens = ")\\]\uFF09\uFF3D"
+	nonParens     = "[^" + openingParens + closingParens + "]"
+
+	// Limit on the number of pairs of brackets in a phone number.
+	bracketPairLimit = "{0,3}"
+
+	// Limit on the number of leading (plus) characters.
+	leadLimit = "{0,2}"
+
+	// Limit on the number of consecutive punctuation characters.
+	punctuationLimit = "{0,4}"
+
+	// The maximum number of digits allowed in a digit-separated block. As
+	// we allow all digits in a single block, set high enough to accommodate
+	// the entire national number and the international country code.
+	digitBlockLimit = MAX_LENGTH_FOR_NSN + MAX_LENGTH_COUNTRY_CODE
+
+	// Punctuation that may be at the start of a phone number - brackets
+	// and plus signs.
+	leadClassChars = openingParens + PLUS_CHARS
+	leadClass      = "[" + leadClassChars + "]"
+)
+
+var (
+	// The phone number pattern used by PhoneNumberMatcher, assembled from:
+	//   - an optional lead class (brackets and plus signs) with punctuation
+	//   - a run of digit blocks separated by punctuation, and
+	//   - an optional extension.
+	MATCHER_PATTERN = regexp.MustCompile(
+		"(?:" + leadClass + "[" + VALID_PUNCTUATION + "]" + punctuationLimit + ")" + leadLimit +
+			DIGITS + "{1," + strconv.Itoa(digitBlockLimit) + "}" +
+			"(?:[" + VALID_PUNCTUATION + "]" + punctuationLimit +
+			DIGITS + "{1," + strconv.Itoa(digitBlockLimit) + "})" + "{0," + strconv.Itoa(digitBlockLimit) + "}" +
+			"(?:" + EXTN_PATTERNS_FOR_MATCHING + ")?")
+
+	// Matches strings that look like publication pages. Example:
+	// "Computing Complete Answers to Queries in the Presence of Limited
+	// Access Patterns. Chen Li. VLDB J. 12(3): 211-227 (2003)."
+	//
+	// The string "211-227 (2003)" is not a telephone number.
+	PUB_PAGES = regexp.MustCompile(`\d{1,5}-+\d{1,5}\s{0,4}\(\d{1,4}`)
+
+	// Matches strings that look like dates using "/" as a separator.
+	// Examples: 3/10/2011, 31/10/96 or 08/31/95.
+	SLASH_SEPARATED_DATES = regexp.MustCompile(
+		`(?:(?:[0-3]?\d/[01]?\d)|(?:[01]?\d/[0-3]?\d))/(?:[12]\d)?\d{2}`)
+
+	// Matches timestamps. Examples: "2012-01-02 08:00". Note that the
+	// reg-ex does not include the trailing ":\d\d" -- that is covered
+	// by TIME_STAMPS_SUFFIX.
+	TIME_STAMPS        = regexp.MustCompile(`[12]\d{3}[-/]?[01]\d[-/]?[0-3]\d +[0-2]\d$`)
+	TIME_STAMPS_SUFFIX = regexp.MustCompile(`^:[0-5]\d`)
+
+	// Pattern to check that brackets match. Opening brackets should be
+	// closed within a phone number. This also checks that there is
+	// something inside the brackets. Having no brackets at all is also
+	// fine.
+	MATCHING_BRACKETS = regexp.MustCompile(
+		"^(?:[" + openingParens + "])?" + "(?:" + nonParens + "+" + "[" + closingParens + "])?" +
+			nonParens + "+" +
+			"(?:[" + openingParens + "]" + nonParens + "+[" + closingParens + "])" + bracketPairLimit +
+			nonParens + "*$")
+
+	// Patterns used to extract phone numbers from a larger phone-number-like
+	// pattern. These are ordered according to specificity. For example,
+	// white-space is last since that is frequently used in numbers, not
+	// just to separate two numbers. We have separate patterns since we
+	// don't want to break up the phone-number-like text on more than one
+	// different kind of symbol at one time, although symbols of the same
+	// type (e.g. space) can be safely grouped together.
+	//
+	// Note that if there is a match, we will always check any text found
+	// up to the first match as well.
+	INNER_MATCHES = []*regexp.Regexp{
+		// Breaks on the slash - e.g. "651-234-2345/332-445-1234"
+		regexp.MustCompile(`/+(.*)`),
+		// Note that the bracket here is inside the capturing group, since
+		// we consider it part of the phone number. Will match a pattern
+		// like "(650) 223 3345 (754) 223 3321".
+		regexp.MustCompile(`(\([^(]*)`),
+		// Breaks on a hyphen - e.g. "12345 - 332-445-1234 is my number."
+		// We require a space on either side of the hyphen for it to be
+		// considered a separator.
+		regexp.MustCompile(`(?:\p{Z}-|-\p{Z})\p{Z}*(.+)`),
+		// Various types of wide hyphens. Note we have decided not to
+		// enforce a space here, since it's possible that it's supposed to
+		// be used to break two numbers without spaces, and we haven't seen
+		// many instances of it used within a number.
+		regexp.MustCompile("[\u2012-\u2015\uFF0D]\\p{Z}*(.+)"),
+		// Breaks on a full stop - e.g. "12345. 332-445-1234 is my number."
+		regexp.MustCompile(`\.+\p{Z}*([^.]+)`),
+		// Breaks on space - e.g. "3324451234 8002341234"
+		regexp.MustCompile(`\p{Z}+(\P{Z}+)`),
+	}
+
+	// Matches the leading characters a phone number may start with.
+	LEAD_CLASS_PATTERN = regexp.MustCompile("^" + leadClass)
+)
+
+// PhoneNumberMatch is a phone number found by a PhoneNumberMatcher, along
+// with where it was found in the text it was searched for in. Start and
+// End are byte offsets into that text, so text[Start:End] == RawString.
+type PhoneNumberMatch struct {
+	Start     int
+	End       int
+	RawString string
+	Number    *PhoneNumber
+}
+
+type matcherState int
+
+const (
+	matcherNotReady matcherState = iota
+	matcherReady
+	matcherDone
+)
+
+// PhoneNumberMatcher is a stateful iterator over the phone numbers found
+// in a piece of text. Vanity numbers (phone numbers using alphabetic
+// digits such as 1-800-SIX-FLAGS) are not found.
 type PhoneNumberMatcher struct {
+	// The text searched for phone numbers.
+	text string
+	// The region (country) to assume for phone numbers without an
+	// international prefix.
+	preferredRegion string
+	// The degree of validation requested.
+	leniency Leniency
+	// The maximum number of retries after matching an invalid number.
+	maxTries int64
+
+	state     matcherState
+	lastMatch *PhoneNumberMatch
+	// The next index to start searching at. Undefined in matcherDone.
+	searchIndex int
+}
+
+// NewPhoneNumberMatcher creates a new matcher over text. The region is
+// used for numbers without an international prefix, leniency controls
+// how strictly candidates are validated and maxTries bounds the number of
+// invalid candidates examined before giving up, which protects against
+// pathological inputs.
+func NewPhoneNumberMatcher(text, region string, leniency Leniency, maxTries int64) *PhoneNumberMatcher {
+	if maxTries < 0 {
+		maxTries = 0
+	}
+	return &PhoneNumberMatcher{
+		text:            text,
+		preferredRegion: region,
+		leniency:        leniency,
+		maxTries:        maxTries,
+		state:           matcherNotReady,
+	}
+}
+
+// HasNext returns whether there is another match in the text.
+func (m *PhoneNumberMatcher) HasNext() bool {
+	return m.hasNext(activeMetadata())
+}
+
+func (m *PhoneNumberMatcher) hasNext(md *metadataState) bool {
+	if m.state == matcherNotReady {
+		m.lastMatch = m.find(md, m.searchIndex)
+		if m.lastMatch == nil {
+			m.state = matcherDone
+		} else {
+			m.searchIndex = m.lastMatch.End
+			m.state = matcherReady
+		}
+	}
+	return m.state == matcherReady
+}
+
+// Next returns the next match in the text, or nil if there are no more.
+func (m *PhoneNumberMatcher) Next() *PhoneNumberMatch {
+	return m.next(activeMetadata())
+}
+
+func (m *PhoneNumberMatcher) next(md *metadataState) *PhoneNumberMatch {
+	if !m.hasNext(md) {
+		return nil
+	}
+	// Remove from memory after use.
+	result := m.lastMatch
+	m.lastMatch = nil
+	m.state = matcherNotReady
+	return result
+}
+
+// Attempts to find the next subsequence in the searched sequence on or
+// after searchIndex that represents a phone number. Returns the next
+// match, or nil if none was found.
+func (m *PhoneNumberMatcher) find(md *metadataState, index int) *PhoneNumberMatch {
+	for m.maxTries > 0 && index <= len(m.text) {
+		ind := MATCHER_PATTERN.FindStringIndex(m.text[index:])
+		if ind == nil {
+			break
+		}
+		start := index + ind[0]
+		candidate := m.text[start : index+ind[1]]
+
+		// Check for extra numbers at the end.
+		candidate = trimAfterFirstMatch(SECOND_NUMBER_START_PATTERN, candidate)
+
+		match := m.extractMatch(md, candidate, start)
+		if match != nil {
+			return match
+		}
+
+		index = start + len(candidate)
+		if len(candidate) == 0 {
+			// Make sure we always make progress through the text.
+			_, size := utf8.DecodeRuneInString(m.text[start:])
+			index += size
+		}
+		m.maxTries--
+	}
+	return nil
+}
+
+// Trims away any characters after the first match of pattern in
+// candidate, returning the trimmed version.
+func trimAfterFirstMatch(pattern *regexp.Regexp, candidate string) string {
+	ind := pattern.FindStringIndex(candidate)
+	if ind != nil {
+		candidate = candidate[:ind[0]]
+	}
+	return candidate
+}
+
+// Helper method to determine if a character is a Latin-script letter or
+// not. For our purposes, combining marks should also return true since
+// we assume they have been added to a preceding Latin character.
+func isLatinLetter(letter rune) bool {
+	// Combining marks are a subset of non-spacing-mark.
+	if !unicode.IsLetter(letter) && !unicode.Is(unicode.Mn, letter) {
+		return false
+	}
+	return (letter >= 0x0000 && letter <= 0x024F) || // Basic Latin to Latin Extended-B
+		(letter >= 0x0300 && letter <= 0x036F) || // Combining Diacritical Marks
+		(letter >= 0x1E00 && letter <= 0x1EFF) // Latin Extended Additional
+}
+
+func isInvalidPunctuationSymbol(character rune) bool {
+	return character == '%' || unicode.Is(unicode.Sc, character)
+}
+
+// Attempts to extract a match from a candidate string. Returns the match
+// found, or nil if none can be found.
+func (m *PhoneNumberMatcher) extractMatch(md *metadataState, candidate string, offset int) *PhoneNumberMatch {
+	// Skip a match that is more likely to be a date.
+	if SLASH_SEPARATED_DATES.MatchString(candidate) {
+		return nil
+	}
+
+	// Skip potential time-stamps.
+	if TIME_STAMPS.MatchString(candidate) {
+		followingText := m.text[offset+len(candidate):]
+		if TIME_STAMPS_SUFFIX.MatchString(followingText) {
+			return nil
+		}
+	}
+
+	// Try to come up with a valid match given the entire candidate.
+	match := m.parseAndVerify(md, candidate, offset)
+	if match != nil {
+		return match
+	}
+
+	// If that failed, try to find an "inner match" - there might be a
+	// phone number within this candidate.
+	return m.extractInnerMatch(md, candidate, offset)
 }
 
-func NewPhoneNumberMatcher(seq string) *PhoneNumberMatcher {
-	// TODO(ttacon): to be implemented
+// Attempts to extract a match from candidate if the whole candidate does
+// not qualify as a match.
+func (m *PhoneNumberMatcher) extractInnerMatch(md *metadataState, candidate string, offset int) *PhoneNumberMatch {
+	for _, possibleInnerMatch := range INNER_MATCHES {
+		isFirstMatch := true
+		searchFrom := 0
+		for m.maxTries > 0 && searchFrom <= len(candidate) {
+			ind := possibleInnerMatch.FindStringSubmatchIndex(candidate[searchFrom:])
+			if ind == nil {
+				break
+			}
+			matchStart, matchEnd := searchFrom+ind[0], searchFrom+ind[1]
+			groupStart, groupEnd := searchFrom+ind[2], searchFrom+ind[3]
+
+			if isFirstMatch {
+				// We should handle any group before this one too.
+				group := trimAfterFirstMatch(UNWANTED_END_CHAR_PATTERN, candidate[:matchStart])
+				match := m.parseAndVerify(md, group, offset)
+				if match != nil {
+					return match
+				}
+				m.maxTries--
+				isFirstMatch = false
+			}
+			group := trimAfterFirstMatch(UNWANTED_END_CHAR_PATTERN, candidate[groupStart:groupEnd])
+			match := m.parseAndVerify(md, group, offset+groupStart)
+			if match != nil {
+				return match
+			}
+			m.maxTries--
+
+			if matchEnd == matchStart {
+				matchEnd++
+			}
+			searchFrom = matchEnd
+		}
+	}
 	return nil
 }
 
+// Parses a phone number from the candidate using Parse and verifies it
+// matches the requested leniency. If parsing and verification succeed, a
+// corresponding PhoneNumberMatch is returned, otherwise this method
+// returns nil.
+func (m *PhoneNumberMatcher) parseAndVerify(md *metadataState, candidate string, offset int) *PhoneNumberMatch {
+	// Check the candidate doesn't contain any formatting which would
+	// indicate that it really isn't a phone number.
+	if !MATCHING_BRACKETS.MatchString(candidate) || PUB_PAGES.MatchString(candidate) {
+		return nil
+	}
+
+	// If leniency is set to VALID or stricter, we also want to skip
+	// numbers that are surrounded by Latin alphabetic characters, to skip
+	// cases like abc8005001234 or 8005001234def.
+	if m.leniency >= VALID {
+		// If the candidate is not at the start of the text, and does not
+		// start with phone-number punctuation, check the previous
+		// character.
+		if offset > 0 && !LEAD_CLASS_PATTERN.MatchString(candidate) {
+			previousChar, _ := utf8.DecodeLastRuneInString(m.text[:offset])
+			// We return nil if it is a latin letter or an invalid
+			// punctuation symbol.
+			if isInvalidPunctuationSymbol(previousChar) || isLatinLetter(previousChar) {
+				return nil
+			}
+		}
+		lastCharIndex := offset + len(candidate)
+		if lastCharIndex < len(m.text) {
+			nextChar, _ := utf8.DecodeRuneInString(m.text[lastCharIndex:])
+			if isInvalidPunctuationSymbol(nextChar) || isLatinLetter(nextChar) {
+				return nil
+			}
+		}
+	}
+
+	number, err := parseAndKeepRawInput(md, candidate, m.preferredRegion)
+	if err != nil {
+		return nil
+	}
+
+	if !m.leniency.verify(md, number, candidate) {
+		return nil
+	}
+
+	// We used ParseAndKeepRawInput to create this number, but for now
+	// we don't return the extra values parsed.
+	number.CountryCodeSource = nil
+	number.RawInput = nil
+	number.PreferredDomesticCarrierCode = nil
+	return &PhoneNumberMatch{
+		Start:     offset,
+		End:       offset + len(candidate),
+		RawString: candidate,
+		Number:    number,
+	}
+}
+
 func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
+	return containsOnlyValidXChars(activeMetadata(), number, candidate)
+}
+
+func containsOnlyValidXChars(md *metadataState, number *PhoneNumber, candidate string) bool {
 	// The characters 'x' and 'X' can be (1) a carrier code, in which
 	// case they always precede the national significant number or (2)
 	// an extension sign, in which case they always precede the extension
@@ -31,7 +406,7 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 				// This is the carrier code case, in which the 'X's
 				// always precede the national significant number.
 				index++
-				if IsNumberMatchWithOneNumber(number, candidate[index:]) != NSN_MATCH {
+				if isNumberMatchWithOneNumber(md, number, candidate[index:]) != NSN_MATCH {
 					return false
 				}
 				// This is the extension sign case, in which the 'x'
@@ -45,13 +420,17 @@ func ContainsOnlyValidXChars(number *PhoneNumber, candidate string) bool {
 }
 
 func IsNationalPrefixPresentIfRequired(number *PhoneNumber) bool {
+	return isNationalPrefixPresentIfRequired(activeMetadata(), number)
+}
+
+func isNationalPrefixPresentIfRequired(md *metadataState, number *PhoneNumber) bool {
 	// First, check how we deduced the country code. If it was written
 	// in international format, then the national prefix is not required.
 	if number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
 		return true
 	}
-	var phoneNumberRegion = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-	var metadata = getMetadataForRegion(phoneNumberRegion)
+	var phoneNumberRegion = getRegionCodeForCountryCode(md, int(number.GetCountryCode()))
+	var metadata = getMetadataForRegion(md, phoneNumberRegion)
 	if metadata == nil {
 		return true
 	}
@@ -98,6 +477,7 @@ func ContainsMoreThanOneSlashInNationalNumber(
 		// Only one slash, this is okay.
 		return false
 	}
+	secondSlash += firstSlash + 1
 
 	// If the first slash is after the country calling code, this is permitted.
 	var candidateHasCountryCode = (number.GetCountryCodeSource() == PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN ||
@@ -115,14 +495,51 @@ func CheckNumberGroupingIsValid(
 	number *PhoneNumber,
 	candidate string,
 	fn func(*PhoneNumber, string, []string) bool) bool {
-	// TODO(ttacon): to be implemented
-	return false
+	return checkNumberGroupingIsValid(activeMetadata(), number, candidate, fn)
+}
+
+func checkNumberGroupingIsValid(
+	md *metadataState,
+	number *PhoneNumber,
+	candidate string,
+	fn func(*PhoneNumber, string, []string) bool) bool {
+	var normalizedCandidate = normalizeDigits(candidate, true /* keep non-digits */)
+	var formattedNumberGroups = getNationalNumberGroups(md, number)
+	// TODO: fall back to the alternate formats once we ship
+	// PhoneNumberAlternateFormats.xml alongside the main metadata.
+	return fn(number, normalizedCandidate, formattedNumberGroups)
+}
+
+// Helper method to get the national-number part of a number, formatted
+// without any national prefix, and return it as a set of digit blocks
+// that would be formatted together following standard formatting rules.
+func getNationalNumberGroups(md *metadataState, number *PhoneNumber) []string {
+	// This will be in the format +CC-DG1-DG2-DGX;ext=EXT where DG1..DGX
+	// represents groups of digits.
+	var rfc3966Format = format(md, number, RFC3966)
+	// We remove the extension part from the formatted string before
+	// splitting it into different groups.
+	var endIndex = strings.Index(rfc3966Format, ";")
+	if endIndex < 0 {
+		endIndex = len(rfc3966Format)
+	}
+	// The country-code will have a '-' following it.
+	var startIndex = strings.Index(rfc3966Format, "-") + 1
+	return strings.Split(rfc3966Format[startIndex:endIndex], "-")
 }
 
 func AllNumberGroupsRemainGrouped(
 	number *PhoneNumber,
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
+	return allNumberGroupsRemainGrouped(activeMetadata(), number, normalizedCandidate, formattedNumberGroups)
+}
+
+func allNumberGroupsRemainGrouped(
+	md *metadataState,
+	number *PhoneNumber,
+	normalizedCandidate string,
+	formattedNumberGroups []string) bool {
 
 	var fromIndex = 0
 	if number.GetCountryCodeSource() != PhoneNumber_FROM_DEFAULT_COUNTRY {
@@ -136,11 +553,12 @@ func AllNumberGroupsRemainGrouped(
 		// Fails if the substring of normalizedCandidate starting
 		// from fromIndex doesn't contain the consecutive digits
 		// in formattedNumberGroups[i].
-		fromIndex = strings.Index(
-			normalizedCandidate[fromIndex+1:], formattedNumberGroups[i])
-		if fromIndex < 0 {
+		var groupIndex = strings.Index(
+			normalizedCandidate[fromIndex:], formattedNumberGroups[i])
+		if groupIndex < 0 {
 			return false
 		}
+		fromIndex += groupIndex
 		// Moves fromIndex forward.
 		fromIndex += len(formattedNumberGroups[i])
 		if i == 0 && fromIndex < len(normalizedCandidate) {
@@ -150,8 +568,8 @@ func AllNumberGroupsRemainGrouped(
 			// number itself, as we do not need to distinguish between
 			// different countries with the same country calling code
 			// and this is faster.
-			var region = GetRegionCodeForCountryCode(int(number.GetCountryCode()))
-			if GetNddPrefixForRegion(region, true) != "" &&
+			var region = getRegionCodeForCountryCode(md, int(number.GetCountryCode()))
+			if getNddPrefixForRegion(md, region, true) != "" &&
 				unicode.IsDigit(rune(normalizedCandidate[fromIndex])) {
 				// This means there is no formatting symbol after the
 				// NDC. In this case, we only accept the number if there
@@ -177,11 +595,15 @@ func AllNumberGroupsAreExactlyPresent(
 	normalizedCandidate string,
 	formattedNumberGroups []string) bool {
 
-	var candidateGroups = NON_DIGITS_PATTERN.FindAllString(normalizedCandidate, -1)
+	var candidateGroups = NON_DIGITS_PATTERN.Split(normalizedCandidate, -1)
+	// Drop any trailing empty groups left by trailing non-digits.
+	for len(candidateGroups) > 1 && candidateGroups[len(candidateGroups)-1] == "" {
+		candidateGroups = candidateGroups[:len(candidateGroups)-1]
+	}
 	// Set this to the last group, skipping it if the number has an extension.
-	var candidateNumberGroupIndex = len(candidateGroups) - 2
+	var candidateNumberGroupIndex = len(candidateGroups) - 1
 	if number.GetExtension() != "" {
-		candidateNumberGroupIndex = len(candidateGroups) - 1
+		candidateNumberGroupIndex = len(candidateGroups) - 2
 	}
 
 	// First we check if the national significant number is formatted
@@ -212,7 +634,7 @@ func AllNumberGroupsAreExactlyPresent(
 
 // Returns whether the given national number (a string containing only decimal digits) matches
 // the national number pattern defined in the given PhoneNumberDesc message.
-func MatchNationalNumber(number string, numberDesc PhoneNumberDesc, allowPrefixMatch bool) bool {
+func MatchNationalNumber(number string, numberDesc *PhoneNumberDesc, allowPrefixMatch bool) bool {
 	nationalNumberPattern := numberDesc.GetNationalNumberPattern()
 	// We don't want to consider it a prefix match when matching non-empty input against an empty pattern.
 	if len(nationalNumberPattern) == 0 {
@@ -0,0 +1,213 @@
+package phonenumbers
+
+import (
+	"google.golang.org/protobuf/proto"
+)
+
+// the number types a region can have data for, FIXED_LINE_OR_MOBILE and UNKNOWN not being types
+// of their own
+var supportedNumberTypes = []PhoneNumberType{
+	FIXED_LINE, MOBILE, TOLL_FREE, PREMIUM_RATE, SHARED_COST, VOIP, PERSONAL_NUMBER, PAGER, UAN, VOICEMAIL,
+}
+
+// GetSupportedTypesForRegion returns the types of numbers the region has numbers of, or nil if
+// the region isn't supported. FIXED_LINE_OR_MOBILE is never included, as it isn't a type of its
+// own, and for regions where fixed line and mobile numbers can't be told apart both FIXED_LINE
+// and MOBILE are.
+func GetSupportedTypesForRegion(regionCode string) map[PhoneNumberType]bool {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return nil
+	}
+	return getSupportedTypesForMetadata(metadata)
+}
+
+// GetSupportedTypesForNonGeoEntity returns the types of numbers the non-geographical entity
+// with the country calling code has numbers of, or nil if it isn't a supported non-geographical
+// entity.
+func GetSupportedTypesForNonGeoEntity(countryCallingCode int) map[PhoneNumberType]bool {
+	md := activeMetadata()
+
+	metadata := getMetadataForNonGeographicalRegion(md, countryCallingCode)
+	if metadata == nil {
+		return nil
+	}
+	return getSupportedTypesForMetadata(metadata)
+}
+
+func getSupportedTypesForMetadata(metadata *PhoneMetadata) map[PhoneNumberType]bool {
+	types := make(map[PhoneNumberType]bool)
+	for _, typ := range supportedNumberTypes {
+		if descHasData(getNumberDescByType(metadata, typ)) {
+			types[typ] = true
+		}
+	}
+	return types
+}
+
+// whether the description has any numbers, types a region has none of being described by the
+// pattern "NA" and no possible lengths, or the single possible length -1
+func descHasData(desc *PhoneNumberDesc) bool {
+	if desc == nil {
+		return false
+	}
+	return desc.GetExampleNumber() != "" || descHasPossibleNumberData(desc) ||
+		(desc.NationalNumberPattern != nil && desc.GetNationalNumberPattern() != "NA")
+}
+
+// GetPossibleLengthsForRegion returns the lengths national significant numbers of the type can
+// have in the region, in ascending order, and those lengths which are only possible when dialling
+// locally, that is without an area code. Passing UNKNOWN returns the lengths of all numbers of the
+// region and FIXED_LINE_OR_MOBILE those of fixed line and mobile numbers combined. Both are nil
+// if the region isn't supported or has no numbers of the type.
+func GetPossibleLengthsForRegion(regionCode string, typ PhoneNumberType) (lengths []int32, localOnlyLengths []int32) {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return nil, nil
+	}
+	return getPossibleLengthsForMetadata(metadata, typ)
+}
+
+// GetPossibleLengthsForNonGeoEntity returns the possible lengths of numbers of the type for the
+// non-geographical entity with the country calling code, as GetPossibleLengthsForRegion does for
+// regions.
+func GetPossibleLengthsForNonGeoEntity(countryCallingCode int, typ PhoneNumberType) (lengths []int32, localOnlyLengths []int32) {
+	md := activeMetadata()
+
+	metadata := getMetadataForNonGeographicalRegion(md, countryCallingCode)
+	if metadata == nil {
+		return nil, nil
+	}
+	return getPossibleLengthsForMetadata(metadata, typ)
+}
+
+// works out the possible lengths the same way testNumberLength does, returning copies so callers
+// can't change our metadata
+func getPossibleLengthsForMetadata(metadata *PhoneMetadata, typ PhoneNumberType) ([]int32, []int32) {
+	if typ == FIXED_LINE_OR_MOBILE {
+		fixedLengths, fixedLocalLengths := getPossibleLengthsForMetadata(metadata, FIXED_LINE)
+		mobileLengths, mobileLocalLengths := getPossibleLengthsForMetadata(metadata, MOBILE)
+		if fixedLengths == nil {
+			return mobileLengths, mobileLocalLengths
+		}
+		if mobileLengths == nil {
+			return fixedLengths, fixedLocalLengths
+		}
+		return dedupLengths(mergeLengths(fixedLengths, mobileLengths)), dedupLengths(mergeLengths(fixedLocalLengths, mobileLocalLengths))
+	}
+
+	desc := getNumberDescByType(metadata, typ)
+	if typ != UNKNOWN && !descHasData(desc) {
+		return nil, nil
+	}
+
+	// sub-descriptions with the same lengths as the general description leave them out
+	lengths := desc.GetPossibleLength()
+	if len(lengths) == 0 {
+		lengths = metadata.GetGeneralDesc().GetPossibleLength()
+	}
+	if len(lengths) == 0 || lengths[0] == -1 {
+		return nil, nil
+	}
+	return append([]int32(nil), lengths...), append([]int32(nil), desc.GetPossibleLengthLocalOnly()...)
+}
+
+// removes the repeated lengths of a sorted slice of lengths
+func dedupLengths(lengths []int32) []int32 {
+	deduped := lengths[:0]
+	for i, length := range lengths {
+		if i == 0 || length != lengths[i-1] {
+			deduped = append(deduped, length)
+		}
+	}
+	return deduped
+}
+
+// GetInternationalPrefixForRegion returns the prefix dialled in the region to call another
+// country, for example 00 for Germany and 011 for the United States. For regions with several
+// international prefixes this is the preferred one, or an empty string if there isn't one, as is
+// the case when the region isn't supported. Use GetNddPrefixForRegion for the national prefix.
+func GetInternationalPrefixForRegion(regionCode string) string {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return ""
+	}
+	internationalPrefix := metadata.GetInternationalPrefix()
+	if internationalPrefix != "" && UNIQUE_INTERNATIONAL_PREFIX.FindString(internationalPrefix) == internationalPrefix {
+		return internationalPrefix
+	}
+	return metadata.GetPreferredInternationalPrefix()
+}
+
+// GetPreferredExtnPrefixForRegion returns what is put between a number and its extension when
+// formatting numbers of the region, which is " ext. " unless the region prefers another, or an
+// empty string if the region isn't supported.
+func GetPreferredExtnPrefixForRegion(regionCode string) string {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return ""
+	}
+	if metadata.GetPreferredExtnPrefix() != "" {
+		return metadata.GetPreferredExtnPrefix()
+	}
+	return DEFAULT_EXTN_PREFIX
+}
+
+// GetNumberFormatsForRegion returns the patterns used to format the national significant numbers
+// of the region in the national format, in the order they are tried, or nil if the region isn't
+// supported. The formats are copies, changing them has no effect on formatting.
+func GetNumberFormatsForRegion(regionCode string) []*NumberFormat {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return nil
+	}
+	return cloneNumberFormats(metadata.GetNumberFormat())
+}
+
+// GetIntlNumberFormatsForRegion returns the patterns used to format the national significant
+// numbers of the region in the international format, which are those of the national format
+// unless the region has formats of its own for international dialling. Numbers which can't be
+// dialled internationally are only covered by the national formats.
+func GetIntlNumberFormatsForRegion(regionCode string) []*NumberFormat {
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, regionCode)
+	if metadata == nil {
+		return nil
+	}
+	if len(metadata.GetIntlNumberFormat()) > 0 {
+		return cloneNumberFormats(metadata.GetIntlNumberFormat())
+	}
+	return cloneNumberFormats(metadata.GetNumberFormat())
+}
+
+// GetNumberFormatsForNonGeoEntity returns the patterns used to format the national significant
+// numbers of the non-geographical entity with the country calling code, or nil if it isn't a
+// supported non-geographical entity.
+func GetNumberFormatsForNonGeoEntity(countryCallingCode int) []*NumberFormat {
+	md := activeMetadata()
+
+	metadata := getMetadataForNonGeographicalRegion(md, countryCallingCode)
+	if metadata == nil {
+		return nil
+	}
+	return cloneNumberFormats(metadata.GetNumberFormat())
+}
+
+func cloneNumberFormats(formats []*NumberFormat) []*NumberFormat {
+	clones := make([]*NumberFormat, len(formats))
+	for i, format := range formats {
+		clones[i] = proto.Clone(format).(*NumberFormat)
+	}
+	return clones
+}
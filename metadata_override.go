@@ -0,0 +1,270 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	ErrUnknownRegion          = errors.New("the region code is not a supported region")
+	ErrInvalidRegionOverride  = errors.New("a region override can't change the region code or country calling code, or leave the region without possible lengths")
+	ErrInvalidCustomRegion    = errors.New("a custom region needs an unused region code, a country calling code and possible lengths")
+	ErrInvalidMetadataPattern = errors.New("the metadata contains an invalid pattern")
+)
+
+var (
+	// guards the registry below and the building of new metadata states from it
+	metadataRegistryMutex sync.Mutex
+
+	// the overrides registered for each region, applied in the order they were registered
+	regionOverrides = make(map[string][]func(*PhoneMetadata))
+
+	// the custom regions registered, keyed by customRegionKey
+	customRegions = make(map[string]*PhoneMetadata)
+)
+
+// RegisterRegionOverride registers a function which patches the metadata of the region, for
+// example to add a newly allocated mobile range before libphonenumber ships it. The function is
+// passed a copy of the metadata of the region and its changes are seen by every lookup, parsing,
+// validation and formatting call once RegisterRegionOverride returns.
+//
+// Overrides are applied again whenever the metadata is replaced by LoadMetadata or another
+// override or custom region is registered, always to the unpatched metadata of the region, so they
+// must make the same changes every time they are called. Note that a number must match the
+// general description of its region before any other type is considered, so an override adding a
+// range usually extends GeneralDesc and the possible lengths too.
+//
+// An error is returned if the region isn't supported, the override changes the region code or
+// country calling code or removes the possible lengths of GeneralDesc, or any of the patterns of
+// the patched metadata don't compile.
+func RegisterRegionOverride(regionCode string, override func(*PhoneMetadata)) error {
+	metadataRegistryMutex.Lock()
+	defer metadataRegistryMutex.Unlock()
+
+	state := activeMetadata()
+	if !hasRegionMetadata(state.base.GetMetadata(), regionCode) {
+		return fmt.Errorf("%w: %s", ErrUnknownRegion, regionCode)
+	}
+
+	previous := regionOverrides[regionCode]
+	regionOverrides[regionCode] = append(previous[:len(previous):len(previous)], override)
+
+	updated, err := newMetadataState(state.base, state.baseCountryCodeToRegion)
+	if err != nil {
+		if len(previous) == 0 {
+			delete(regionOverrides, regionCode)
+		} else {
+			regionOverrides[regionCode] = previous
+		}
+		return err
+	}
+	currentMetadata.Store(updated)
+	return nil
+}
+
+// RegisterCustomRegion registers the metadata of a region which libphonenumber doesn't know
+// about, such as a private dial plan for an internal PBX number space. Its Id must be a region
+// code which isn't otherwise in use and its CountryCode the calling code numbers of the region are
+// dialled with. A custom region sharing its calling code with other regions comes after them, so
+// it needs LeadingDigits or patterns which tell its numbers apart. An Id of "001" registers a
+// non-geographical entity, whose calling code must not be in use at all.
+//
+// Registering a custom region with the same Id, or for non-geographical entities the same calling
+// code, as an earlier one replaces it. The metadata is copied, so later changes to it have no
+// effect. An error is returned if the region clashes with the existing metadata, its GeneralDesc
+// has a pattern but no possible lengths, or any of its patterns don't compile.
+func RegisterCustomRegion(metadata *PhoneMetadata) error {
+	if metadata.GetId() == "" || metadata.GetCountryCode() <= 0 {
+		return ErrInvalidCustomRegion
+	}
+
+	metadataRegistryMutex.Lock()
+	defer metadataRegistryMutex.Unlock()
+
+	key := customRegionKey(metadata)
+	previous, hadPrevious := customRegions[key]
+	customRegions[key] = proto.Clone(metadata).(*PhoneMetadata)
+
+	state := activeMetadata()
+	updated, err := newMetadataState(state.base, state.baseCountryCodeToRegion)
+	if err != nil {
+		if hadPrevious {
+			customRegions[key] = previous
+		} else {
+			delete(customRegions, key)
+		}
+		return err
+	}
+	currentMetadata.Store(updated)
+	return nil
+}
+
+// custom regions are unique by region code, and non-geographical entities by calling code
+func customRegionKey(metadata *PhoneMetadata) string {
+	if metadata.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+		return fmt.Sprintf("%s+%d", REGION_CODE_FOR_NON_GEO_ENTITY, metadata.GetCountryCode())
+	}
+	return metadata.GetId()
+}
+
+func hasRegionMetadata(metadataList []*PhoneMetadata, regionCode string) bool {
+	if regionCode == REGION_CODE_FOR_NON_GEO_ENTITY {
+		return false
+	}
+	for _, metadata := range metadataList {
+		if metadata.GetId() == regionCode {
+			return true
+		}
+	}
+	return false
+}
+
+// returns the metadata and calling code to region mapping with the registered overrides and
+// custom regions applied, leaving those passed in untouched. The caller must hold
+// metadataRegistryMutex.
+func applyMetadataRegistry(baseList []*PhoneMetadata, baseCountryCodeToRegion map[int][]string) ([]*PhoneMetadata, map[int][]string, error) {
+	if len(regionOverrides) == 0 && len(customRegions) == 0 {
+		return baseList, baseCountryCodeToRegion, nil
+	}
+
+	metadataList := make([]*PhoneMetadata, 0, len(baseList)+len(customRegions))
+	for _, metadata := range baseList {
+		overrides := regionOverrides[metadata.GetId()]
+		if len(overrides) == 0 || metadata.GetId() == REGION_CODE_FOR_NON_GEO_ENTITY {
+			metadataList = append(metadataList, metadata)
+			continue
+		}
+
+		patched := proto.Clone(metadata).(*PhoneMetadata)
+		for _, override := range overrides {
+			override(patched)
+		}
+		if patched.GetId() != metadata.GetId() || patched.GetCountryCode() != metadata.GetCountryCode() {
+			return nil, nil, fmt.Errorf("%w: %s", ErrInvalidRegionOverride, metadata.GetId())
+		}
+		if err := validateMetadataPatterns(patched); err != nil {
+			return nil, nil, fmt.Errorf("region %s: %w", metadata.GetId(), err)
+		}
+		if err := validatePossibleLengths(patched); err != nil {
+			return nil, nil, fmt.Errorf("%w: %s %v", ErrInvalidRegionOverride, metadata.GetId(), err)
+		}
+		metadataList = append(metadataList, patched)
+	}
+
+	countryCodeToRegion := make(map[int][]string, len(baseCountryCodeToRegion)+len(customRegions))
+	for countryCode, regions := range baseCountryCodeToRegion {
+		countryCodeToRegion[countryCode] = regions
+	}
+
+	// add our custom regions in a stable order, so regions sharing a calling code are always
+	// tried in the same order
+	keys := make([]string, 0, len(customRegions))
+	for key := range customRegions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		custom := proto.Clone(customRegions[key]).(*PhoneMetadata)
+		region, countryCode := custom.GetId(), int(custom.GetCountryCode())
+
+		_, codeInUse := baseCountryCodeToRegion[countryCode]
+		if region == REGION_CODE_FOR_NON_GEO_ENTITY && codeInUse {
+			return nil, nil, fmt.Errorf("%w: calling code %d is already in use", ErrInvalidCustomRegion, countryCode)
+		}
+		if hasRegionMetadata(baseList, region) {
+			return nil, nil, fmt.Errorf("%w: region %s already exists", ErrInvalidCustomRegion, region)
+		}
+		if err := validateMetadataPatterns(custom); err != nil {
+			return nil, nil, fmt.Errorf("region %s: %w", region, err)
+		}
+		if err := validatePossibleLengths(custom); err != nil {
+			return nil, nil, fmt.Errorf("%w: region %s %v", ErrInvalidCustomRegion, region, err)
+		}
+
+		metadataList = append(metadataList, custom)
+		regions := countryCodeToRegion[countryCode]
+		countryCodeToRegion[countryCode] = append(regions[:len(regions):len(regions)], region)
+	}
+	return metadataList, countryCodeToRegion, nil
+}
+
+// returns every number description of the metadata, first setting those which are missing to
+// match nothing, as the metadata builder does for types a region doesn't have
+func phoneNumberDescs(metadata *PhoneMetadata) []*PhoneNumberDesc {
+	fields := []**PhoneNumberDesc{
+		&metadata.GeneralDesc, &metadata.FixedLine, &metadata.Mobile, &metadata.TollFree, &metadata.PremiumRate,
+		&metadata.SharedCost, &metadata.PersonalNumber, &metadata.Voip, &metadata.Pager, &metadata.Uan,
+		&metadata.Emergency, &metadata.Voicemail, &metadata.ShortCode, &metadata.StandardRate,
+		&metadata.CarrierSpecific, &metadata.SmsServices, &metadata.NoInternationalDialling,
+	}
+	descs := make([]*PhoneNumberDesc, len(fields))
+	for i, field := range fields {
+		if *field == nil {
+			*field = &PhoneNumberDesc{NationalNumberPattern: sp("NA"), PossibleLength: []int32{-1}}
+		}
+		descs[i] = *field
+	}
+	return descs
+}
+
+// checks the general description of the metadata has the possible lengths every number is checked
+// against, unless it matches nothing, in which case it is given the -1 the metadata builder uses to
+// match no length
+func validatePossibleLengths(metadata *PhoneMetadata) error {
+	desc := metadata.GetGeneralDesc()
+	if len(desc.PossibleLength) > 0 {
+		for _, length := range desc.PossibleLength {
+			if length <= 0 && !(length == -1 && len(desc.PossibleLength) == 1) {
+				return fmt.Errorf("has an invalid possible length %d", length)
+			}
+		}
+		return nil
+	}
+	if desc.GetNationalNumberPattern() != "NA" {
+		return errors.New("has no possible lengths in its general description")
+	}
+	desc.PossibleLength = []int32{-1}
+	return nil
+}
+
+// checks every pattern of the metadata compiles using validateRE, cleaning them and filling in
+// missing number descriptions and international prefix so they match nothing
+func validateMetadataPatterns(metadata *PhoneMetadata) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%w: %v", ErrInvalidMetadataPattern, r)
+		}
+	}()
+
+	for _, desc := range phoneNumberDescs(metadata) {
+		if desc.NationalNumberPattern != nil {
+			desc.NationalNumberPattern = sp(validateRE(desc.GetNationalNumberPattern(), true))
+		}
+	}
+	if metadata.LeadingDigits != nil {
+		metadata.LeadingDigits = sp(validateRE(metadata.GetLeadingDigits(), false))
+	}
+	if metadata.InternationalPrefix != nil {
+		metadata.InternationalPrefix = sp(validateRE(metadata.GetInternationalPrefix(), false))
+	} else {
+		// without one every number would be taken to start with a calling code
+		metadata.InternationalPrefix = sp("NA")
+	}
+	if metadata.NationalPrefixForParsing != nil {
+		metadata.NationalPrefixForParsing = sp(validateRE(metadata.GetNationalPrefixForParsing(), true))
+	}
+	for _, formats := range [][]*NumberFormat{metadata.NumberFormat, metadata.IntlNumberFormat} {
+		for _, format := range formats {
+			format.Pattern = sp(validateRE(format.GetPattern(), false))
+			for i, leadingDigits := range format.LeadingDigitsPattern {
+				format.LeadingDigitsPattern[i] = validateRE(leadingDigits, true)
+			}
+		}
+	}
+	return nil
+}
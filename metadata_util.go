@@ -40,3 +40,35 @@ func (pd *PhoneNumberDesc) hasPossibleLengthLocalOnly(length int32) bool {
 	}
 	return false
 }
+
+// clears what lite builds of the metadata leave out, the example numbers of every number
+// description, which are only used by GetExampleNumber and friends
+func filterMetadataForLiteBuild(metadata *PhoneMetadata) {
+	for _, desc := range phoneNumberDescs(metadata) {
+		desc.ExampleNumber = nil
+	}
+}
+
+// clears what special builds of the metadata leave out, which is everything but what is needed to
+// tell whether a number is a mobile number, as upstream's special build does
+func filterMetadataForSpecialBuild(metadata *PhoneMetadata) {
+	mobile := metadata.Mobile
+	metadata.FixedLine, metadata.Mobile, metadata.TollFree, metadata.PremiumRate = nil, nil, nil, nil
+	metadata.SharedCost, metadata.PersonalNumber, metadata.Voip, metadata.Pager = nil, nil, nil, nil
+	metadata.Uan, metadata.Emergency, metadata.Voicemail, metadata.ShortCode = nil, nil, nil, nil
+	metadata.StandardRate, metadata.CarrierSpecific, metadata.SmsServices = nil, nil, nil
+	metadata.NoInternationalDialling = nil
+	metadata.Mobile = mobile
+
+	// the descriptions we cleared are set back to match nothing, as we expect every description
+	// to be present
+	phoneNumberDescs(metadata)
+
+	metadata.PreferredInternationalPrefix = nil
+	metadata.NationalPrefix = nil
+	metadata.PreferredExtnPrefix = nil
+	metadata.NationalPrefixTransformRule = nil
+	metadata.SameMobileAndFixedLinePattern = nil
+	metadata.MainCountryForCode = nil
+	metadata.MobileNumberPortableRegion = nil
+}
@@ -2,6 +2,13 @@ package phonenumbers
 
 import (
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"sync"
+	"sync/atomic"
 
 	"github.com/oarkflow/pkg/str"
 )
@@ -17,23 +24,455 @@ type Network struct {
 	Status      string `json:"status"`
 }
 
-var CountryNetwork = map[string][]Network{}
+// IsOperational returns whether the network is currently in operation.
+func (n Network) IsOperational() bool {
+	return strings.EqualFold(n.Status, NETWORK_STATUS_OPERATIONAL)
+}
+
+// The type of networks which aren't tied to a single country.
+const NETWORK_TYPE_INTERNATIONAL = "International"
+
+// The status of networks that are currently in operation. Other networks either are no longer
+// in operation or have a status such as Unknown, Reserved or Testing.
+const NETWORK_STATUS_OPERATIONAL = "Operational"
+
+// NetworkStatusFilter selects networks by their status.
+type NetworkStatusFilter int
+
+const (
+	NETWORK_ANY NetworkStatusFilter = iota
+	NETWORK_OPERATIONAL
+	NETWORK_NOT_OPERATIONAL
+)
+
+// Matches returns whether the network passes the filter.
+func (f NetworkStatusFilter) Matches(network Network) bool {
+	switch f {
+	case NETWORK_OPERATIONAL:
+		return network.IsOperational()
+	case NETWORK_NOT_OPERATIONAL:
+		return !network.IsOperational()
+	}
+	return true
+}
+
+// Filter returns the networks which pass the filter, as a new slice.
+func (f NetworkStatusFilter) Filter(networks []Network) []Network {
+	filtered := make([]Network, 0, len(networks))
+	for _, network := range networks {
+		if f.Matches(network) {
+			filtered = append(filtered, network)
+		}
+	}
+	return filtered
+}
+
+var (
+	ErrInvalidHNI     = errors.New("the HNI is not a 3 digit MCC followed by a 2 or 3 digit MNC")
+	ErrInvalidIMSI    = errors.New("the IMSI is not a 3 digit MCC followed by an MNC and MSIN of at most 15 digits")
+	ErrUnknownNetwork = errors.New("the MCC and MNC do not identify a known network")
+)
+
+// The maximum length of an IMSI, see ITU-T E.212.
+const MAX_LENGTH_FOR_IMSI = 15
+
+// networkIndex holds the known networks indexed for lookups. Once built it is never modified, so
+// it can be read from any number of goroutines.
+type networkIndex struct {
+	all        []Network
+	byCountry  map[string][]Network
+	byMccMnc   map[CarrierNetwork][]Network
+	byMcc      map[string][]Network
+	byOperator map[string][]Network
 
+	// the country most of the networks of each MCC are in
+	mccCountry map[string]string
+}
+
+var (
+	networkOnce sync.Once
+	networkErr  error
+	networks    atomic.Pointer[networkIndex]
+
+	// serializes merges, readers never wait on it
+	networkMergeMutex sync.Mutex
+)
+
+// LoadNetworks loads the embedded network list. Calling it is optional, the list is loaded the
+// first time it's needed, but doing so reports an error loading it up front.
 func LoadNetworks() error {
-	if len(CountryNetwork) > 0 {
+	_, err := loadNetworks()
+	return err
+}
+
+// returns our network index, loading it from the embedded network list on first use
+func loadNetworks() (*networkIndex, error) {
+	networkOnce.Do(func() {
+		data, err := str.DecodeBinaryString(networkMap)
+		if err != nil {
+			networkErr = err
+			return
+		}
+		var items []Network
+		if err := json.Unmarshal(data, &items); err != nil {
+			networkErr = err
+			return
+		}
+		networks.Store(newNetworkIndex(items))
+	})
+	if networkErr != nil {
+		return nil, networkErr
+	}
+	return networks.Load(), nil
+}
+
+func newNetworkIndex(items []Network) *networkIndex {
+	index := &networkIndex{
+		all:        items,
+		byCountry:  make(map[string][]Network),
+		byMccMnc:   make(map[CarrierNetwork][]Network),
+		byMcc:      make(map[string][]Network),
+		byOperator: make(map[string][]Network),
+		mccCountry: make(map[string]string),
+	}
+	for _, network := range items {
+		index.add(network)
+	}
+
+	for mcc, networks := range index.byMcc {
+		counts := make(map[string]int)
+		for _, network := range networks {
+			for _, region := range networkRegions(network) {
+				counts[region]++
+			}
+		}
+		// ties go to the first region in alphabetical order, so the result doesn't change from
+		// one load to the next
+		home := ""
+		for region, count := range counts {
+			if count > counts[home] || (count == counts[home] && region < home) {
+				home = region
+			}
+		}
+		index.mccCountry[mcc] = home
+	}
+	return index
+}
+
+// returns the regions of the network, networks shared by several territories listing all of
+// them, e.g. "AU/CC/CX", and international ones none
+func networkRegions(network Network) []string {
+	if network.CountryCode == "" {
+		return []string{""}
+	}
+	return strings.Split(network.CountryCode, "/")
+}
+
+// adds the network to our country, MCC/MNC, MCC and operator indexes
+func (i *networkIndex) add(network Network) {
+	for _, region := range networkRegions(network) {
+		i.byCountry[region] = append(i.byCountry[region], network)
+	}
+
+	key := CarrierNetwork{Mcc: network.Mcc, Mnc: network.Mnc}
+	i.byMccMnc[key] = append(i.byMccMnc[key], network)
+	i.byMcc[network.Mcc] = append(i.byMcc[network.Mcc], network)
+
+	// index the network by each name of its operator and brand, but only once for each name
+	names := make(map[string]bool)
+	for _, field := range []string{network.Operator, network.Brand} {
+		for _, name := range operatorNames(field) {
+			if name = normalizeCarrierName(name); name != "" && !names[name] {
+				names[name] = true
+				i.byOperator[name] = append(i.byOperator[name], network)
+			}
+		}
+	}
+}
+
+// matches the aliases operators give in parentheses, e.g. "Nepal Telecom (NDCL)"
+var operatorAliasPattern = regexp.MustCompile(`\(([^()]*)\)`)
+
+// returns the names an operator or brand can be looked up by, the name itself, each of the
+// alternatives it lists, e.g. "Namaste / NT Mobile / Sky Phone", and for those with an alias in
+// parentheses both the name without it and the alias
+func operatorNames(field string) []string {
+	var names []string
+	for _, name := range append(strings.Split(field, "/"), field) {
+		names = append(names, name)
+		for _, alias := range operatorAliasPattern.FindAllStringSubmatch(name, -1) {
+			names = append(names, alias[1])
+		}
+		names = append(names, operatorAliasPattern.ReplaceAllString(name, " "))
+	}
+	return names
+}
+
+// NetworkError is returned when a network being loaded or merged is not valid. Err is one of the
+// ErrInvalidNetwork sentinel errors and Index is the position of the network in its list.
+type NetworkError struct {
+	Index   int
+	Network Network
+	Err     error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("invalid network %s-%s at index %d: %s", e.Network.Mcc, e.Network.Mnc, e.Index, e.Err)
+}
+
+func (e *NetworkError) Unwrap() error {
+	return e.Err
+}
+
+var (
+	ErrInvalidNetworkMcc     = errors.New("the MCC is not 3 digits")
+	ErrInvalidNetworkMnc     = errors.New("the MNC is not 2 or 3 digits")
+	ErrInvalidNetworkCountry = errors.New("the country code is not a known ISO 3166 country")
+)
+
+// NetworkConflict reports a merged network that conflicts with one we already knew of with the
+// same MCC and MNC. When both are for the same country, Existing was Replaced by Network,
+// otherwise the MCC and MNC are now shared by both countries.
+type NetworkConflict struct {
+	Network  Network
+	Existing Network
+	Replaced bool
+}
+
+// LoadNetworksFrom reads a JSON array of networks, in the format of the embedded network list,
+// and merges them over the networks we know of, see MergeNetworks.
+func LoadNetworksFrom(r io.Reader) ([]NetworkConflict, error) {
+	md := activeMetadata()
+
+	var items []Network
+	if err := json.NewDecoder(r).Decode(&items); err != nil {
+		return nil, fmt.Errorf("error decoding networks: %w", err)
+	}
+	return mergeNetworks(md, items)
+}
+
+// MergeNetworks layers the passed in networks over those we know of, so that local corrections
+// can be made without a new release. A network replaces the one with the same MCC, MNC and
+// country, or is added if there is none. Merged networks must have a 3 digit MCC, a 2 or 3 digit
+// MNC and a known ISO 3166 country code, which international networks may leave empty. If any
+// network is invalid nothing is merged and the returned error joins a *NetworkError for each.
+//
+// The conflicts with the networks we knew of are returned, networks identical to one we knew of
+// are not conflicts. Lookups made while merging see either all or none of the networks. Note the
+// carrier network mapping used by Number.Verify is generated at build time and isn't affected.
+func MergeNetworks(items []Network) ([]NetworkConflict, error) {
+	return mergeNetworks(activeMetadata(), items)
+}
+
+func mergeNetworks(md *metadataState, items []Network) ([]NetworkConflict, error) {
+	var errs []error
+	for i, network := range items {
+		if err := validateNetwork(md, network); err != nil {
+			errs = append(errs, &NetworkError{Index: i, Network: network, Err: err})
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if _, err := loadNetworks(); err != nil {
+		return nil, err
+	}
+
+	networkMergeMutex.Lock()
+	defer networkMergeMutex.Unlock()
+
+	current := networks.Load()
+	merged := make([]Network, len(current.all), len(current.all)+len(items))
+	copy(merged, current.all)
+
+	// where each network we knew of, or merged, is in merged by MCC/MNC
+	positions := make(map[CarrierNetwork][]int, len(merged))
+	for i, network := range merged {
+		key := CarrierNetwork{Mcc: network.Mcc, Mnc: network.Mnc}
+		positions[key] = append(positions[key], i)
+	}
+
+	var conflicts []NetworkConflict
+	for _, network := range items {
+		key := CarrierNetwork{Mcc: network.Mcc, Mnc: network.Mnc}
+		replaced := false
+		for _, i := range positions[key] {
+			existing := merged[i]
+			if existing == network {
+				replaced = true
+				break
+			}
+			sameCountry := existing.CountryCode == network.CountryCode
+			conflicts = append(conflicts, NetworkConflict{Network: network, Existing: existing, Replaced: sameCountry})
+			if sameCountry {
+				merged[i] = network
+				replaced = true
+			}
+		}
+		if !replaced {
+			positions[key] = append(positions[key], len(merged))
+			merged = append(merged, network)
+		}
+	}
+
+	networks.Store(newNetworkIndex(merged))
+	return conflicts, nil
+}
+
+// returns the reason the network is not valid, if any
+func validateNetwork(md *metadataState, network Network) error {
+	if len(network.Mcc) != 3 || !isDigits(network.Mcc) {
+		return ErrInvalidNetworkMcc
+	}
+	if len(network.Mnc) < 2 || len(network.Mnc) > 3 || !isDigits(network.Mnc) {
+		return ErrInvalidNetworkMnc
+	}
+	if network.CountryCode == "" {
+		if !strings.EqualFold(network.Type, NETWORK_TYPE_INTERNATIONAL) {
+			return ErrInvalidNetworkCountry
+		}
 		return nil
 	}
-	data, err := str.DecodeBinaryString(networkMap)
+	// networks shared by several territories list all of them, e.g. "AU/CC/CX"
+	for _, region := range strings.Split(network.CountryCode, "/") {
+		if _, found := Countries[region]; !found && !isValidRegionCode(md, region) {
+			return ErrInvalidNetworkCountry
+		}
+	}
+	return nil
+}
+
+// Networks returns all the known networks. The returned slice is a copy which callers are free
+// to modify.
+func Networks() []Network {
+	index, err := loadNetworks()
 	if err != nil {
-		return err
+		return nil
 	}
-	var items []Network
-	err = json.Unmarshal(data, &items)
+	return NETWORK_ANY.Filter(index.all)
+}
+
+// NetworksByCountry returns the networks of the region which pass the status filter, including
+// those shared with other regions. Networks which aren't tied to a single country, such as
+// international ones, have an empty region.
+func NetworksByCountry(region string, filter NetworkStatusFilter) []Network {
+	index, err := loadNetworks()
 	if err != nil {
-		return err
+		return nil
 	}
-	for _, mp := range items {
-		CountryNetwork[mp.CountryCode] = append(CountryNetwork[mp.CountryCode], mp)
+	return filter.Filter(index.byCountry[strings.ToUpper(region)])
+}
+
+// NetworkByMccMnc returns the network identified by the MCC and MNC and whether there is one.
+// Some MCC/MNC pairs are shared by more than one country, e.g. 310-260 by the United States and
+// Puerto Rico, in which case the network of the home country of the MCC, the country most of its
+// networks are in, is preferred, and operational networks over those that aren't. Use
+// NetworksByMccMnc for all of them. MNCs must include any leading zeros, so "01" and "001" are
+// different networks.
+func NetworkByMccMnc(mcc, mnc string) (Network, bool) {
+	index, err := loadNetworks()
+	if err != nil {
+		return Network{}, false
 	}
-	return nil
+	matches := index.byMccMnc[CarrierNetwork{Mcc: mcc, Mnc: mnc}]
+	if len(matches) == 0 {
+		return Network{}, false
+	}
+
+	best, bestScore := matches[0], -1
+	for _, network := range matches {
+		score := 0
+		for _, region := range networkRegions(network) {
+			if region == index.mccCountry[mcc] {
+				score += 2
+				break
+			}
+		}
+		if network.IsOperational() {
+			score++
+		}
+		if score > bestScore {
+			best, bestScore = network, score
+		}
+	}
+	return best, true
+}
+
+// NetworksByMccMnc returns the networks identified by the MCC and MNC which pass the status
+// filter, of which there are several for pairs shared by more than one country.
+func NetworksByMccMnc(mcc, mnc string, filter NetworkStatusFilter) []Network {
+	index, err := loadNetworks()
+	if err != nil {
+		return nil
+	}
+	return filter.Filter(index.byMccMnc[CarrierNetwork{Mcc: mcc, Mnc: mnc}])
+}
+
+// NetworksByMcc returns the networks with the MCC which pass the status filter.
+func NetworksByMcc(mcc string, filter NetworkStatusFilter) []Network {
+	index, err := loadNetworks()
+	if err != nil {
+		return nil
+	}
+	return filter.Filter(index.byMcc[mcc])
+}
+
+// NetworksByOperator returns the networks whose operator or brand is name and which pass the
+// status filter. Names are compared case insensitively, ignoring punctuation and words such
+// as "Ltd" or "Inc", brands listing alternatives such as "Namaste / NT Mobile" match each
+// of them, and names with an alias in parentheses such as "Nepal Telecom (NDCL)" match both the
+// name and the alias.
+func NetworksByOperator(name string, filter NetworkStatusFilter) []Network {
+	index, err := loadNetworks()
+	if err != nil {
+		return nil
+	}
+	return filter.Filter(index.byOperator[normalizeCarrierName(name)])
+}
+
+// ParseHNI returns the network identified by the home network identity, the MCC of a network
+// followed by its MNC. As MNCs are 2 or 3 digits, the length of the HNI determines the MNC.
+func ParseHNI(hni string) (Network, error) {
+	if (len(hni) != 5 && len(hni) != 6) || !isDigits(hni) {
+		return Network{}, ErrInvalidHNI
+	}
+	network, found := NetworkByMccMnc(hni[:3], hni[3:])
+	if !found {
+		return Network{}, ErrUnknownNetwork
+	}
+	return network, nil
+}
+
+// IMSI is an international mobile subscriber identity split into the network it belongs to
+// and the MSIN identifying the subscriber within that network.
+type IMSI struct {
+	Network Network
+	Msin    string
+}
+
+// ParseIMSI parses the international mobile subscriber identity, resolving it to its network.
+// An IMSI doesn't tell whether its MNC is 2 or 3 digits, so the 3 digit MNC is used when the MCC
+// has a network with it and the 2 digit one otherwise. Unknown networks return ErrUnknownNetwork.
+func ParseIMSI(imsi string) (*IMSI, error) {
+	if len(imsi) < 6 || len(imsi) > MAX_LENGTH_FOR_IMSI || !isDigits(imsi) {
+		return nil, ErrInvalidIMSI
+	}
+	if network, found := NetworkByMccMnc(imsi[:3], imsi[3:6]); found {
+		return &IMSI{Network: network, Msin: imsi[6:]}, nil
+	}
+	if network, found := NetworkByMccMnc(imsi[:3], imsi[3:5]); found {
+		return &IMSI{Network: network, Msin: imsi[5:]}, nil
+	}
+	return nil, ErrUnknownNetwork
+}
+
+// returns whether s is a non empty string of ASCII digits
+func isDigits(s string) bool {
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return s != ""
 }
@@ -1,11 +1,13 @@
 package phonenumbers
 
 import (
+	"context"
 	"fmt"
+	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/oarkflow/errors"
-	"github.com/oarkflow/pkg/pool"
 )
 
 var Type = map[int]string{
@@ -49,28 +51,98 @@ var Type = map[int]string{
 }
 
 type Number struct {
-	Phone          string `query:"phone" json:"phone" csv:"phone"`
-	DefaultPrefix  string `query:"default_prefix" json:"default_prefix" csv:"default_prefix"`
-	PhoneTypeHuman string `json:"phone_type_human" csv:"phone_type_human"`
-	CarrierName    string `json:"carrier_name" csv:"carrier_name"`
-	CarrierMcc     string `json:"carrier_mcc" csv:"carrier_mcc"`
-	CarrierMnc     string `json:"carrier_mnc" csv:"carrier_mnc"`
-	CarrierNnc     string `json:"carrier_nnc" csv:"carrier_nnc"`
-	CountryName    string `json:"country_name" csv:"country_name"`
-	CountryCode    string `json:"country_code" csv:"country_code"`
-	Currency       string `json:"currency" csv:"currency"`
-	CurrencySymbol string `json:"currency_symbol" csv:"currency_symbol"`
-	Timezone       string `json:"timezone" csv:"timezone"`
-	Invalid        bool   `json:"invalid" csv:"invalid"`
-	DialCode       int32  `json:"dial_code" csv:"dial_code"`
-	PhoneType      int    `json:"phone_type" csv:"phone_type"`
+	Index             int    `json:"index" csv:"index"`
+	Phone             string `query:"phone" json:"phone" csv:"phone"`
+	DefaultPrefix     string `query:"default_prefix" json:"default_prefix" csv:"default_prefix"`
+	PhoneTypeHuman    string `json:"phone_type_human" csv:"phone_type_human"`
+	CarrierName       string `json:"carrier_name" csv:"carrier_name"`
+	CarrierMcc        string `json:"carrier_mcc" csv:"carrier_mcc"`
+	CarrierMnc        string `json:"carrier_mnc" csv:"carrier_mnc"`
+	CarrierNnc        string `json:"carrier_nnc" csv:"carrier_nnc"`
+	CarrierConfidence string `json:"carrier_confidence,omitempty" csv:"carrier_confidence"`
+	CarrierAmbiguous  bool   `json:"carrier_ambiguous" csv:"carrier_ambiguous"`
+	CarrierSource     string `json:"carrier_source,omitempty" csv:"carrier_source"`
+	CountryName       string `json:"country_name" csv:"country_name"`
+	CountryCode       string `json:"country_code" csv:"country_code"`
+	Currency          string `json:"currency" csv:"currency"`
+	CurrencySymbol    string `json:"currency_symbol" csv:"currency_symbol"`
+	Timezone          string `json:"timezone" csv:"timezone"`
+	Invalid           bool   `json:"invalid" csv:"invalid"`
+	InvalidReason     string `json:"invalid_reason,omitempty" csv:"invalid_reason"`
+	Location          string `json:"location,omitempty" csv:"location"`
+	DialCode          int32  `json:"dial_code" csv:"dial_code"`
+	PhoneType         int    `json:"phone_type" csv:"phone_type"`
 }
 
 type Numbers struct {
-	Phones        []string `json:"phones"`
-	DefaultPrefix string   `query:"default_prefix" json:"default_prefix"`
-	PhoneTypes    []string `json:"phone_types"`
-	PhoneOnly     bool     `json:"phone_only"`
+	Phones        []string      `json:"phones"`
+	DefaultPrefix string        `query:"default_prefix" json:"default_prefix"`
+	PhoneTypes    []string      `json:"phone_types"`
+	PhoneOnly     bool          `json:"phone_only"`
+	Options       VerifyOptions `json:"options"`
+}
+
+// VerifyOptions controls how numbers are verified. The zero value looks up
+// carriers in English, doesn't geocode, retries numbers that fail with the
+// default region as international numbers and rejects numbers whose type
+// can't be determined.
+type VerifyOptions struct {
+	// Region used for numbers not written in international format, the
+	// DefaultPrefix of a Number or Numbers takes precedence if set.
+	DefaultRegion string `json:"default_region"`
+
+	// Skips looking up the carrier name, MCC and MNC.
+	DisableCarrier  bool   `json:"disable_carrier"`
+	CarrierLanguage string `json:"carrier_language"`
+
+	// Looks up the geographical area of the number into Number.Location.
+	Geocode         bool   `json:"geocode"`
+	GeocodeLanguage string `json:"geocode_language"`
+
+	// Doesn't retry numbers that fail to parse, or parse to an UNKNOWN type,
+	// with the default region as international numbers.
+	DisableRetry bool `json:"disable_retry"`
+
+	// Accepts any number that parses, even if its type is UNKNOWN.
+	AcceptUnknownType bool `json:"accept_unknown_type"`
+
+	// Accepts numbers of UNKNOWN type as long as they have a possible length
+	// for their region, i.e. possible but not valid numbers.
+	AcceptPossible bool `json:"accept_possible"`
+
+	// Number of goroutines bulk verification runs on, defaults to GOMAXPROCS.
+	Workers int `json:"workers"`
+
+	// Consulted before guessing the carrier from the number's prefix, so that
+	// ported numbers get the carrier and network they were ported to.
+	Portability PortabilityProvider `json:"-"`
+}
+
+func (o VerifyOptions) workers() int {
+	if o.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return o.Workers
+}
+
+func (o VerifyOptions) carrierLanguage() string {
+	if o.CarrierLanguage == "" {
+		return "EN"
+	}
+	return o.CarrierLanguage
+}
+
+func (o VerifyOptions) geocodeLanguage() string {
+	if o.GeocodeLanguage == "" {
+		return "en"
+	}
+	return o.GeocodeLanguage
+}
+
+// Returns whether a number whose type is UNKNOWN should still be considered
+// valid under these options.
+func (o VerifyOptions) acceptsUnknown(md *metadataState, num *PhoneNumber) bool {
+	return o.AcceptUnknownType || (o.AcceptPossible && isPossibleNumber(md, num))
 }
 
 type VerifiedNumbers struct {
@@ -83,6 +155,8 @@ type UnverifiedNumbers struct {
 	Phones []string `json:"phones"`
 }
 
+// Ops is a condition or projection of a Query. Alias is the column name of a
+// projection.
 type Ops struct {
 	Raw      string `json:"raw"`
 	Field    string `json:"field"`
@@ -91,9 +165,13 @@ type Ops struct {
 	Alias    string `json:"alias"`
 }
 
+// Query is a filter and aggregation over verified numbers, see ParseQuery.
+// Operations are the conditions numbers must all match, Select the projected
+// fields and counts, and Fields the names of the resulting columns.
 type Query struct {
 	Raw        string   `json:"raw"`
 	Fields     []string `json:"fields"`
+	Select     []Ops    `json:"select"`
 	Operations []Ops    `json:"operations"`
 	GroupBy    []string `json:"group_by"`
 }
@@ -126,6 +204,15 @@ type CountryStats struct {
 	TotalCount   int                              `json:"total_count"`
 }
 
+// Reasons a number can be invalid besides the ParseErrorType of a failed parse,
+// as reported in Number.InvalidReason.
+const (
+	INVALID_REASON_UNKNOWN_TYPE     = "UNKNOWN_TYPE"
+	INVALID_REASON_UNKNOWN_TIMEZONE = "UNKNOWN_TIMEZONE"
+)
+
+var ErrUnknownNumberType = errors.New("unknown phone number")
+
 var allowedPhoneTypes = map[string]int{
 	"landline":           4,
 	"mobile":             1,
@@ -134,10 +221,27 @@ var allowedPhoneTypes = map[string]int{
 	"voip":               6,
 }
 
-func (p *Number) Verify(getCarrierInfo ...bool) {
-	carrierInfo := true
-	if len(getCarrierInfo) > 0 && !getCarrierInfo[0] {
-		carrierInfo = false
+// Verify parses and validates the number using the default options, filling
+// in the remaining fields.
+func (p *Number) Verify() {
+	p.verify(activeMetadata())
+}
+
+func (p *Number) verify(md *metadataState) {
+	p.verifyWithOptions(md, VerifyOptions{})
+}
+
+// VerifyWithOptions parses and validates the number, filling in the
+// remaining fields. If the number can't be verified, Invalid is set and
+// InvalidReason says why.
+func (p *Number) VerifyWithOptions(opts VerifyOptions) {
+	p.verifyWithOptions(activeMetadata(), opts)
+}
+
+func (p *Number) verifyWithOptions(md *metadataState, opts VerifyOptions) {
+	defaultRegion := p.DefaultPrefix
+	if defaultRegion == "" {
+		defaultRegion = opts.DefaultRegion
 	}
 	var num *PhoneNumber
 	var e error
@@ -149,38 +253,50 @@ func (p *Number) Verify(getCarrierInfo ...bool) {
 	phoneWithoutPlus := strings.Replace(p.Phone, "+", "", 1)
 	phoneWithPlus := "+" + phoneWithoutPlus
 	if hasPlusSymbol {
-		num, e = Parse(phoneWithPlus, "")
+		num, e = parse(md, phoneWithPlus, "")
 	} else {
-		num, e = Parse(phoneWithoutPlus, strings.ToUpper(p.DefaultPrefix))
+		num, e = parse(md, phoneWithoutPlus, strings.ToUpper(defaultRegion))
 	}
-	p.PhoneType = int(GetNumberType(num))
-	if e == nil && (p.PhoneType == 10 || p.PhoneType == 11) {
-		e = errors.New("unknown phone number")
+	parsed := e == nil
+	if parsed && isUnknownType(md, num) {
+		e = ErrUnknownNumberType
 	}
-	if e != nil {
-		num, e = Parse(phoneWithPlus, "")
+	if e != nil && !opts.DisableRetry {
+		// retry as an international number, preferring it if it parses to a
+		// known type or the number didn't parse at all with the default region,
+		// but if that fails too, report why the number as given was rejected
+		retryNum, retryErr := parse(md, phoneWithPlus, "")
+		if retryErr == nil && (!parsed || !isUnknownType(md, retryNum)) {
+			num, e = retryNum, nil
+		}
+	}
+	if errors.Is(e, ErrUnknownNumberType) && opts.acceptsUnknown(md, num) {
+		e = nil
 	}
 	if e != nil {
 		num = nil
 		p.Invalid = true
+		p.InvalidReason = invalidReason(e)
 		return
 	}
-	p.PhoneType = int(GetNumberType(num))
-	region := GetRegionCodeForNumber(num)
+	p.PhoneType = int(getNumberType(md, num))
+	region := getRegionCodeForNumber(md, num)
 	p.CountryCode = region
 	p.DefaultPrefix = region
 	p.PhoneTypeHuman = Type[p.PhoneType]
-	if p.PhoneType == 10 || p.PhoneType == 11 {
+	if isUnknownType(md, num) && !opts.acceptsUnknown(md, num) {
 		num = nil
 		p.Invalid = true
+		p.InvalidReason = INVALID_REASON_UNKNOWN_TYPE
 		return
 	}
 
-	p.Phone = Format(num, E164)
-	timezones, e := GetTimezonesForNumber(num)
+	p.Phone = format(md, num, E164)
+	timezones, e := getTimezonesForNumber(md, num)
 	if e != nil {
 		num = nil
 		p.Invalid = true
+		p.InvalidReason = INVALID_REASON_UNKNOWN_TIMEZONE
 		return
 	}
 	if len(timezones) > 0 {
@@ -192,59 +308,105 @@ func (p *Number) Verify(getCarrierInfo ...bool) {
 	p.CountryName = country.Name
 	p.Currency = country.Currency
 	p.CurrencySymbol = country.CurrencySymbol
-	if carrierInfo {
-		carrier, _ := GetCarrierForNumber(num, "EN")
+	if opts.Geocode {
+		p.Location, _ = getGeocodingForNumber(md, num, opts.geocodeLanguage())
+	}
+	if !opts.DisableCarrier {
+		carrier, ported, e := getPortedCarrierForNumber(md, num, opts.carrierLanguage(), opts.Portability)
+		if e != nil {
+			// a failing provider shouldn't stop us from guessing the carrier
+			carrier, ported = "", nil
+			carrier, _ = getCarrierForNumber(md, num, opts.carrierLanguage())
+		}
 		p.CarrierName = carrier
-		networks := CountryNetwork[region]
-		for _, net := range networks {
-			if carrier != "" && (strings.Contains(strings.ToLower(net.Brand), strings.ToLower(carrier)) ||
-				strings.Contains(strings.ToLower(net.Operator), strings.ToLower(carrier))) {
-				p.CarrierMnc = net.Mnc
-				p.CarrierMcc = net.Mcc
-				p.CarrierNnc = net.Mcc + net.Mnc
-				break
-			}
+		if ported != nil {
+			p.CarrierMnc = ported.Mnc
+			p.CarrierMcc = ported.Mcc
+			p.CarrierNnc = ported.Mcc + ported.Mnc
+			p.CarrierSource = CARRIER_SOURCE_PORTED
+			return
+		}
+		if carrier != "" {
+			p.CarrierSource = CARRIER_SOURCE_PREFIX
 		}
+		// when the networks are ambiguous we still report the first, but flag it
+		// so callers that need a definite network can ignore it
+		networks, _ := getCarrierNetworksForNumber(md, num)
+		if networks != nil && len(networks.Networks) > 0 {
+			net := networks.Networks[0]
+			p.CarrierMnc = net.Mnc
+			p.CarrierMcc = net.Mcc
+			p.CarrierNnc = net.Mcc + net.Mnc
+			p.CarrierConfidence = networks.Confidence.String()
+			p.CarrierAmbiguous = networks.Ambiguous
+		}
+	}
+}
+
+// Returns whether the number's type is one Type labels as UNKNOWN.
+func isUnknownType(md *metadataState, num *PhoneNumber) bool {
+	numType := int(getNumberType(md, num))
+	return Type[numType] == "UNKNOWN"
+}
+
+// Returns the InvalidReason for a number that failed to parse with err.
+func invalidReason(err error) string {
+	var parseErr *ParseError
+	if errors.As(err, &parseErr) {
+		return parseErr.Type.String()
+	}
+	if errors.Is(err, ErrUnknownNumberType) {
+		return INVALID_REASON_UNKNOWN_TYPE
 	}
+	return PARSE_NOT_A_NUMBER.String()
 }
 
 func (p *Numbers) Verify() VerifiedNumbers {
-	numbers := VerifiedNumbers{}
-	workerPool := pool.New()
-	defer workerPool.Close()
+	return p.verify(activeMetadata())
+}
 
-	batch := workerPool.Batch()
+func (p *Numbers) verify(md *metadataState) VerifiedNumbers {
+	numbers, _ := p.verifyContext(context.Background(), md)
+	return numbers
+}
 
-	go func() {
-		for _, phone := range p.Phones {
-			num := Number{Phone: phone, DefaultPrefix: p.DefaultPrefix}
-			batch.Queue(verify(num))
-		}
-		batch.QueueComplete()
-	}()
-	for phone := range batch.Results() {
-		numbers.Phones = append(numbers.Phones, phone.Value().(Number))
+// VerifyContext verifies all phones, returning them in input order. If ctx is
+// cancelled or its deadline passes, it stops early and returns ctx's error.
+func (p *Numbers) VerifyContext(ctx context.Context) (VerifiedNumbers, error) {
+	return p.verifyContext(ctx, activeMetadata())
+}
+
+func (p *Numbers) verifyContext(ctx context.Context, md *metadataState) (VerifiedNumbers, error) {
+	phones, err := verifyAll(ctx, md, p.Phones, p.DefaultPrefix, p.Options)
+	if err != nil {
+		return VerifiedNumbers{}, err
 	}
-	return numbers
+	return VerifiedNumbers{Phones: phones}, nil
 }
 
 func (p *Numbers) Clean() (VerifiedNumbers, UnverifiedNumbers) {
-	phones := UnverifiedNumbers{}
-	numbers := VerifiedNumbers{DefaultPrefix: p.DefaultPrefix, PhoneTypes: p.PhoneTypes}
-	workerPool := pool.New()
-	defer workerPool.Close()
+	return p.clean(activeMetadata())
+}
 
-	batch := workerPool.Batch()
+func (p *Numbers) clean(md *metadataState) (VerifiedNumbers, UnverifiedNumbers) {
+	numbers, phones, _ := p.cleanContext(context.Background(), md)
+	return numbers, phones
+}
 
-	go func() {
-		for _, phone := range p.Phones {
-			num := Number{Phone: phone, DefaultPrefix: p.DefaultPrefix}
-			batch.Queue(verify(num))
-		}
-		batch.QueueComplete()
-	}()
-	for phone := range batch.Results() {
-		num := phone.Value().(Number)
+// CleanContext is the cancellable version of Clean, it returns the valid
+// phones of the allowed types in input order.
+func (p *Numbers) CleanContext(ctx context.Context) (VerifiedNumbers, UnverifiedNumbers, error) {
+	return p.cleanContext(ctx, activeMetadata())
+}
+
+func (p *Numbers) cleanContext(ctx context.Context, md *metadataState) (VerifiedNumbers, UnverifiedNumbers, error) {
+	phones := UnverifiedNumbers{}
+	numbers := VerifiedNumbers{DefaultPrefix: p.DefaultPrefix, PhoneTypes: p.PhoneTypes}
+	verified, err := verifyAll(ctx, md, p.Phones, p.DefaultPrefix, p.Options)
+	if err != nil {
+		return numbers, phones, err
+	}
+	for _, num := range verified {
 		if !num.Invalid {
 			if len(p.PhoneTypes) > 0 {
 				for _, phoneType := range p.PhoneTypes {
@@ -274,26 +436,33 @@ func (p *Numbers) Clean() (VerifiedNumbers, UnverifiedNumbers) {
 
 		}
 	}
-	return numbers, phones
+	return numbers, phones, nil
 }
 
 func (p *Numbers) StatsByCarrier() CarrierStats {
+	return p.statsByCarrier(activeMetadata())
+}
+
+func (p *Numbers) statsByCarrier(md *metadataState) CarrierStats {
+	stats, _ := p.statsByCarrierContext(context.Background(), md)
+	return stats
+}
+
+// StatsByCarrierContext is the cancellable version of StatsByCarrier, results
+// are ordered by the first phone that fell into them.
+func (p *Numbers) StatsByCarrierContext(ctx context.Context) (CarrierStats, error) {
+	return p.statsByCarrierContext(ctx, activeMetadata())
+}
+
+func (p *Numbers) statsByCarrierContext(ctx context.Context, md *metadataState) (CarrierStats, error) {
 	stats := CarrierStats{}
 	rs := make(map[string]*AnalyzeCarrierResult)
-	workerPool := pool.New()
-	defer workerPool.Close()
-
-	batch := workerPool.Batch()
+	verified, err := verifyAll(ctx, md, p.Phones, p.DefaultPrefix, p.Options)
+	if err != nil {
+		return stats, err
+	}
 	stats.TotalCount = len(p.Phones)
-	go func() {
-		for _, phone := range p.Phones {
-			num := Number{Phone: phone, DefaultPrefix: p.DefaultPrefix}
-			batch.Queue(verify(num))
-		}
-		batch.QueueComplete()
-	}()
-	for phone := range batch.Results() {
-		num := phone.Value().(Number)
+	for _, num := range verified {
 		if num.Invalid {
 			stats.InvalidCount += 1
 			continue
@@ -313,35 +482,38 @@ func (p *Numbers) StatsByCarrier() CarrierStats {
 		if rs[hash] == nil {
 			analyzeResult.PhoneCount = 1
 			rs[hash] = analyzeResult
+			stats.RS = append(stats.RS, analyzeResult)
 		} else {
 			rs[hash].PhoneCount += 1
 		}
 	}
+	return stats, nil
+}
 
-	for _, val := range rs {
-		stats.RS = append(stats.RS, val)
-	}
-	rs = nil
+func (p *Numbers) StatsByCountry() CountryStats {
+	return p.statsByCountry(activeMetadata())
+}
+
+func (p *Numbers) statsByCountry(md *metadataState) CountryStats {
+	stats, _ := p.statsByCountryContext(context.Background(), md)
 	return stats
 }
 
-func (p *Numbers) StatsByCountry() CountryStats {
+// StatsByCountryContext is the cancellable version of StatsByCountry, results
+// are ordered by the first phone that fell into them.
+func (p *Numbers) StatsByCountryContext(ctx context.Context) (CountryStats, error) {
+	return p.statsByCountryContext(ctx, activeMetadata())
+}
+
+func (p *Numbers) statsByCountryContext(ctx context.Context, md *metadataState) (CountryStats, error) {
 	stats := CountryStats{}
 	rs := make(map[string]*AnalyzeCountryResult)
-	workerPool := pool.New()
-	defer workerPool.Close()
-
-	batch := workerPool.Batch()
+	verified, err := verifyAll(ctx, md, p.Phones, p.DefaultPrefix, p.Options)
+	if err != nil {
+		return stats, err
+	}
 	stats.TotalCount = len(p.Phones)
-	go func() {
-		for _, phone := range p.Phones {
-			num := Number{Phone: phone, DefaultPrefix: p.DefaultPrefix}
-			batch.Queue(verify(num))
-		}
-		batch.QueueComplete()
-	}()
-	for phone := range batch.Results() {
-		num := phone.Value().(Number)
+	for _, num := range verified {
 		if num.Invalid {
 			stats.InvalidCount += 1
 			continue
@@ -356,92 +528,240 @@ func (p *Numbers) StatsByCountry() CountryStats {
 		if rs[hash] == nil {
 			analyzeResult.PhoneCount = 1
 			rs[hash] = analyzeResult
+			stats.RS = append(stats.RS, analyzeResult)
 		} else {
 			rs[hash].PhoneCount += 1
 		}
 	}
-	for _, val := range rs {
-		stats.RS = append(stats.RS, val)
-	}
-	rs = nil
-	return stats
+	return stats, nil
 }
 
-func verify(phone Number) pool.WorkFunc {
-	return func(wu pool.WorkUnit) (interface{}, error) {
-		if wu.IsCancelled() {
-			return nil, nil
+// Verifies phones on opts.Workers goroutines, returning the results in input
+// order with Index set to each phone's position. If ctx is done before all
+// phones are verified, no results are returned, only ctx's error.
+func verifyAll(ctx context.Context, md *metadataState, phones []string, defaultPrefix string, opts VerifyOptions) ([]Number, error) {
+	results := make([]Number, len(phones))
+	indexes := make(chan int)
+
+	wg := new(sync.WaitGroup)
+	for w := 0; w < opts.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				num := Number{Index: i, Phone: phones[i], DefaultPrefix: defaultPrefix}
+				num.verifyWithOptions(md, opts)
+				results[i] = num
+			}
+		}()
+	}
+
+	var err error
+queue:
+	for i := range phones {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			err = ctx.Err()
+			break queue
 		}
-		phone.Verify()
-		return phone, nil
 	}
-}
+	close(indexes)
+	wg.Wait()
 
-func Verify(phone string, defaultRegion ...string) Number {
-	defaultPrefix := ""
-	if len(defaultRegion) > 0 {
-		defaultPrefix = defaultRegion[0]
+	if err != nil {
+		return nil, err
 	}
+	return results, nil
+}
+
+func Verify(phone string, opts VerifyOptions) Number {
+	md := activeMetadata()
+
 	num := Number{
 		Phone:         phone,
-		DefaultPrefix: defaultPrefix,
+		DefaultPrefix: opts.DefaultRegion,
 	}
-	num.Verify(true)
+	num.verifyWithOptions(md, opts)
 	return num
 }
 
-func Clean(phone []string, defaultRegion ...string) (VerifiedNumbers, UnverifiedNumbers) {
-	defaultPrefix := ""
-	if len(defaultRegion) > 0 {
-		defaultPrefix = defaultRegion[0]
+func Clean(phone []string, opts VerifyOptions) (VerifiedNumbers, UnverifiedNumbers) {
+	md := activeMetadata()
+
+	nums := Numbers{
+		Phones:        phone,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
+	return nums.clean(md)
+}
+
+func VerifyList(phone []string, opts VerifyOptions) VerifiedNumbers {
+	md := activeMetadata()
+
 	nums := Numbers{
 		Phones:        phone,
-		DefaultPrefix: defaultPrefix,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
-	return nums.Clean()
+	return nums.verify(md)
 }
 
-func VerifyList(phone []string, defaultRegion ...string) VerifiedNumbers {
-	defaultPrefix := ""
-	if len(defaultRegion) > 0 {
-		defaultPrefix = defaultRegion[0]
+func StatsByCarrier(phone []string, opts VerifyOptions) CarrierStats {
+	md := activeMetadata()
+
+	nums := Numbers{
+		Phones:        phone,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
+	return nums.statsByCarrier(md)
+}
+
+func StatsByCountry(phone []string, opts VerifyOptions) CountryStats {
+	md := activeMetadata()
+
 	nums := Numbers{
 		Phones:        phone,
-		DefaultPrefix: defaultPrefix,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
-	return nums.Verify()
+	return nums.statsByCountry(md)
 }
 
-func StatsByCarrier(phone []string, defaultRegion ...string) CarrierStats {
-	defaultPrefix := ""
-	if len(defaultRegion) > 0 {
-		defaultPrefix = defaultRegion[0]
+// VerifyContext verifies phones with opts, returning them in input order. If
+// ctx is cancelled or its deadline passes, it stops early and returns ctx's
+// error.
+func VerifyContext(ctx context.Context, phone []string, opts VerifyOptions) (VerifiedNumbers, error) {
+	md := activeMetadata()
+
+	nums := Numbers{
+		Phones:        phone,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
+	return nums.verifyContext(ctx, md)
+}
+
+func CleanContext(ctx context.Context, phone []string, opts VerifyOptions) (VerifiedNumbers, UnverifiedNumbers, error) {
+	md := activeMetadata()
+
 	nums := Numbers{
 		Phones:        phone,
-		DefaultPrefix: defaultPrefix,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
-	return nums.StatsByCarrier()
+	return nums.cleanContext(ctx, md)
 }
 
-func StatsByCountry(phone []string, defaultRegion ...string) CountryStats {
-	defaultPrefix := ""
-	if len(defaultRegion) > 0 {
-		defaultPrefix = defaultRegion[0]
+func StatsByCarrierContext(ctx context.Context, phone []string, opts VerifyOptions) (CarrierStats, error) {
+	md := activeMetadata()
+
+	nums := Numbers{
+		Phones:        phone,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
+	return nums.statsByCarrierContext(ctx, md)
+}
+
+func StatsByCountryContext(ctx context.Context, phone []string, opts VerifyOptions) (CountryStats, error) {
+	md := activeMetadata()
+
 	nums := Numbers{
 		Phones:        phone,
-		DefaultPrefix: defaultPrefix,
+		DefaultPrefix: opts.DefaultRegion,
+		Options:       opts,
 	}
-	return nums.StatsByCountry()
+	return nums.statsByCountryContext(ctx, md)
+}
+
+// VerifyStream verifies phones read from in with opts, sending the results to
+// the returned channel in the order they were read with Index set to their
+// position in the stream. At most opts.Workers phones are verified at once and
+// no more are read from in until the caller has received the earliest of them,
+// so memory use stays bounded however long the stream is. The returned channel
+// is closed once in is closed and all its phones sent, or when ctx is done, in
+// which case ctx.Err() says why the stream ended early.
+func VerifyStream(ctx context.Context, in <-chan string, opts VerifyOptions) <-chan Number {
+	md := activeMetadata()
+
+	type streamJob struct {
+		num    Number
+		result chan Number
+	}
+
+	out := make(chan Number)
+	jobs := make(chan streamJob)
+	// results of the jobs in flight, in input order
+	pending := make(chan chan Number, opts.workers())
+
+	for w := 0; w < opts.workers(); w++ {
+		go func() {
+			for job := range jobs {
+				job.num.verifyWithOptions(md, opts)
+				job.result <- job.num
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		defer close(pending)
+
+		for index := 0; ; index++ {
+			var phone string
+			var ok bool
+			select {
+			case phone, ok = <-in:
+				if !ok {
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+
+			job := streamJob{num: Number{Index: index, Phone: phone}, result: make(chan Number, 1)}
+			select {
+			case pending <- job.result:
+			case <-ctx.Done():
+				return
+			}
+			select {
+			case jobs <- job:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	go func() {
+		defer close(out)
+
+		for result := range pending {
+			select {
+			case num := <-result:
+				select {
+				case out <- num:
+				case <-ctx.Done():
+					return
+				}
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return out
 }
 
 func Worker(data map[string]string) bool {
+	md := activeMetadata()
+
 	var num Number
 	num.Phone = data["phone"]
-	num.Verify()
+	num.verify(md)
 	if num.Invalid {
 		return false
 	}
@@ -452,6 +772,9 @@ func Worker(data map[string]string) bool {
 	data["carrier_mnc"] = num.CarrierMnc
 	data["carrier_mcc"] = num.CarrierMcc
 	data["carrier_nnc"] = num.CarrierNnc
+	data["carrier_confidence"] = num.CarrierConfidence
+	data["carrier_ambiguous"] = fmt.Sprintf("%v", num.CarrierAmbiguous)
+	data["carrier_source"] = num.CarrierSource
 	data["dial_code"] = fmt.Sprintf("%v", num.DialCode)
 	data["region"] = num.CountryCode
 	data["currency"] = num.Currency
@@ -3,12 +3,16 @@ package phonenumbers
 import (
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"reflect"
 	"regexp"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"unicode"
+	"unicode/utf8"
 
 	"golang.org/x/text/language"
 	"golang.org/x/text/language/display"
@@ -298,7 +302,7 @@ var (
 	// We remove all characters that are not alpha or numerical characters.
 	// The hash character is retained here, as it may signify the previous
 	// block was an extension.
-	UNWANTED_END_CHARS        = "[[\\P{N}&&\\P{L}]&&[^#]]+$"
+	UNWANTED_END_CHARS        = "[^\\p{N}\\p{L}#]+$"
 	UNWANTED_END_CHAR_PATTERN = regexp.MustCompile(UNWANTED_END_CHARS)
 
 	// We use this pattern to check if the phone number has at least three
@@ -379,6 +383,11 @@ var (
 	VALID_PHONE_NUMBER_PATTERN = regexp.MustCompile(
 		"^(" + VALID_PHONE_NUMBER + "(?:" + EXTN_PATTERNS_FOR_PARSING + ")?)$")
 
+	// Characters which can be part of a number or its extension, used to
+	// find where a number which isn't viable goes wrong.
+	VALID_NUMBER_CHAR_PATTERN = regexp.MustCompile(
+		"^[" + VALID_PUNCTUATION + PLUS_CHARS + string(STAR_SIGN) + VALID_ALPHA + DIGITS + "#;,=:]$")
+
 	NON_DIGITS_PATTERN = regexp.MustCompile(`(\D+)`)
 	DIGITS_PATTERN     = regexp.MustCompile(`(\d+)`)
 
@@ -396,7 +405,7 @@ var (
 	// formatting rule has the first group only, i.e., does not start
 	// with the national prefix. Note that the pattern explicitly allows
 	// for unbalanced parentheses.
-	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`\(?\$1\)?`)
+	FIRST_GROUP_ONLY_PREFIX_PATTERN = regexp.MustCompile(`^\(?\$1\)?$`)
 
 	REGION_CODE_FOR_NON_GEO_ENTITY = "001"
 
@@ -514,38 +523,42 @@ const (
 )
 
 func (l Leniency) Verify(number *PhoneNumber, candidate string) bool {
+	return l.verify(activeMetadata(), number, candidate)
+}
+
+func (l Leniency) verify(md *metadataState, number *PhoneNumber, candidate string) bool {
 
 	switch l {
 	case POSSIBLE:
-		return IsPossibleNumber(number)
+		return isPossibleNumber(md, number)
 	case VALID:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) {
+		if !isValidNumber(md, number) ||
+			!containsOnlyValidXChars(md, number, candidate) {
 			return false
 		}
-		return IsNationalPrefixPresentIfRequired(number)
+		return isNationalPrefixPresentIfRequired(md, number)
 	case STRICT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !isValidNumber(md, number) ||
+			!containsOnlyValidXChars(md, number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!isNationalPrefixPresentIfRequired(md, number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return checkNumberGroupingIsValid(md, number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
-				return AllNumberGroupsRemainGrouped(
-					number, normalizedCandidate, expectedNumberGroups)
+				return allNumberGroupsRemainGrouped(
+					md, number, normalizedCandidate, expectedNumberGroups)
 			})
 	case EXACT_GROUPING:
-		if !IsValidNumber(number) ||
-			!ContainsOnlyValidXChars(number, candidate) ||
+		if !isValidNumber(md, number) ||
+			!containsOnlyValidXChars(md, number, candidate) ||
 			ContainsMoreThanOneSlashInNationalNumber(number, candidate) ||
-			!IsNationalPrefixPresentIfRequired(number) {
+			!isNationalPrefixPresentIfRequired(md, number) {
 			return false
 		}
-		return CheckNumberGroupingIsValid(number, candidate,
+		return checkNumberGroupingIsValid(md, number, candidate,
 			func(number *PhoneNumber,
 				normalizedCandidate string,
 				expectedNumberGroups []string) bool {
@@ -560,57 +573,72 @@ var (
 	// golang map is not go routine safe. Sometimes process exiting
 	// because of panic. So adding mutex to synchronize the operation.
 
+	// A cache for frequently used region-specific regular expressions.
+	// The initial capacity is set to 100 as this seems to be an optimal
+	// value for Android, based on performance measurements.
+	regexCache    = make(map[string]*regexp.Regexp)
+	regCacheMutex sync.RWMutex
+
+	// These are our onces and maps for our prefix to carrier maps
+	carrierOnces     = make(map[string]*sync.Once)
+	carrierPrefixMap = make(map[string]*intStringMap)
+
+	// These are our onces and maps for our prefix to geocoding maps
+	geocodingOnces     = make(map[string]*sync.Once)
+	geocodingPrefixMap = make(map[string]*intStringMap)
+
+	// Our once and map for prefix to timezone lookups
+	timezoneOnce sync.Once
+	timezoneMap  *intStringArrayMap
+
+	// The metadata we are currently using, swapped as a whole by LoadMetadata
+	currentMetadata atomic.Pointer[metadataState]
+)
+
+// metadataState is the metadata of every region and non-geographical entity, along with the maps
+// we derive from it. It is never modified once built, so it can be read from any goroutine while
+// LoadMetadata swaps in a new one.
+type metadataState struct {
+	// the metadata as loaded, before region overrides and custom regions are applied, and
+	// the metadata we use with them applied
+	base                    *PhoneMetadataCollection
+	baseCountryCodeToRegion map[int][]string
+	collection              *PhoneMetadataCollection
+
 	// The set of regions that share country calling code 1.
 	// There are roughly 26 regions.
-	nanpaRegions = make(map[string]struct{})
+	nanpaRegions map[string]struct{}
 
 	// A mapping from a region code to the PhoneMetadata for that region.
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	regionToMetadataMap = make(map[string]*PhoneMetadata)
+	regionToMetadataMap map[string]*PhoneMetadata
 
 	// A mapping from a country calling code for a non-geographical
 	// entity to the PhoneMetadata for that country calling code.
 	// Examples of the country calling codes include 800 (International
 	// Toll Free Service) and 808 (International Shared Cost Service).
-	// Note: Synchronization, though only needed for the Android version
-	// of the library, is used in all versions for consistency.
-	countryCodeToNonGeographicalMetadataMap = make(map[int]*PhoneMetadata)
-
-	// A cache for frequently used region-specific regular expressions.
-	// The initial capacity is set to 100 as this seems to be an optimal
-	// value for Android, based on performance measurements.
-	regexCache    = make(map[string]*regexp.Regexp)
-	regCacheMutex sync.RWMutex
+	countryCodeToNonGeographicalMetadataMap map[int]*PhoneMetadata
 
 	// The set of regions the library supports.
 	// There are roughly 240 of them and we set the initial capacity of
 	// the HashSet to 320 to offer a load factor of roughly 0.75.
-	supportedRegions = make(map[string]bool, 320)
+	supportedRegions map[string]bool
 
 	// The set of calling codes that map to the non-geo entity
 	// region ("001"). This set currently contains < 12 elements so the
 	// default capacity of 16 (load factor=0.75) is fine.
-	countryCodesForNonGeographicalRegion = make(map[int]bool, 16)
-
-	// These are our onces and maps for our prefix to carrier maps
-	carrierOnces     = make(map[string]*sync.Once)
-	carrierPrefixMap = make(map[string]*intStringMap)
-
-	// These are our onces and maps for our prefix to geocoding maps
-	geocodingOnces     = make(map[string]*sync.Once)
-	geocodingPrefixMap = make(map[string]*intStringMap)
+	countryCodesForNonGeographicalRegion map[int]bool
 
 	// All the calling codes we support
-	supportedCallingCodes = make(map[int]bool, 320)
-
-	// Our once and map for prefix to timezone lookups
-	timezoneOnce sync.Once
-	timezoneMap  *intStringArrayMap
+	supportedCallingCodes map[int]bool
 
 	// Our map from country code (as integer) to two letter region codes
 	countryCodeToRegion map[int][]string
-)
+}
+
+// returns the metadata we are currently using
+func activeMetadata() *metadataState {
+	return currentMetadata.Load()
+}
 
 var ErrEmptyMetadata = errors.New("empty metadata")
 
@@ -636,71 +664,96 @@ func regexFor(pattern string) *regexp.Regexp {
 	return regex
 }
 
-func readFromNanpaRegions(key string) (struct{}, bool) {
-	v, ok := nanpaRegions[key]
+func readFromNanpaRegions(md *metadataState, key string) (struct{}, bool) {
+	v, ok := md.nanpaRegions[key]
 	return v, ok
 }
 
-func writeToNanpaRegions(key string, val struct{}) {
-	nanpaRegions[key] = val
-}
-
-func readFromRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
-	v, ok := regionToMetadataMap[key]
+func readFromRegionToMetadataMap(md *metadataState, key string) (*PhoneMetadata, bool) {
+	v, ok := md.regionToMetadataMap[key]
 	return v, ok
 }
 
-func writeToRegionToMetadataMap(key string, val *PhoneMetadata) {
-	regionToMetadataMap[key] = val
-}
-
-func readFromCountryCodeToNonGeographicalMetadataMap(key int) (*PhoneMetadata, bool) {
-	v, ok := countryCodeToNonGeographicalMetadataMap[key]
+func readFromCountryCodeToNonGeographicalMetadataMap(md *metadataState, key int) (*PhoneMetadata, bool) {
+	v, ok := md.countryCodeToNonGeographicalMetadataMap[key]
 	return v, ok
 }
 
-func writeToCountryCodeToNonGeographicalMetadataMap(key int, v *PhoneMetadata) {
-	countryCodeToNonGeographicalMetadataMap[key] = v
-}
-
-func loadMetadataFromFile(
-	regionCode string,
-	countryCallingCode int) error {
+// builds the metadata state for the passed in collection, baseCountryCodeToRegion mapping each
+// country calling code to its regions as BuildCountryCodeToRegionMap does. Registered region
+// overrides and custom regions are applied on top, so the caller must hold metadataRegistryMutex.
+func newMetadataState(baseCollection *PhoneMetadataCollection, baseCountryCodeToRegion map[int][]string) (*metadataState, error) {
+	if len(baseCollection.GetMetadata()) == 0 {
+		return nil, ErrEmptyMetadata
+	}
 
-	metadataCollection, err := MetadataCollection()
+	metadataList, countryCodeToRegion, err := applyMetadataRegistry(baseCollection.GetMetadata(), baseCountryCodeToRegion)
 	if err != nil {
-		return err
-	} else if currMetadataColl == nil {
-		currMetadataColl = metadataCollection
+		return nil, err
 	}
 
-	metadataList := metadataCollection.GetMetadata()
-	if len(metadataList) == 0 {
-		return ErrEmptyMetadata
+	state := &metadataState{
+		base:                                    baseCollection,
+		baseCountryCodeToRegion:                 baseCountryCodeToRegion,
+		collection:                              &PhoneMetadataCollection{Metadata: metadataList},
+		nanpaRegions:                            make(map[string]struct{}),
+		regionToMetadataMap:                     make(map[string]*PhoneMetadata),
+		countryCodeToNonGeographicalMetadataMap: make(map[int]*PhoneMetadata),
+		supportedRegions:                        make(map[string]bool, 320),
+		countryCodesForNonGeographicalRegion:    make(map[int]bool, 16),
+		supportedCallingCodes:                   make(map[int]bool, 320),
+		countryCodeToRegion:                     countryCodeToRegion,
 	}
 
 	for _, meta := range metadataList {
 		region := meta.GetId()
 		if region == "001" {
 			// it's a non geographical entity
-			writeToCountryCodeToNonGeographicalMetadataMap(int(meta.GetCountryCode()), meta)
+			state.countryCodeToNonGeographicalMetadataMap[int(meta.GetCountryCode())] = meta
 		} else {
-			writeToRegionToMetadataMap(region, meta)
+			state.regionToMetadataMap[region] = meta
 		}
 	}
-	return nil
-}
 
-var (
-	currMetadataColl *PhoneMetadataCollection
-	reloadMetadata   = true
-)
+	for eKey, regionCodes := range countryCodeToRegion {
+		// We can assume that if the county calling code maps to the
+		// non-geo entity region code then that's the only region code
+		// it maps to.
+		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
+			// This is the subset of all country codes that map to the
+			// non-geo entity region code.
+			state.countryCodesForNonGeographicalRegion[eKey] = true
+		} else {
+			// The supported regions set does not include the "001"
+			// non-geo entity region code.
+			for _, val := range regionCodes {
+				state.supportedRegions[val] = true
+			}
+		}
 
-func MetadataCollection() (*PhoneMetadataCollection, error) {
-	if !reloadMetadata {
-		return currMetadataColl, nil
+		state.supportedCallingCodes[eKey] = true
 	}
+	// If the non-geo entity still got added to the set of supported
+	// regions it must be because there are entries that list the non-geo
+	// entity alongside normal regions (which is wrong). If we discover
+	// this, remove the non-geo entity from the set of supported regions
+	// and log (or not log).
+	delete(state.supportedRegions, REGION_CODE_FOR_NON_GEO_ENTITY)
+
+	for _, val := range countryCodeToRegion[NANPA_COUNTRY_CODE] {
+		state.nanpaRegions[val] = struct{}{}
+	}
+	return state, nil
+}
 
+// MetadataCollection returns the metadata we are currently using, either the embedded metadata
+// or that last passed to LoadMetadata, with any region overrides and custom regions applied.
+func MetadataCollection() (*PhoneMetadataCollection, error) {
+	return activeMetadata().collection, nil
+}
+
+// loads the metadata embedded in gen.NumberData
+func loadEmbeddedMetadata() (*PhoneMetadataCollection, error) {
 	rawBytes, err := decodeUnzipString(gen.NumberData)
 	if err != nil {
 		return nil, err
@@ -708,10 +761,59 @@ func MetadataCollection() (*PhoneMetadataCollection, error) {
 
 	var metadataCollection = &PhoneMetadataCollection{}
 	err = proto.Unmarshal(rawBytes, metadataCollection)
-	reloadMetadata = false
 	return metadataCollection, err
 }
 
+// MetadataFormat is the format of the metadata passed to LoadMetadata.
+type MetadataFormat int
+
+const (
+	// The PhoneNumberMetadata.xml file of a libphonenumber release.
+	METADATA_FORMAT_XML MetadataFormat = iota
+	// A PhoneMetadataCollection serialized as protobuf, as cmd/buildmetadata embeds it.
+	METADATA_FORMAT_PROTOBUF
+)
+
+// LoadMetadata replaces the metadata of every region and non-geographical entity with that read
+// from r, so a new libphonenumber data release can be picked up without a rebuild. The metadata
+// of every region and the mapping of calling codes to regions are swapped together, atomically,
+// and every call such as Parse or Format uses the metadata it started with throughout, so one
+// running while LoadMetadata swaps it sees either the old or the new metadata, never some of
+// both. Calls made once LoadMetadata returns use the new metadata. An AsYouTypeFormatter or
+// PhoneNumberMatcher uses the metadata current at each call of its methods. Registered region
+// overrides and custom regions are applied to the new metadata too. Short number, carrier,
+// geocoding and timezone data are not affected.
+func LoadMetadata(r io.Reader, format MetadataFormat) error {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return fmt.Errorf("error reading metadata: %w", err)
+	}
+
+	var metadataCollection *PhoneMetadataCollection
+	switch format {
+	case METADATA_FORMAT_XML:
+		metadataCollection, err = BuildPhoneMetadataCollection(data, false, false, false)
+	case METADATA_FORMAT_PROTOBUF:
+		metadataCollection = &PhoneMetadataCollection{}
+		err = proto.Unmarshal(data, metadataCollection)
+	default:
+		return fmt.Errorf("unknown metadata format: %d", format)
+	}
+	if err != nil {
+		return fmt.Errorf("error parsing metadata: %w", err)
+	}
+
+	metadataRegistryMutex.Lock()
+	defer metadataRegistryMutex.Unlock()
+
+	state, err := newMetadataState(metadataCollection, BuildCountryCodeToRegionMap(metadataCollection))
+	if err != nil {
+		return err
+	}
+	currentMetadata.Store(state)
+	return nil
+}
+
 // Attempts to extract a possible number from the string passed in.
 // This currently strips all leading characters that cannot be used to
 // start a phone number. Characters that can be used to start a phone
@@ -889,7 +991,9 @@ func ConvertAlphaCharactersInNumber(number string) string {
 //     non-geographical entities
 //   - some geographical numbers have no area codes.
 func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+	md := activeMetadata()
+
+	metadata := getMetadataForRegion(md, getRegionCodeForNumber(md, number))
 	if metadata == nil {
 		return 0
 	}
@@ -901,11 +1005,11 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 		return 0
 	}
 
-	if !isNumberGeographical(number) {
+	if !isNumberGeographical(md, number) {
 		return 0
 	}
 
-	return GetLengthOfNationalDestinationCode(number)
+	return getLengthOfNationalDestinationCode(md, number)
 }
 
 // Gets the length of the national destination code (NDC) from the
@@ -937,6 +1041,10 @@ func GetLengthOfGeographicalAreaCode(number *PhoneNumber) int {
 // Refer to the unittests to see the difference between this function and
 // GetLengthOfGeographicalAreaCode().
 func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
+	return getLengthOfNationalDestinationCode(activeMetadata(), number)
+}
+
+func getLengthOfNationalDestinationCode(md *metadataState, number *PhoneNumber) int {
 	var copiedProto *PhoneNumber
 	if len(number.GetExtension()) > 0 {
 		// We don't want to alter the proto given to us, but we don't
@@ -949,7 +1057,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 		copiedProto = number
 	}
 
-	nationalSignificantNumber := Format(copiedProto, INTERNATIONAL)
+	nationalSignificantNumber := format(md, copiedProto, INTERNATIONAL)
 	numberGroups := NON_DIGITS_PATTERN.Split(nationalSignificantNumber, -1)
 
 	// The pattern will start with "+COUNTRY_CODE " so the first group
@@ -959,7 +1067,7 @@ func GetLengthOfNationalDestinationCode(number *PhoneNumber) int {
 	if len(numberGroups) <= 3 {
 		return 0
 	}
-	if GetNumberType(number) == MOBILE {
+	if getNumberType(md, number) == MOBILE {
 		// For example Argentinian mobile numbers, when formatted in
 		// the international format, are in the form of +54 9 NDC XXXX....
 		// As a result, we take the length of the third group (NDC) and
@@ -1008,7 +1116,7 @@ func normalizeHelper(number string,
 
 // GetSupportedRegions returns all regions the library has metadata for.
 func GetSupportedRegions() map[string]bool {
-	return supportedRegions
+	return activeMetadata().supportedRegions
 }
 
 // GetSupportedCallingCodes returns all country calling codes the library has metadata for, covering both non-geographical
@@ -1016,12 +1124,12 @@ func GetSupportedRegions() map[string]bool {
 // used to populate a drop-down box of country calling codes for a phone-number widget, for
 // instance.
 func GetSupportedCallingCodes() map[int]bool {
-	return supportedCallingCodes
+	return activeMetadata().supportedCallingCodes
 }
 
 // GetSupportedGlobalNetworkCallingCodes returns all global network calling codes the library has metadata for.
 func GetSupportedGlobalNetworkCallingCodes() map[int]bool {
-	return countryCodesForNonGeographicalRegion
+	return activeMetadata().countryCodesForNonGeographicalRegion
 }
 
 // Helper function to check if the national prefix formatting rule has the
@@ -1040,8 +1148,8 @@ func formattingRuleHasFirstGroupOnly(nationalPrefixFormattingRule string) bool {
 // overlap for geocodable and non-geocodable numbers. Also, if new phone
 // number types were added, we should check if this other method should be
 // updated too.
-func isNumberGeographical(phoneNumber *PhoneNumber) bool {
-	numberType := GetNumberType(phoneNumber)
+func isNumberGeographical(md *metadataState, phoneNumber *PhoneNumber) bool {
+	numberType := getNumberType(md, phoneNumber)
 	// TODO: Include mobile phone numbers from countries like Indonesia,
 	// which has some mobile numbers that are geographical.
 	return numberType == FIXED_LINE ||
@@ -1049,14 +1157,14 @@ func isNumberGeographical(phoneNumber *PhoneNumber) bool {
 }
 
 // Helper function to check region code is not unknown or null.
-func isValidRegionCode(regionCode string) bool {
-	valid := supportedRegions[regionCode]
+func isValidRegionCode(md *metadataState, regionCode string) bool {
+	valid := md.supportedRegions[regionCode]
 	return len(regionCode) != 0 && valid
 }
 
 // Helper function to check the country calling code is valid.
-func hasValidCountryCallingCode(countryCallingCode int) bool {
-	_, containsKey := countryCodeToRegion[countryCallingCode]
+func hasValidCountryCallingCode(md *metadataState, countryCallingCode int) bool {
+	_, containsKey := md.countryCodeToRegion[countryCallingCode]
 	return containsKey
 }
 
@@ -1071,6 +1179,10 @@ func hasValidCountryCallingCode(countryCallingCode int) bool {
 // formatting rules to apply so we return the national significant number
 // with no formatting applied.
 func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
+	return format(activeMetadata(), number, numberFormat)
+}
+
+func format(md *metadataState, number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 	if number.GetNationalNumber() == 0 && len(number.GetRawInput()) > 0 {
 		// Unparseable numbers that kept their raw input just use that.
 		// This is the only case where a number can be formatted as E164
@@ -1084,7 +1196,7 @@ func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 		}
 	}
 	var formattedNumber = NewBuilder(nil)
-	FormatWithBuf(number, numberFormat, formattedNumber)
+	formatWithBuf(md, number, numberFormat, formattedNumber)
 	return formattedNumber.String()
 }
 
@@ -1092,6 +1204,10 @@ func Format(number *PhoneNumber, numberFormat PhoneNumberFormat) string {
 // StringBuilder as a parameter to decrease object creation when invoked
 // many times.
 func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formattedNumber *Builder) {
+	formatWithBuf(activeMetadata(), number, numberFormat, formattedNumber)
+}
+
+func formatWithBuf(md *metadataState, number *PhoneNumber, numberFormat PhoneNumberFormat, formattedNumber *Builder) {
 	// Clear the StringBuilder first.
 	formattedNumber.Reset()
 	countryCallingCode := int(number.GetCountryCode())
@@ -1104,7 +1220,7 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 		formattedNumber.WriteString(nationalSignificantNumber)
 		prefixNumberWithCountryCallingCode(countryCallingCode, E164, formattedNumber)
 		return
-	} else if !hasValidCountryCallingCode(countryCallingCode) {
+	} else if !hasValidCountryCallingCode(md, countryCallingCode) {
 		formattedNumber.WriteString(nationalSignificantNumber)
 		return
 	}
@@ -1112,12 +1228,12 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCallingCode)
 
 	// Metadata cannot be null because the country calling code is
 	// valid (which means that the region code cannot be ZZ and must
 	// be one of our supported region codes).
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := getMetadataForRegionOrCallingCode(md, countryCallingCode, regionCode)
 
 	formattedNumber.WriteString(formatNsn(nationalSignificantNumber, metadata, numberFormat))
 	maybeAppendFormattedExtension(number, metadata, numberFormat, formattedNumber)
@@ -1133,19 +1249,25 @@ func FormatWithBuf(number *PhoneNumber, numberFormat PhoneNumberFormat, formatte
 func FormatByPattern(number *PhoneNumber,
 	numberFormat PhoneNumberFormat,
 	userDefinedFormats []*NumberFormat) string {
+	return formatByPattern(activeMetadata(), number, numberFormat, userDefinedFormats)
+}
+
+func formatByPattern(md *metadataState, number *PhoneNumber,
+	numberFormat PhoneNumberFormat,
+	userDefinedFormats []*NumberFormat) string {
 
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !hasValidCountryCallingCode(md, countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For example,
 	// for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := getMetadataForRegionOrCallingCode(md, countryCallingCode, regionCode)
 
 	formattedNumber := NewBuilder(nil)
 
@@ -1196,18 +1318,22 @@ func FormatByPattern(number *PhoneNumber,
 // carrier code stored. If carrierCode contains an empty string, returns
 // the number in national format without any carrier code.
 func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string) string {
+	return formatNationalNumberWithCarrierCode(activeMetadata(), number, carrierCode)
+}
+
+func formatNationalNumberWithCarrierCode(md *metadataState, number *PhoneNumber, carrierCode string) string {
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !hasValidCountryCallingCode(md, countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	// Note GetRegionCodeForCountryCode() is used because formatting
 	// information for regions which share a country calling code is
 	// contained by only one region for performance reasons. For
 	// example, for NANPA regions it will be contained in the metadata for US.
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
-	metadata := getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+	metadata := getMetadataForRegionOrCallingCode(md, countryCallingCode, regionCode)
 
 	formattedNumber := NewBuilder(nil)
 	formattedNumber.WriteString(
@@ -1224,11 +1350,11 @@ func FormatNationalNumberWithCarrierCode(number *PhoneNumber, carrierCode string
 	return formattedNumber.String()
 }
 
-func getMetadataForRegionOrCallingCode(countryCallingCode int, regionCode string) *PhoneMetadata {
+func getMetadataForRegionOrCallingCode(md *metadataState, countryCallingCode int, regionCode string) *PhoneMetadata {
 	if REGION_CODE_FOR_NON_GEO_ENTITY == regionCode {
-		return getMetadataForNonGeographicalRegion(countryCallingCode)
+		return getMetadataForNonGeographicalRegion(md, countryCallingCode)
 	}
-	return getMetadataForRegion(regionCode)
+	return getMetadataForRegion(md, regionCode)
 }
 
 // Formats a phone number in national format for dialing using the carrier
@@ -1244,12 +1370,19 @@ func getMetadataForRegionOrCallingCode(countryCallingCode int, regionCode string
 func FormatNationalNumberWithPreferredCarrierCode(
 	number *PhoneNumber,
 	fallbackCarrierCode string) string {
+	return formatNationalNumberWithPreferredCarrierCode(activeMetadata(), number, fallbackCarrierCode)
+}
+
+func formatNationalNumberWithPreferredCarrierCode(
+	md *metadataState,
+	number *PhoneNumber,
+	fallbackCarrierCode string) string {
 
 	pref := number.GetPreferredDomesticCarrierCode()
 	if number.GetPreferredDomesticCarrierCode() == "" {
 		pref = fallbackCarrierCode
 	}
-	return FormatNationalNumberWithCarrierCode(number, pref)
+	return formatNationalNumberWithCarrierCode(md, number, pref)
 }
 
 // Returns a number formatted in such a way that it can be dialed from a
@@ -1260,9 +1393,10 @@ func FormatNumberForMobileDialing(
 	number *PhoneNumber,
 	regionCallingFrom string,
 	withFormatting bool) string {
+	md := activeMetadata()
 
 	countryCallingCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !hasValidCountryCallingCode(md, countryCallingCode) {
 		return number.GetRawInput() // go impl defaults to ""
 	}
 
@@ -1272,8 +1406,8 @@ func FormatNumberForMobileDialing(
 	var numberNoExt = &PhoneNumber{}
 	proto.Merge(numberNoExt, number)
 	numberNoExt.Extension = nil // can we assume this is safe? (no nil-pointer?)
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
-	numberType := GetNumberType(numberNoExt)
+	regionCode := getRegionCodeForCountryCode(md, countryCallingCode)
+	numberType := getNumberType(md, numberNoExt)
 	isValidNumber := numberType != UNKNOWN
 	if regionCallingFrom == regionCode {
 		isFixedLineOrMobile :=
@@ -1283,12 +1417,12 @@ func FormatNumberForMobileDialing(
 		// Carrier codes may be needed in some countries. We handle this here.
 		if regionCode == "CO" && numberType == FIXED_LINE {
 			formattedNumber =
-				FormatNationalNumberWithCarrierCode(
-					numberNoExt, COLOMBIA_MOBILE_TO_FIXED_LINE_PREFIX)
+				formatNationalNumberWithCarrierCode(
+					md, numberNoExt, COLOMBIA_MOBILE_TO_FIXED_LINE_PREFIX)
 		} else if regionCode == "BR" && isFixedLineOrMobile {
 			if numberNoExt.GetPreferredDomesticCarrierCode() != "" {
 				formattedNumber =
-					FormatNationalNumberWithPreferredCarrierCode(numberNoExt, "")
+					formatNationalNumberWithPreferredCarrierCode(md, numberNoExt, "")
 			} else {
 				// Brazilian fixed line and mobile numbers need to be dialed
 				// with a carrier code when called within Brazil. Without
@@ -1304,18 +1438,18 @@ func FormatNumberForMobileDialing(
 			// result, we add it back here
 			// if it is a valid regular length phone number.
 			formattedNumber =
-				GetNddPrefixForRegion(regionCode, true /* strip non-digits */) +
-					" " + Format(numberNoExt, NATIONAL)
+				getNddPrefixForRegion(md, regionCode, true /* strip non-digits */) +
+					" " + format(md, numberNoExt, NATIONAL)
 		} else if countryCallingCode == NANPA_COUNTRY_CODE {
 			// For NANPA countries, we output international format for
 			// numbers that can be dialed internationally, since that
 			// always works, except for numbers which might potentially be
 			// short numbers, which are always dialled in national format.
-			regionMetadata := getMetadataForRegion(regionCallingFrom)
-			if canBeInternationallyDialled(numberNoExt) && testNumberLength(GetNationalSignificantNumber(numberNoExt), regionMetadata, UNKNOWN) != TOO_SHORT {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+			regionMetadata := getMetadataForRegion(md, regionCallingFrom)
+			if canBeInternationallyDialled(md, numberNoExt) && testNumberLength(GetNationalSignificantNumber(numberNoExt), regionMetadata, UNKNOWN) != TOO_SHORT {
+				formattedNumber = format(md, numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = format(md, numberNoExt, NATIONAL)
 			}
 		} else {
 			// For non-geographical countries, and Mexican and Chilean fixed
@@ -1337,21 +1471,21 @@ func FormatNumberForMobileDialing(
 			if regionCode == REGION_CODE_FOR_NON_GEO_ENTITY ||
 				((regionCode == "MX" || regionCode == "CL" || regionCode == "UZ") &&
 					isFixedLineOrMobile) &&
-					canBeInternationallyDialled(numberNoExt) {
-				formattedNumber = Format(numberNoExt, INTERNATIONAL)
+					canBeInternationallyDialled(md, numberNoExt) {
+				formattedNumber = format(md, numberNoExt, INTERNATIONAL)
 			} else {
-				formattedNumber = Format(numberNoExt, NATIONAL)
+				formattedNumber = format(md, numberNoExt, NATIONAL)
 			}
 		}
-	} else if isValidNumber && canBeInternationallyDialled(numberNoExt) {
+	} else if isValidNumber && canBeInternationallyDialled(md, numberNoExt) {
 		// We assume that short numbers are not diallable from outside
 		// their region, so if a number is not a valid regular length
 		// phone number, we treat it as if it cannot be internationally
 		// dialled.
 		if withFormatting {
-			return Format(numberNoExt, INTERNATIONAL)
+			return format(md, numberNoExt, INTERNATIONAL)
 		}
-		return Format(numberNoExt, E164)
+		return format(md, numberNoExt, E164)
 	}
 	if withFormatting {
 		return formattedNumber
@@ -1377,22 +1511,29 @@ func FormatNumberForMobileDialing(
 func FormatOutOfCountryCallingNumber(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
+	return formatOutOfCountryCallingNumber(activeMetadata(), number, regionCallingFrom)
+}
+
+func formatOutOfCountryCallingNumber(
+	md *metadataState,
+	number *PhoneNumber,
+	regionCallingFrom string) string {
 
-	if !isValidRegionCode(regionCallingFrom) {
-		return Format(number, INTERNATIONAL)
+	if !isValidRegionCode(md, regionCallingFrom) {
+		return format(md, number, INTERNATIONAL)
 	}
 	countryCallingCode := int(number.GetCountryCode())
 	nationalSignificantNumber := GetNationalSignificantNumber(number)
-	if !hasValidCountryCallingCode(countryCallingCode) {
+	if !hasValidCountryCallingCode(md, countryCallingCode) {
 		return nationalSignificantNumber
 	}
 	if countryCallingCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if isNANPACountry(md, regionCallingFrom) {
 			// For NANPA regions, return the national format for these
 			// regions but prefix it with the country calling code.
-			return strconv.Itoa(countryCallingCode) + " " + Format(number, NATIONAL)
+			return strconv.Itoa(countryCallingCode) + " " + format(md, number, NATIONAL)
 		}
-	} else if countryCallingCode == getCountryCodeForValidRegion(regionCallingFrom) {
+	} else if countryCallingCode == getCountryCodeForValidRegion(md, regionCallingFrom) {
 		// If regions share a country calling code, the country calling
 		// code need not be dialled. This also applies when dialling
 		// within a region, so this if clause covers both these cases.
@@ -1402,10 +1543,10 @@ func FormatOutOfCountryCallingNumber(
 		// case for now and for those cases return the version including
 		// country calling code.
 		// Details here: http://www.petitfute.com/voyage/225-info-pratiques-reunion
-		return Format(number, NATIONAL)
+		return format(md, number, NATIONAL)
 	}
 	// Metadata cannot be null because we checked 'isValidRegionCode()' above.
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := getMetadataForRegion(md, regionCallingFrom)
 	internationalPrefix := metadataForRegionCallingFrom.GetInternationalPrefix()
 
 	// For regions that have multiple international prefixes, the
@@ -1419,10 +1560,10 @@ func FormatOutOfCountryCallingNumber(
 		internationalPrefixForFormatting = metPref
 	}
 
-	regionCode := GetRegionCodeForCountryCode(countryCallingCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCallingCode)
 	// Metadata cannot be null because the country calling code is valid.
 	metadataForRegion :=
-		getMetadataForRegionOrCallingCode(countryCallingCode, regionCode)
+		getMetadataForRegionOrCallingCode(md, countryCallingCode, regionCode)
 	formattedNationalNumber :=
 		formatNsn(
 			nationalSignificantNumber, metadataForRegion, INTERNATIONAL)
@@ -1450,33 +1591,35 @@ func FormatOutOfCountryCallingNumber(
 // Note this method guarantees no digit will be inserted, removed or
 // modified as a result of formatting.
 func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) string {
+	md := activeMetadata()
+
 	rawInput := number.GetRawInput()
-	if len(rawInput) == 0 && !hasFormattingPatternForNumber(number) {
+	if len(rawInput) == 0 && !hasFormattingPatternForNumber(md, number) {
 		// We check if we have the formatting pattern because without that, we might format the number
 		// as a group without national prefix.
 		return rawInput
 	}
 	if number.GetCountryCodeSource() == 0 {
-		return Format(number, NATIONAL)
+		return format(md, number, NATIONAL)
 	}
 	var formattedNumber string
 	switch number.GetCountryCodeSource() {
 	case PhoneNumber_FROM_NUMBER_WITH_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)
+		formattedNumber = format(md, number, INTERNATIONAL)
 	case PhoneNumber_FROM_NUMBER_WITH_IDD:
-		formattedNumber = FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		formattedNumber = formatOutOfCountryCallingNumber(md, number, regionCallingFrom)
 	case PhoneNumber_FROM_NUMBER_WITHOUT_PLUS_SIGN:
-		formattedNumber = Format(number, INTERNATIONAL)[1:]
+		formattedNumber = format(md, number, INTERNATIONAL)[1:]
 	case PhoneNumber_FROM_DEFAULT_COUNTRY:
 		// Fall-through to default case.
 		fallthrough
 	default:
-		regionCode := GetRegionCodeForCountryCode(int(number.GetCountryCode()))
+		regionCode := getRegionCodeForCountryCode(md, int(number.GetCountryCode()))
 		// We strip non-digits from the NDD here, and from the raw
 		// input later, so that we can compare them easily.
-		nationalPrefix := GetNddPrefixForRegion(
-			regionCode, true /* strip non-digits */)
-		nationalFormat := Format(number, NATIONAL)
+		nationalPrefix := getNddPrefixForRegion(
+			md, regionCode, true /* strip non-digits */)
+		nationalFormat := format(md, number, NATIONAL)
 		if len(nationalPrefix) == 0 {
 			// If the region doesn't have a national prefix at all,
 			// we can safely return the national format without worrying
@@ -1486,14 +1629,14 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		}
 		// Otherwise, we check if the original number was entered with
 		// a national prefix.
-		if rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode) {
+		if rawInputContainsNationalPrefix(md, rawInput, nationalPrefix, regionCode) {
 			// If so, we can safely return the national format.
 			formattedNumber = nationalFormat
 			break
 		}
 		// Metadata cannot be null here because GetNddPrefixForRegion()
 		// (above) returns null if there is no metadata for the region.
-		metadata := getMetadataForRegion(regionCode)
+		metadata := getMetadataForRegion(md, regionCode)
 		nationalNumber := GetNationalSignificantNumber(number)
 		formatRule :=
 			chooseFormattingPatternForNumber(metadata.GetNumberFormat(), nationalNumber)
@@ -1531,7 +1674,7 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 		proto.Merge(numFormatCopy, formatRule)
 		numFormatCopy.NationalPrefixFormattingRule = nil
 		var numberFormats = []*NumberFormat{numFormatCopy}
-		formattedNumber = FormatByPattern(number, NATIONAL, numberFormats)
+		formattedNumber = formatByPattern(md, number, NATIONAL, numberFormats)
 	}
 	rawInput = number.GetRawInput()
 	// If no digit is inserted/removed/modified as a result of our
@@ -1550,7 +1693,7 @@ func FormatInOriginalFormat(number *PhoneNumber, regionCallingFrom string) strin
 // Check if rawInput, which is assumed to be in the national format, has
 // a national prefix. The national prefix is assumed to be in digits-only
 // form.
-func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string) bool {
+func rawInputContainsNationalPrefix(md *metadataState, rawInput, nationalPrefix, regionCode string) bool {
 	normalizedNationalNumber := NormalizeDigitsOnly(rawInput)
 	if strings.HasPrefix(normalizedNationalNumber, nationalPrefix) {
 		// Some Japanese numbers (e.g. 00777123) might be mistaken to
@@ -1558,21 +1701,21 @@ func rawInputContainsNationalPrefix(rawInput, nationalPrefix, regionCode string)
 		// (e.g. 0777123) if we just do prefix matching. To tackle that,
 		// we check the validity of the number if the assumed national
 		// prefix is removed (777123 won't be valid in Japan).
-		num, err := Parse(normalizedNationalNumber[len(nationalPrefix):], regionCode)
+		num, err := parse(md, normalizedNationalNumber[len(nationalPrefix):], regionCode)
 		if err != nil {
 			return false
 		}
-		return IsValidNumber(num)
+		return isValidNumber(md, num)
 
 	}
 	return false
 }
 
-func hasFormattingPatternForNumber(number *PhoneNumber) bool {
+func hasFormattingPatternForNumber(md *metadataState, number *PhoneNumber) bool {
 	countryCallingCode := int(number.GetCountryCode())
-	phoneNumberRegion := GetRegionCodeForCountryCode(countryCallingCode)
+	phoneNumberRegion := getRegionCodeForCountryCode(md, countryCallingCode)
 	metadata := getMetadataForRegionOrCallingCode(
-		countryCallingCode, phoneNumberRegion)
+		md, countryCallingCode, phoneNumberRegion)
 	if metadata == nil {
 		return false
 	}
@@ -1605,16 +1748,17 @@ func hasFormattingPatternForNumber(number *PhoneNumber) bool {
 func FormatOutOfCountryKeepingAlphaChars(
 	number *PhoneNumber,
 	regionCallingFrom string) string {
+	md := activeMetadata()
 
 	rawInput := number.GetRawInput()
 	// If there is no raw input, then we can't keep alpha characters
 	// because there aren't any. In this case, we return
 	// formatOutOfCountryCallingNumber.
 	if len(rawInput) == 0 {
-		return FormatOutOfCountryCallingNumber(number, regionCallingFrom)
+		return formatOutOfCountryCallingNumber(md, number, regionCallingFrom)
 	}
 	countryCode := int(number.GetCountryCode())
-	if !hasValidCountryCallingCode(countryCode) {
+	if !hasValidCountryCallingCode(md, countryCode) {
 		return rawInput
 	}
 	// Strip any prefix such as country calling code, IDD, that was
@@ -1634,13 +1778,13 @@ func FormatOutOfCountryKeepingAlphaChars(
 			rawInput = rawInput[firstNationalNumberDigit:]
 		}
 	}
-	metadataForRegionCallingFrom := getMetadataForRegion(regionCallingFrom)
+	metadataForRegionCallingFrom := getMetadataForRegion(md, regionCallingFrom)
 	if countryCode == NANPA_COUNTRY_CODE {
-		if IsNANPACountry(regionCallingFrom) {
+		if isNANPACountry(md, regionCallingFrom) {
 			return strconv.Itoa(countryCode) + " " + rawInput
 		}
 	} else if metadataForRegionCallingFrom != nil &&
-		countryCode == getCountryCodeForValidRegion(regionCallingFrom) {
+		countryCode == getCountryCodeForValidRegion(md, regionCallingFrom) {
 		formattingPattern :=
 			chooseFormattingPatternForNumber(
 				metadataForRegionCallingFrom.GetNumberFormat(),
@@ -1679,9 +1823,9 @@ func FormatOutOfCountryKeepingAlphaChars(
 		}
 	}
 	var formattedNumber = NewBuilder([]byte(rawInput))
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadataForRegion *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	var metadataForRegion *PhoneMetadata = getMetadataForRegionOrCallingCode(md, countryCode, regionCode)
 	maybeAppendFormattedExtension(number, metadataForRegion,
 		INTERNATIONAL, formattedNumber)
 	if len(internationalPrefixForFormatting) > 0 {
@@ -1898,20 +2042,26 @@ func formatNsnUsingPatternWithCarrier(
 
 // Gets a valid number for the specified region.
 func GetExampleNumber(regionCode string) *PhoneNumber {
-	return GetExampleNumberForType(regionCode, FIXED_LINE)
+	md := activeMetadata()
+
+	return getExampleNumberForType(md, regionCode, FIXED_LINE)
 }
 
 // Gets a valid number for the specified region and number type.
 func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumber {
+	return getExampleNumberForType(activeMetadata(), regionCode, typ)
+}
+
+func getExampleNumberForType(md *metadataState, regionCode string, typ PhoneNumberType) *PhoneNumber {
 	// Check the region code is valid.
-	if !isValidRegionCode(regionCode) {
+	if !isValidRegionCode(md, regionCode) {
 		return nil
 	}
 	// PhoneNumberDesc (pointer?)
-	var desc = getNumberDescByType(getMetadataForRegion(regionCode), typ)
+	var desc = getNumberDescByType(getMetadataForRegion(md, regionCode), typ)
 	exNum := desc.GetExampleNumber()
 	if len(exNum) > 0 {
-		num, err := Parse(exNum, regionCode)
+		num, err := parse(md, exNum, regionCode)
 		if err != nil {
 			return nil
 		}
@@ -1922,7 +2072,9 @@ func GetExampleNumberForType(regionCode string, typ PhoneNumberType) *PhoneNumbe
 
 // Gets a valid number for the specified country calling code for a non-geographical entity.
 func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
-	var metadata *PhoneMetadata = getMetadataForNonGeographicalRegion(countryCallingCode)
+	md := activeMetadata()
+
+	var metadata *PhoneMetadata = getMetadataForNonGeographicalRegion(md, countryCallingCode)
 	if metadata == nil {
 		return nil
 	}
@@ -1935,7 +2087,7 @@ func GetExampleNumberForNonGeoEntity(countryCallingCode int) *PhoneNumber {
 
 	for _, desc := range descPriority {
 		if desc != nil && desc.GetExampleNumber() != "" {
-			num, err := Parse("+"+strconv.Itoa(countryCallingCode)+desc.GetExampleNumber(), "ZZ")
+			num, err := parse(md, "+"+strconv.Itoa(countryCallingCode)+desc.GetExampleNumber(), "ZZ")
 			if err != nil {
 				return nil
 			}
@@ -2003,9 +2155,13 @@ func getNumberDescByType(
 
 // Gets the type of a phone number.
 func GetNumberType(number *PhoneNumber) PhoneNumberType {
-	var regionCode string = GetRegionCodeForNumber(number)
+	return getNumberType(activeMetadata(), number)
+}
+
+func getNumberType(md *metadataState, number *PhoneNumber) PhoneNumberType {
+	var regionCode string = getRegionCodeForNumber(md, number)
 	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(
-		int(number.GetCountryCode()), regionCode)
+		md, int(number.GetCountryCode()), regionCode)
 	if metadata == nil {
 		return UNKNOWN
 	}
@@ -2065,20 +2221,20 @@ func getNumberTypeHelper(nationalNumber string, metadata *PhoneMetadata) PhoneNu
 
 // Returns the metadata for the given region code or nil if the region
 // code is invalid or unknown.
-func getMetadataForRegion(regionCode string) *PhoneMetadata {
-	if !isValidRegionCode(regionCode) {
+func getMetadataForRegion(md *metadataState, regionCode string) *PhoneMetadata {
+	if !isValidRegionCode(md, regionCode) {
 		return nil
 	}
-	val, _ := readFromRegionToMetadataMap(regionCode)
+	val, _ := readFromRegionToMetadataMap(md, regionCode)
 	return val
 }
 
-func getMetadataForNonGeographicalRegion(countryCallingCode int) *PhoneMetadata {
-	_, ok := countryCodeToRegion[countryCallingCode]
+func getMetadataForNonGeographicalRegion(md *metadataState, countryCallingCode int) *PhoneMetadata {
+	_, ok := md.countryCodeToRegion[countryCallingCode]
 	if !ok {
 		return nil
 	}
-	val, _ := readFromCountryCodeToNonGeographicalMetadataMap(countryCallingCode)
+	val, _ := readFromCountryCodeToNonGeographicalMetadataMap(md, countryCallingCode)
 	return val
 }
 
@@ -2116,8 +2272,12 @@ func isNumberMatchingDesc(nationalNumber string, numberDesc *PhoneNumberDesc) bo
 // verify the number is actually in use, which is impossible to tell by
 // just looking at a number itself.
 func IsValidNumber(number *PhoneNumber) bool {
-	var regionCode string = GetRegionCodeForNumber(number)
-	return IsValidNumberForRegion(number, regionCode)
+	return isValidNumber(activeMetadata(), number)
+}
+
+func isValidNumber(md *metadataState, number *PhoneNumber) bool {
+	var regionCode string = getRegionCodeForNumber(md, number)
+	return isValidNumberForRegion(md, number, regionCode)
 }
 
 // Tests whether a phone number is valid for a certain region. Note this
@@ -2133,9 +2293,13 @@ func IsValidNumber(number *PhoneNumber) bool {
 // such as the Isle of Man as invalid for the region "GB" (United Kingdom),
 // since it has its own region code, "IM", which may be undesirable.
 func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
+	return isValidNumberForRegion(activeMetadata(), number, regionCode)
+}
+
+func isValidNumberForRegion(md *metadataState, number *PhoneNumber, regionCode string) bool {
 	var countryCode int = int(number.GetCountryCode())
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
-	if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && countryCode != getCountryCodeForValidRegion(regionCode)) {
+	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(md, countryCode, regionCode)
+	if metadata == nil || (REGION_CODE_FOR_NON_GEO_ENTITY != regionCode && countryCode != getCountryCodeForValidRegion(md, regionCode)) {
 		// Either the region code was invalid, or the country calling
 		// code for this number does not match that of the region code.
 		return false
@@ -2147,18 +2311,23 @@ func IsValidNumberForRegion(number *PhoneNumber, regionCode string) bool {
 // Returns the region where a phone number is from. This could be used for
 // geocoding at the region level.
 func GetRegionCodeForNumber(number *PhoneNumber) string {
+	return getRegionCodeForNumber(activeMetadata(), number)
+}
+
+func getRegionCodeForNumber(md *metadataState, number *PhoneNumber) string {
 	var countryCode int = int(number.GetCountryCode())
-	var regions []string = countryCodeToRegion[countryCode]
+	var regions []string = md.countryCodeToRegion[countryCode]
 	if len(regions) == 0 {
 		return ""
 	}
 	if len(regions) == 1 {
 		return regions[0]
 	}
-	return getRegionCodeForNumberFromRegionList(number, regions)
+	return getRegionCodeForNumberFromRegionList(md, number, regions)
 }
 
 func getRegionCodeForNumberFromRegionList(
+	md *metadataState,
 	number *PhoneNumber,
 	regionCodes []string) string {
 
@@ -2167,7 +2336,7 @@ func getRegionCodeForNumberFromRegionList(
 		// If leadingDigits is present, use this. Otherwise, do
 		// full validation. Metadata cannot be null because the
 		// region codes come from the country calling code map.
-		var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+		var metadata *PhoneMetadata = getMetadataForRegion(md, regionCode)
 		if len(metadata.GetLeadingDigits()) > 0 {
 			patP := "^(?:" + metadata.GetLeadingDigits() + ")" // Non capturing grouping to support OR'ed alternatives (e.g. 555|1[78]|2)
 			pat := regexFor(patP)
@@ -2190,7 +2359,11 @@ func getRegionCodeForNumberFromRegionList(
 // value "001" will be returned (corresponding to the value for World in
 // the UN M.49 schema).
 func GetRegionCodeForCountryCode(countryCallingCode int) string {
-	var regionCodes []string = countryCodeToRegion[countryCallingCode]
+	return getRegionCodeForCountryCode(activeMetadata(), countryCallingCode)
+}
+
+func getRegionCodeForCountryCode(md *metadataState, countryCallingCode int) string {
+	var regionCodes []string = md.countryCodeToRegion[countryCallingCode]
 	if len(regionCodes) == 0 {
 		return UNKNOWN_REGION
 	}
@@ -2202,24 +2375,32 @@ func GetRegionCodeForCountryCode(countryCallingCode int) string {
 // code 001 is returned. Also, in the case of no region code being found,
 // an empty list is returned.
 func GetRegionCodesForCountryCode(countryCallingCode int) []string {
-	var regionCodes []string = countryCodeToRegion[countryCallingCode]
+	return getRegionCodesForCountryCode(activeMetadata(), countryCallingCode)
+}
+
+func getRegionCodesForCountryCode(md *metadataState, countryCallingCode int) []string {
+	var regionCodes []string = md.countryCodeToRegion[countryCallingCode]
 	return regionCodes
 }
 
 // Returns the country calling code for a specific region. For example, this
 // would be 1 for the United States, and 64 for New Zealand.
 func GetCountryCodeForRegion(regionCode string) int {
-	if !isValidRegionCode(regionCode) {
+	return getCountryCodeForRegion(activeMetadata(), regionCode)
+}
+
+func getCountryCodeForRegion(md *metadataState, regionCode string) int {
+	if !isValidRegionCode(md, regionCode) {
 		return 0
 	}
-	return getCountryCodeForValidRegion(regionCode)
+	return getCountryCodeForValidRegion(md, regionCode)
 }
 
 // Returns the country calling code for a specific region. For example,
 // this would be 1 for the United States, and 64 for New Zealand. Assumes
 // the region is already valid.
-func getCountryCodeForValidRegion(regionCode string) int {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+func getCountryCodeForValidRegion(md *metadataState, regionCode string) int {
+	var metadata *PhoneMetadata = getMetadataForRegion(md, regionCode)
 	return int(metadata.GetCountryCode())
 }
 
@@ -2234,7 +2415,11 @@ func getCountryCodeForValidRegion(regionCode string) int {
 // of numbers. Use the library's formatting functions to prefix the
 // national prefix when required.
 func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
-	var metadata *PhoneMetadata = getMetadataForRegion(regionCode)
+	return getNddPrefixForRegion(activeMetadata(), regionCode, stripNonDigits)
+}
+
+func getNddPrefixForRegion(md *metadataState, regionCode string, stripNonDigits bool) string {
+	var metadata *PhoneMetadata = getMetadataForRegion(md, regionCode)
 	if metadata == nil {
 		return ""
 	}
@@ -2254,7 +2439,11 @@ func GetNddPrefixForRegion(regionCode string, stripNonDigits bool) string {
 // Checks if this is a region under the North American Numbering Plan
 // Administration (NANPA).
 func IsNANPACountry(regionCode string) bool {
-	_, ok := readFromNanpaRegions(regionCode)
+	return isNANPACountry(activeMetadata(), regionCode)
+}
+
+func isNANPACountry(md *metadataState, regionCode string) bool {
+	_, ok := readFromNanpaRegions(md, regionCode)
 	return ok
 }
 
@@ -2278,7 +2467,11 @@ func IsAlphaNumber(number string) bool {
 // Convenience wrapper around IsPossibleNumberWithReason(). Instead of
 // returning the reason for failure, this method returns a boolean value.
 func IsPossibleNumber(number *PhoneNumber) bool {
-	possible := IsPossibleNumberWithReason(number)
+	return isPossibleNumber(activeMetadata(), number)
+}
+
+func isPossibleNumber(md *metadataState, number *PhoneNumber) bool {
+	possible := isPossibleNumberWithReason(md, number)
 	return possible == IS_POSSIBLE || possible == IS_POSSIBLE_LOCAL_ONLY
 }
 
@@ -2408,6 +2601,10 @@ func testNumberLength(number string, metadata *PhoneMetadata, numberType PhoneNu
 //     line numbers), it will return false for the subscriber-number-only
 //     version.
 func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
+	return isPossibleNumberWithReason(activeMetadata(), number)
+}
+
+func isPossibleNumberWithReason(md *metadataState, number *PhoneNumber) ValidationResult {
 	nationalNumber := GetNationalSignificantNumber(number)
 	countryCode := int(number.GetCountryCode())
 	// Note: For Russian Fed and NANPA numbers, we just use the rules
@@ -2416,12 +2613,12 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 	// but not valid. This would need to be revisited if the possible
 	// number pattern ever differed between various regions within
 	// those plans.
-	if !hasValidCountryCallingCode(countryCode) {
+	if !hasValidCountryCallingCode(md, countryCode) {
 		return INVALID_COUNTRY_CODE
 	}
-	regionCode := GetRegionCodeForCountryCode(countryCode)
+	regionCode := getRegionCodeForCountryCode(md, countryCode)
 	// Metadata cannot be null because the country calling code is valid.
-	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(countryCode, regionCode)
+	var metadata *PhoneMetadata = getMetadataForRegionOrCallingCode(md, countryCode, regionCode)
 	var generalNumDesc *PhoneNumberDesc = metadata.GetGeneralDesc()
 	// Handling case of numbers with no metadata.
 	if len(generalNumDesc.GetNationalNumberPattern()) == 0 {
@@ -2442,7 +2639,9 @@ func IsPossibleNumberWithReason(number *PhoneNumber) ValidationResult {
 // version. If no valid number could be extracted, the PhoneNumber object
 // passed in will not be modified.
 func TruncateTooLongNumber(number *PhoneNumber) bool {
-	if IsValidNumber(number) {
+	md := activeMetadata()
+
+	if isValidNumber(md, number) {
 		return true
 	}
 	numberCopy := &PhoneNumber{}
@@ -2450,13 +2649,13 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 	nationalNumber := number.GetNationalNumber()
 	nationalNumber /= 10
 	numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-	if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT || nationalNumber == 0 {
+	if isPossibleNumberWithReason(md, numberCopy) == TOO_SHORT || nationalNumber == 0 {
 		return false
 	}
-	for !IsValidNumber(numberCopy) {
+	for !isValidNumber(md, numberCopy) {
 		nationalNumber /= 10
 		numberCopy.NationalNumber = proto.Uint64(nationalNumber)
-		if IsPossibleNumberWithReason(numberCopy) == TOO_SHORT ||
+		if isPossibleNumberWithReason(md, numberCopy) == TOO_SHORT ||
 			nationalNumber == 0 {
 			return false
 		}
@@ -2467,17 +2666,18 @@ func TruncateTooLongNumber(number *PhoneNumber) bool {
 }
 
 // Gets an AsYouTypeFormatter for the specific region.
-// TODO(ttacon): uncomment once we do asyoutypeformatter.go
-// public AsYouTypeFormatter getAsYouTypeFormatter(String regionCode) {
-//    return new AsYouTypeFormatter(regionCode);
-// }
+func GetAsYouTypeFormatter(regionCode string) *AsYouTypeFormatter {
+	md := activeMetadata()
+
+	return newAsYouTypeFormatter(md, regionCode)
+}
 
 // Extracts country calling code from fullNumber, returns it and places
 // the remaining number in nationalNumber. It assumes that the leading plus
 // sign or IDD has already been removed. Returns 0 if fullNumber doesn't
 // start with a valid country calling code, and leaves nationalNumber
 // unmodified.
-func extractCountryCode(fullNumber, nationalNumber *Builder) int {
+func extractCountryCode(md *metadataState, fullNumber, nationalNumber *Builder) int {
 	fullNumBytes := fullNumber.Bytes()
 	if len(fullNumBytes) == 0 || fullNumBytes[0] == '0' {
 		// Country codes do not begin with a '0'.
@@ -2489,7 +2689,7 @@ func extractCountryCode(fullNumber, nationalNumber *Builder) int {
 	)
 	for i := 1; i <= MAX_LENGTH_COUNTRY_CODE && i <= numberLength; i++ {
 		potentialCountryCode, _ = strconv.Atoi(string(fullNumBytes[0:i]))
-		if _, ok := countryCodeToRegion[potentialCountryCode]; ok {
+		if _, ok := md.countryCodeToRegion[potentialCountryCode]; ok {
 			nationalNumber.Write(fullNumBytes[i:])
 			return potentialCountryCode
 		}
@@ -2520,6 +2720,7 @@ var ErrTooShortAfterIDD = errors.New("phone number had an IDD, but " +
 // the country calling code supplied after this does not match that of any
 // known region.
 func maybeExtractCountryCode(
+	md *metadataState,
 	number string,
 	defaultRegionMetadata *PhoneMetadata,
 	nationalNumber *Builder,
@@ -2545,7 +2746,7 @@ func maybeExtractCountryCode(
 		if len(fullNumber.String()) <= MIN_LENGTH_FOR_NSN {
 			return 0, ErrTooShortAfterIDD
 		}
-		potentialCountryCode := extractCountryCode(fullNumber, nationalNumber)
+		potentialCountryCode := extractCountryCode(md, fullNumber, nationalNumber)
 		if potentialCountryCode != 0 {
 			phoneNumber.CountryCode = proto.Int32(int32(potentialCountryCode))
 			return potentialCountryCode, nil
@@ -2779,8 +2980,8 @@ func maybeStripExtension(number *Builder) string {
 // that the number to parse starts with a + symbol so that we can attempt
 // to infer the region from the number. Returns false if it cannot use the
 // region provided and the region cannot be inferred.
-func checkRegionForParsing(numberToParse, defaultRegion string) bool {
-	if !isValidRegionCode(defaultRegion) {
+func checkRegionForParsing(md *metadataState, numberToParse, defaultRegion string) bool {
+	if !isValidRegionCode(md, defaultRegion) {
 		// If the number is null or empty, we can't infer the region.
 		if len(numberToParse) == 0 ||
 			!PLUS_CHARS_PATTERN.MatchString(numberToParse) {
@@ -2796,15 +2997,23 @@ func checkRegionForParsing(numberToParse, defaultRegion string) bool {
 // a valid number for a particular region is not performed. This can be
 // done separately with IsValidNumber().
 func Parse(numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return parse(activeMetadata(), numberToParse, defaultRegion)
+}
+
+func parse(md *metadataState, numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	err := ParseToNumber(numberToParse, defaultRegion, phoneNumber)
+	err := parseToNumber(md, numberToParse, defaultRegion, phoneNumber)
 	return phoneNumber, err
 }
 
 // Same as Parse(string, string), but accepts mutable PhoneNumber as a
 // parameter to decrease object creation when invoked many times.
 func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, false, true, phoneNumber)
+	return parseToNumber(activeMetadata(), numberToParse, defaultRegion, phoneNumber)
+}
+
+func parseToNumber(md *metadataState, numberToParse, defaultRegion string, phoneNumber *PhoneNumber) error {
+	return parseHelper(md, numberToParse, defaultRegion, false, true, phoneNumber)
 }
 
 // Parses a string and returns it in proto buffer format. This method
@@ -2812,10 +3021,16 @@ func ParseToNumber(numberToParse, defaultRegion string, phoneNumber *PhoneNumber
 // the protocol buffer with numberToParse as well as the country_code_source
 // field.
 func ParseAndKeepRawInput(
+	numberToParse, defaultRegion string) (*PhoneNumber, error) {
+	return parseAndKeepRawInput(activeMetadata(), numberToParse, defaultRegion)
+}
+
+func parseAndKeepRawInput(
+	md *metadataState,
 	numberToParse, defaultRegion string) (*PhoneNumber, error) {
 	var phoneNumber *PhoneNumber = &PhoneNumber{}
-	return phoneNumber, ParseAndKeepRawInputToNumber(
-		numberToParse, defaultRegion, phoneNumber)
+	return phoneNumber, parseAndKeepRawInputToNumber(
+		md, numberToParse, defaultRegion, phoneNumber)
 }
 
 // Same as ParseAndKeepRawInput(String, String), but accepts a mutable
@@ -2824,28 +3039,36 @@ func ParseAndKeepRawInput(
 func ParseAndKeepRawInputToNumber(
 	numberToParse, defaultRegion string,
 	phoneNumber *PhoneNumber) error {
-	return parseHelper(numberToParse, defaultRegion, true, true, phoneNumber)
+	return parseAndKeepRawInputToNumber(activeMetadata(), numberToParse, defaultRegion, phoneNumber)
 }
 
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-// This is a shortcut for findNumbers(CharSequence, String, Leniency, long)
-// getMatcher(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE)}.
-// public Iterable<PhoneNumberMatch> findNumbers(CharSequence text, String defaultRegion) {
-//    return findNumbers(text, defaultRegion, Leniency.VALID, Long.MAX_VALUE);
-// }
+func parseAndKeepRawInputToNumber(
+	md *metadataState,
+	numberToParse, defaultRegion string,
+	phoneNumber *PhoneNumber) error {
+	return parseHelper(md, numberToParse, defaultRegion, true, true, phoneNumber)
+}
 
-// Returns an iterable over all PhoneNumberMatch PhoneNumberMatches in text.
-// public Iterable<PhoneNumberMatch> findNumbers(
-//	final CharSequence text, final String defaultRegion, final Leniency leniency,
-//	final long maxTries) {
-//
-//		return new Iterable<PhoneNumberMatch>() {
-//			public Iterator<PhoneNumberMatch> iterator() {
-//				return new PhoneNumberMatcher(
-//					PhoneNumberUtil.this, text, defaultRegion, leniency, maxTries);
-//			}
-//		};
-//	}
+// FindNumbers returns an iterator over all the phone numbers found in
+// text. Numbers without an international prefix are parsed as if dialed
+// from defaultRegion, each candidate must satisfy leniency, and at most
+// maxTries invalid candidates are examined before the search gives up.
+func FindNumbers(text, defaultRegion string, leniency Leniency, maxTries int64) *PhoneNumberMatcher {
+	return NewPhoneNumberMatcher(text, defaultRegion, leniency, maxTries)
+}
+
+// FindAllNumbers is a shortcut for iterating FindNumbers with a VALID
+// leniency and no limit on the number of tries, returning every match.
+func FindAllNumbers(text, defaultRegion string) []*PhoneNumberMatch {
+	md := activeMetadata()
+
+	var matches []*PhoneNumberMatch
+	matcher := FindNumbers(text, defaultRegion, VALID, math.MaxInt64)
+	for matcher.hasNext(md) {
+		matches = append(matches, matcher.next(md))
+	}
+	return matches
+}
 
 // A helper function to set the values related to leading zeros in a
 // PhoneNumber.
@@ -2874,36 +3097,176 @@ var (
 	ErrTooShortNSN        = errors.New("the string supplied is too short to be a phone number")
 )
 
+// The reason a number could not be parsed, mirroring NumberParseException.ErrorType.
+type ParseErrorType int
+
+const (
+	// INVALID_COUNTRY_CODE:
+	//     The country code supplied did not belong to a supported country or
+	//     non-geographical entity.
+	// NOT_A_NUMBER:
+	//     This generally indicates the string passed in had less than 3
+	//     digits in it. More specifically, the number failed to match the
+	//     regular expression VALID_PHONE_NUMBER, RFC3966_GLOBAL_NUMBER_DIGITS,
+	//     or RFC3966_DOMAINNAME.
+	// TOO_SHORT_AFTER_IDD:
+	//     This indicates the string started with an international dialing
+	//     prefix, but after this was stripped from the number, had less
+	//     digits than any valid phone number (including extension) could have.
+	// TOO_SHORT_NSN:
+	//     This indicates the string, after any country code has been
+	//     stripped, had less digits than any valid phone number could have.
+	// TOO_LONG:
+	//     This indicates the string had more digits than any valid phone
+	//     number could have.
+	PARSE_INVALID_COUNTRY_CODE ParseErrorType = iota
+	PARSE_NOT_A_NUMBER
+	PARSE_TOO_SHORT_AFTER_IDD
+	PARSE_TOO_SHORT_NSN
+	PARSE_TOO_LONG
+)
+
+var parseErrorTypeNames = map[ParseErrorType]string{
+	PARSE_INVALID_COUNTRY_CODE: "INVALID_COUNTRY_CODE",
+	PARSE_NOT_A_NUMBER:         "NOT_A_NUMBER",
+	PARSE_TOO_SHORT_AFTER_IDD:  "TOO_SHORT_AFTER_IDD",
+	PARSE_TOO_SHORT_NSN:        "TOO_SHORT_NSN",
+	PARSE_TOO_LONG:             "TOO_LONG",
+}
+
+func (t ParseErrorType) String() string {
+	return parseErrorTypeNames[t]
+}
+
+// ParseError is returned by Parse and its variants when a number can't be
+// parsed. Err is the sentinel error for Type, e.g. ErrNotANumber, so
+// callers can keep using errors.Is against the sentinel errors. Offset is
+// the character (not byte) offset in Input where the rejected part of the
+// number starts: for NOT_A_NUMBER the first character which can't be part
+// of a number, for INVALID_COUNTRY_CODE the country calling code after a
+// plus sign, and otherwise, or when there is no such character or plus
+// sign, the start of the number.
+type ParseError struct {
+	Type   ParseErrorType
+	Input  string
+	Offset int
+	Err    error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s (at offset %d of %q)", e.Err, e.Offset, e.Input)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Wraps one of the sentinel parsing errors in a ParseError, converting the
+// byte offset into numberToParse into a character offset.
+func newParseError(err error, numberToParse string, byteOffset int) error {
+	var errType ParseErrorType
+	switch err {
+	case ErrInvalidCountryCode:
+		errType = PARSE_INVALID_COUNTRY_CODE
+	case ErrTooShortAfterIDD:
+		errType = PARSE_TOO_SHORT_AFTER_IDD
+	case ErrTooShortNSN:
+		errType = PARSE_TOO_SHORT_NSN
+	case ErrNumTooLong:
+		errType = PARSE_TOO_LONG
+	default:
+		errType = PARSE_NOT_A_NUMBER
+	}
+	if byteOffset > len(numberToParse) {
+		byteOffset = len(numberToParse)
+	}
+	return &ParseError{
+		Type:   errType,
+		Input:  numberToParse,
+		Offset: utf8.RuneCountInString(numberToParse[:byteOffset]),
+		Err:    err,
+	}
+}
+
+// Returns the byte offset in numberToParse at which the number itself
+// starts, skipping any tel: prefix or leading characters that can't start a
+// phone number.
+func numberStartOffset(numberToParse string) int {
+	if strings.Contains(numberToParse, RFC3966_PHONE_CONTEXT) {
+		if index := strings.Index(numberToParse, RFC3966_PREFIX); index >= 0 {
+			return index + len(RFC3966_PREFIX)
+		}
+		return 0
+	}
+	if loc := VALID_START_CHAR_PATTERN.FindStringIndex(numberToParse); loc != nil {
+		return loc[0]
+	}
+	return 0
+}
+
+// Returns the byte offset in numberToParse of the first character from
+// start on which can't be part of a number, or start if there is none, as
+// when the number has too few digits.
+func invalidCharOffset(numberToParse string, start int) int {
+	for i, r := range numberToParse[start:] {
+		if !VALID_NUMBER_CHAR_PATTERN.MatchString(string(r)) {
+			return start + i
+		}
+	}
+	return start
+}
+
+// Returns the byte offset in numberToParse of the country calling code of
+// the number starting at start, being the first digit after a leading plus
+// sign, or start if the number doesn't have one, as when it starts with an
+// international dialing prefix.
+func callingCodeOffset(numberToParse string, start int) int {
+	number := numberToParse[start:]
+	if loc := PLUS_CHARS_PATTERN.FindStringIndex(number); loc != nil && loc[0] == 0 {
+		for i, r := range number[loc[1]:] {
+			if unicode.IsDigit(r) {
+				return start + loc[1] + i
+			}
+		}
+	}
+	return start
+}
+
 // Parses a string and fills up the phoneNumber. This method is the same
 // as the public Parse() method, with the exception that it allows the
 // default region to be null, for use by IsNumberMatch(). checkRegion should
 // be set to false if it is permitted for the default region to be null or
 // unknown ("ZZ").
 func parseHelper(
+	md *metadataState,
 	numberToParse, defaultRegion string,
 	keepRawInput, checkRegion bool,
 	phoneNumber *PhoneNumber) error {
 	if len(numberToParse) == 0 {
-		return ErrNotANumber
+		return newParseError(ErrNotANumber, numberToParse, 0)
 	} else if len(numberToParse) > MAX_INPUT_STRING_LENGTH {
-		return ErrNumTooLong
+		return newParseError(ErrNumTooLong, numberToParse, MAX_INPUT_STRING_LENGTH)
 	}
 
 	nationalNumber := NewBuilder(nil)
 	err := buildNationalNumberForParsing(numberToParse, nationalNumber)
 	if err != nil {
-		return err
+		offset := strings.Index(numberToParse, RFC3966_PHONE_CONTEXT) + len(RFC3966_PHONE_CONTEXT)
+		return newParseError(err, numberToParse, offset)
 	}
 
+	// All remaining errors are reported at the start of the number.
+	numberStart := numberStartOffset(numberToParse)
+
 	if !isViablePhoneNumber(nationalNumber.String()) {
-		return ErrNotANumber
+		return newParseError(ErrNotANumber, numberToParse, invalidCharOffset(numberToParse, numberStart))
 	}
 
 	// Check the region supplied is valid, or that the extracted number
 	// starts with some sort of + sign so the number's region can be determined.
 	if checkRegion &&
-		!checkRegionForParsing(nationalNumber.String(), defaultRegion) {
-		return ErrInvalidCountryCode
+		!checkRegionForParsing(md, nationalNumber.String(), defaultRegion) {
+		return newParseError(ErrInvalidCountryCode, numberToParse, numberStart)
 	}
 
 	if keepRawInput {
@@ -2916,7 +3279,7 @@ func parseHelper(
 	if len(extension) > 0 {
 		phoneNumber.Extension = proto.String(extension)
 	}
-	var regionMetadata *PhoneMetadata = getMetadataForRegion(defaultRegion)
+	var regionMetadata *PhoneMetadata = getMetadataForRegion(md, defaultRegion)
 	// Check to see if the number is given in international format so we
 	// know whether this number is from the default region or not.
 	normalizedNationalNumber := NewBuilder(nil)
@@ -2924,7 +3287,7 @@ func parseHelper(
 	// has already been created, and just remove the prefix, rather than
 	// taking in a string and then outputting a string buffer.
 	countryCode, err := maybeExtractCountryCode(
-		nationalNumber.String(), regionMetadata,
+		md, nationalNumber.String(), regionMetadata,
 		normalizedNationalNumber, keepRawInput, phoneNumber)
 	if err != nil {
 		// There might be a plus at the beginning
@@ -2932,24 +3295,26 @@ func parseHelper(
 		if err == ErrInvalidCountryCode && len(inds) > 0 {
 			// Strip the plus-char, and try again.
 			countryCode, err = maybeExtractCountryCode(
-				nationalNumber.String()[inds[1]:], regionMetadata,
+				md, nationalNumber.String()[inds[1]:], regionMetadata,
 				normalizedNationalNumber, keepRawInput, phoneNumber)
 			if err != nil {
-				return err
+				return newParseError(err, numberToParse, callingCodeOffset(numberToParse, numberStart))
 			} else if countryCode == 0 {
-				return ErrInvalidCountryCode
+				return newParseError(ErrInvalidCountryCode, numberToParse, callingCodeOffset(numberToParse, numberStart))
 			}
+		} else if err == ErrInvalidCountryCode {
+			return newParseError(err, numberToParse, callingCodeOffset(numberToParse, numberStart))
 		} else {
-			return err
+			return newParseError(err, numberToParse, numberStart)
 		}
 	}
 	if countryCode != 0 {
-		phoneNumberRegion := GetRegionCodeForCountryCode(countryCode)
+		phoneNumberRegion := getRegionCodeForCountryCode(md, countryCode)
 		if phoneNumberRegion != defaultRegion {
 			// Metadata cannot be null because the country calling
 			// code is valid.
 			regionMetadata = getMetadataForRegionOrCallingCode(
-				countryCode, phoneNumberRegion)
+				md, countryCode, phoneNumberRegion)
 		}
 	} else {
 		// If no extracted country calling code, use the region supplied
@@ -2964,7 +3329,7 @@ func parseHelper(
 		}
 	}
 	if len(normalizedNationalNumber.String()) < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(ErrTooShortNSN, numberToParse, numberStart)
 	}
 
 	if regionMetadata != nil {
@@ -2989,10 +3354,10 @@ func parseHelper(
 	}
 	lengthOfNationalNumber := len(normalizedNationalNumber.String())
 	if lengthOfNationalNumber < MIN_LENGTH_FOR_NSN {
-		return ErrTooShortNSN
+		return newParseError(ErrTooShortNSN, numberToParse, numberStart)
 	}
 	if lengthOfNationalNumber > MAX_LENGTH_FOR_NSN {
-		return ErrNumTooLong
+		return newParseError(ErrNumTooLong, numberToParse, numberStart)
 	}
 	setItalianLeadingZerosForPhoneNumber(
 		normalizedNationalNumber.String(), phoneNumber)
@@ -3196,26 +3561,28 @@ func isNationalNumberSuffixOfTheOther(firstNumber, secondNumber *PhoneNumber) bo
 // a convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
 // default region is known.
 func IsNumberMatch(firstNumber, secondNumber string) MatchType {
-	firstNumberAsProto, err := Parse(firstNumber, UNKNOWN_REGION)
+	md := activeMetadata()
+
+	firstNumberAsProto, err := parse(md, firstNumber, UNKNOWN_REGION)
 	if err == nil {
-		return IsNumberMatchWithOneNumber(firstNumberAsProto, secondNumber)
-	} else if err != ErrInvalidCountryCode {
+		return isNumberMatchWithOneNumber(md, firstNumberAsProto, secondNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := parse(md, secondNumber, UNKNOWN_REGION)
 	if err == nil {
-		return IsNumberMatchWithOneNumber(secondNumberAsProto, firstNumber)
-	} else if err != ErrInvalidCountryCode {
+		return isNumberMatchWithOneNumber(md, secondNumberAsProto, firstNumber)
+	} else if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 
 	var firstNumberProto, secondNumberProto PhoneNumber
-	err = parseHelper(firstNumber, "", false, false, &firstNumberProto)
+	err = parseHelper(md, firstNumber, "", false, false, &firstNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
-	err = parseHelper(secondNumber, "", false, false, &secondNumberProto)
+	err = parseHelper(md, secondNumber, "", false, false, &secondNumberProto)
 	if err != nil {
 		return NOT_A_NUMBER
 	}
@@ -3226,25 +3593,31 @@ func IsNumberMatch(firstNumber, secondNumber string) MatchType {
 // convenience wrapper for IsNumberMatch(PhoneNumber, PhoneNumber). No
 // default region is known.
 func IsNumberMatchWithOneNumber(
+	firstNumber *PhoneNumber, secondNumber string) MatchType {
+	return isNumberMatchWithOneNumber(activeMetadata(), firstNumber, secondNumber)
+}
+
+func isNumberMatchWithOneNumber(
+	md *metadataState,
 	firstNumber *PhoneNumber, secondNumber string) MatchType {
 	// First see if the second number has an implicit country calling
 	// code, by attempting to parse it.
-	secondNumberAsProto, err := Parse(secondNumber, UNKNOWN_REGION)
+	secondNumberAsProto, err := parse(md, secondNumber, UNKNOWN_REGION)
 	if err == nil {
 		return IsNumberMatchWithNumbers(firstNumber, secondNumberAsProto)
 	}
-	if err != ErrInvalidCountryCode {
+	if !errors.Is(err, ErrInvalidCountryCode) {
 		return NOT_A_NUMBER
 	}
 	// The second number has no country calling code. EXACT_MATCH is no
 	// longer possible. We parse it as if the region was the same as that
 	// for the first number, and if EXACT_MATCH is returned, we replace
 	// this with NSN_MATCH.
-	firstNumberRegion := GetRegionCodeForCountryCode(int(firstNumber.GetCountryCode()))
+	firstNumberRegion := getRegionCodeForCountryCode(md, int(firstNumber.GetCountryCode()))
 
 	if firstNumberRegion != UNKNOWN_REGION {
 		secondNumberWithFirstNumberRegion, err :=
-			Parse(secondNumber, firstNumberRegion)
+			parse(md, secondNumber, firstNumberRegion)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
@@ -3258,7 +3631,7 @@ func IsNumberMatchWithOneNumber(
 		// If the first number didn't have a valid country calling
 		// code, then we parse the second number without one as well.
 		var secondNumberProto *PhoneNumber
-		err := parseHelper(secondNumber, "", false, false, secondNumberProto)
+		err := parseHelper(md, secondNumber, "", false, false, secondNumberProto)
 		if err != nil {
 			return NOT_A_NUMBER
 		}
@@ -3271,8 +3644,8 @@ func IsNumberMatchWithOneNumber(
 // returns false. Does not check the number is a valid number. Note that,
 // at the moment, this method does not handle short numbers.
 // TODO: Make this method public when we have enough metadata to make it worthwhile.
-func canBeInternationallyDialled(number *PhoneNumber) bool {
-	metadata := getMetadataForRegion(GetRegionCodeForNumber(number))
+func canBeInternationallyDialled(md *metadataState, number *PhoneNumber) bool {
+	metadata := getMetadataForRegion(md, getRegionCodeForNumber(md, number))
 	if metadata == nil {
 		// Note numbers belonging to non-geographical entities
 		// (e.g. +800 numbers) are always internationally diallable,
@@ -3288,7 +3661,11 @@ func canBeInternationallyDialled(number *PhoneNumber) bool {
 // Returns false for invalid, unknown or regions that don't support mobile
 // number portability.
 func IsMobileNumberPortableRegion(regionCode string) bool {
-	metadata := getMetadataForRegion(regionCode)
+	return isMobileNumberPortableRegion(activeMetadata(), regionCode)
+}
+
+func isMobileNumberPortableRegion(md *metadataState, regionCode string) bool {
+	metadata := getMetadataForRegion(md, regionCode)
 	if metadata == nil {
 		return false
 	}
@@ -3301,42 +3678,17 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
-	countryCodeToRegion = regionMap.Map
 
 	// then our metadata
-	err = loadMetadataFromFile("US", 1)
+	metadataCollection, err := loadEmbeddedMetadata()
 	if err != nil {
 		panic(err)
 	}
-
-	for eKey, regionCodes := range countryCodeToRegion {
-		// We can assume that if the county calling code maps to the
-		// non-geo entity region code then that's the only region code
-		// it maps to.
-		if len(regionCodes) == 1 && REGION_CODE_FOR_NON_GEO_ENTITY == regionCodes[0] {
-			// This is the subset of all country codes that map to the
-			// non-geo entity region code.
-			countryCodesForNonGeographicalRegion[eKey] = true
-		} else {
-			// The supported regions set does not include the "001"
-			// non-geo entity region code.
-			for _, val := range regionCodes {
-				supportedRegions[val] = true
-			}
-		}
-
-		supportedCallingCodes[eKey] = true
-	}
-	// If the non-geo entity still got added to the set of supported
-	// regions it must be because there are entries that list the non-geo
-	// entity alongside normal regions (which is wrong). If we discover
-	// this, remove the non-geo entity from the set of supported regions
-	// and log (or not log).
-	delete(supportedRegions, REGION_CODE_FOR_NON_GEO_ENTITY)
-
-	for _, val := range countryCodeToRegion[NANPA_COUNTRY_CODE] {
-		writeToNanpaRegions(val, struct{}{})
+	state, err := newMetadataState(metadataCollection, regionMap.Map)
+	if err != nil {
+		panic(err)
 	}
+	currentMetadata.Store(state)
 
 	// Create our sync.Onces for each of our languages for carriers
 	for lang := range gen.CarrierData {
@@ -3385,11 +3737,15 @@ func GetTimezonesForPrefix(number string) ([]string, error) {
 // GetTimezonesForNumber returns the names of timezones which we believe maps to the
 // passed in number.
 func GetTimezonesForNumber(number *PhoneNumber) ([]string, error) {
-	e164 := Format(number, E164)
+	return getTimezonesForNumber(activeMetadata(), number)
+}
+
+func getTimezonesForNumber(md *metadataState, number *PhoneNumber) ([]string, error) {
+	e164 := format(md, number, E164)
 	return GetTimezonesForPrefix(e164)
 }
 
-func getValueForNumber(onceMap map[string]*sync.Once, langMap map[string]*intStringMap, binMap map[string]string, language string, maxLength int, number *PhoneNumber) (string, int, error) {
+func getValueForNumber(md *metadataState, onceMap map[string]*sync.Once, langMap map[string]*intStringMap, binMap map[string]string, language string, maxLength int, number *PhoneNumber) (string, int, error) {
 	// do we have data for this language
 	_, existing := binMap[language]
 	if !existing {
@@ -3410,7 +3766,7 @@ func getValueForNumber(onceMap map[string]*sync.Once, langMap map[string]*intStr
 		return "", 0, fmt.Errorf("error loading language map for %s", language)
 	}
 
-	e164 := Format(number, E164)
+	e164 := format(md, number, E164)
 
 	l := len(e164)
 	if maxLength > l {
@@ -3431,7 +3787,11 @@ func getValueForNumber(onceMap map[string]*sync.Once, langMap map[string]*intStr
 // GetCarrierForNumber returns the carrier we believe the number belongs to. Note due
 // to number porting this is only a guess, there is no guarantee to its accuracy.
 func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
-	carrier, _, err := GetCarrierWithPrefixForNumber(number, lang)
+	return getCarrierForNumber(activeMetadata(), number, lang)
+}
+
+func getCarrierForNumber(md *metadataState, number *PhoneNumber, lang string) (string, error) {
+	carrier, _, err := getCarrierWithPrefixForNumber(md, number, lang)
 	return carrier, err
 }
 
@@ -3440,16 +3800,22 @@ func GetCarrierForNumber(number *PhoneNumber, lang string) (string, error) {
 // A carrier name is considered safe if the number is valid and
 // for a region that doesn't support mobile number portability .
 func GetSafeCarrierDisplayNameForNumber(phoneNumber *PhoneNumber, lang string) (string, error) {
-	if IsMobileNumberPortableRegion(GetRegionCodeForNumber(phoneNumber)) {
+	md := activeMetadata()
+
+	if isMobileNumberPortableRegion(md, getRegionCodeForNumber(md, phoneNumber)) {
 		return "", nil
 	}
-	return GetCarrierForNumber(phoneNumber, lang)
+	return getCarrierForNumber(md, phoneNumber, lang)
 }
 
 // GetCarrierWithPrefixForNumber returns the carrier we believe the number belongs to, as well as
 // its prefix. Note due to number porting this is only a guess, there is no guarantee to its accuracy.
 func GetCarrierWithPrefixForNumber(number *PhoneNumber, lang string) (string, int, error) {
-	carrier, prefix, err := getValueForNumber(carrierOnces, carrierPrefixMap, gen.CarrierData, lang, 10, number)
+	return getCarrierWithPrefixForNumber(activeMetadata(), number, lang)
+}
+
+func getCarrierWithPrefixForNumber(md *metadataState, number *PhoneNumber, lang string) (string, int, error) {
+	carrier, prefix, err := getValueForNumber(md, carrierOnces, carrierPrefixMap, gen.CarrierData, lang, 10, number)
 	if err != nil {
 		return "", 0, err
 	}
@@ -3458,26 +3824,30 @@ func GetCarrierWithPrefixForNumber(number *PhoneNumber, lang string) (string, in
 	}
 
 	// fallback to english
-	return getValueForNumber(carrierOnces, carrierPrefixMap, gen.CarrierData, "en", 10, number)
+	return getValueForNumber(md, carrierOnces, carrierPrefixMap, gen.CarrierData, "en", 10, number)
 }
 
 // GetGeocodingForNumber returns the location we think the number was first acquired in. This is
 // just our best guess, there is no guarantee to its accuracy.
 func GetGeocodingForNumber(number *PhoneNumber, lang string) (string, error) {
-	geocoding, _, err := getValueForNumber(geocodingOnces, geocodingPrefixMap, gen.GeocodingData, lang, 10, number)
+	return getGeocodingForNumber(activeMetadata(), number, lang)
+}
+
+func getGeocodingForNumber(md *metadataState, number *PhoneNumber, lang string) (string, error) {
+	geocoding, _, err := getValueForNumber(md, geocodingOnces, geocodingPrefixMap, gen.GeocodingData, lang, 10, number)
 	if err != nil || geocoding != "" {
 		return geocoding, err
 	}
 
 	// fallback to english
-	geocoding, _, err = getValueForNumber(geocodingOnces, geocodingPrefixMap, gen.GeocodingData, "en", 10, number)
+	geocoding, _, err = getValueForNumber(md, geocodingOnces, geocodingPrefixMap, gen.GeocodingData, "en", 10, number)
 	if err != nil || geocoding != "" {
 		return geocoding, err
 	}
 
 	// fallback to locale
 	var reg language.Region
-	if reg, err = language.ParseRegion(GetRegionCodeForNumber(number)); err != nil {
+	if reg, err = language.ParseRegion(getRegionCodeForNumber(md, number)); err != nil {
 		return "", err
 	}
 
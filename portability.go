@@ -0,0 +1,325 @@
+package phonenumbers
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// The source of the carrier of a verified Number.
+const (
+	// The number is listed by the PortabilityProvider as ported to the carrier.
+	CARRIER_SOURCE_PORTED = "ported"
+	// The carrier is our guess from the range of numbers the number belongs to.
+	CARRIER_SOURCE_PREFIX = "prefix"
+)
+
+// PortedNumber is the network a number has been ported to. Carrier may be empty, in which case
+// the brand or operator of the network is used.
+type PortedNumber struct {
+	Mcc     string `json:"mcc"`
+	Mnc     string `json:"mnc"`
+	Carrier string `json:"carrier"`
+}
+
+// PortabilityProvider looks up numbers that have been ported to another carrier than the one
+// their number range was allocated to. Implementations must be safe for concurrent use, as bulk
+// verification looks numbers up from several goroutines.
+type PortabilityProvider interface {
+	// LookupPorted returns the network the number, formatted as E164, has been ported to and
+	// whether it has been ported at all.
+	LookupPorted(e164 string) (PortedNumber, bool, error)
+}
+
+var (
+	ErrInvalidPortedNumber  = errors.New("the ported number is not an E164 number")
+	ErrInvalidPortedNetwork = errors.New("the ported network needs a 3 digit MCC and a 2 or 3 digit MNC")
+	ErrInvalidPortabilityDB = errors.New("the data is not a portability database")
+)
+
+// The first bytes of the binary format of a FilePortabilityProvider.
+const PORTABILITY_DB_MAGIC = "PNPDB1"
+
+// The most bytes the networks of a binary portability database can take up, far more than the
+// few thousand networks there are need, so a corrupt size can't make us allocate gigabytes.
+const MAX_PORTABILITY_DB_NETWORK_BYTES = 16 << 20
+
+// The most numbers we make room for up front when loading a binary portability database, larger
+// databases grow as their numbers are read.
+const maxPortabilityDBPreallocatedNumbers = 1 << 20
+
+// FilePortabilityProvider is a PortabilityProvider backed by a list of ported numbers held in
+// memory, typically the porting dump of an aggregator. It is read only once loaded, so it is
+// safe for concurrent use.
+type FilePortabilityProvider struct {
+	// ported numbers as an integer, to the index of their network in networks
+	numbers  map[uint64]uint32
+	networks []PortedNumber
+}
+
+// LookupPorted implements PortabilityProvider.
+func (p *FilePortabilityProvider) LookupPorted(e164 string) (PortedNumber, bool, error) {
+	number, err := portedNumberKey(e164)
+	if err != nil {
+		return PortedNumber{}, false, err
+	}
+	index, found := p.numbers[number]
+	if !found {
+		return PortedNumber{}, false, nil
+	}
+	return p.networks[index], true, nil
+}
+
+// Len returns how many ported numbers the provider holds.
+func (p *FilePortabilityProvider) Len() int {
+	return len(p.numbers)
+}
+
+func newFilePortabilityProvider() *FilePortabilityProvider {
+	return &FilePortabilityProvider{numbers: make(map[uint64]uint32)}
+}
+
+// adds the ported number, sharing the network with the numbers already ported to it
+func (p *FilePortabilityProvider) add(number uint64, network PortedNumber, interned map[PortedNumber]uint32) {
+	index, found := interned[network]
+	if !found {
+		index = uint32(len(p.networks))
+		interned[network] = index
+		p.networks = append(p.networks, network)
+	}
+	p.numbers[number] = index
+}
+
+// LoadPortabilityFile loads the ported numbers in the file at path, which is read as CSV if it
+// has a .csv extension and in the binary format written by WriteBinary otherwise.
+func LoadPortabilityFile(path string) (*FilePortabilityProvider, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		return NewPortabilityProviderFromCSV(file)
+	}
+	return NewPortabilityProviderFromBinary(file)
+}
+
+// NewPortabilityProviderFromCSV reads ported numbers from CSV rows of number, MCC, MNC and an
+// optional carrier name. Numbers must be in E164 format, the leading plus is optional. A first
+// row whose number isn't numeric is taken to be a header and skipped. When a number is listed
+// more than once the last row wins.
+func NewPortabilityProviderFromCSV(r io.Reader) (*FilePortabilityProvider, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	provider := newFilePortabilityProvider()
+	interned := make(map[PortedNumber]uint32)
+	for first := true; ; first = false {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if len(row) < 3 {
+			return nil, fmt.Errorf("line %d: expected number, MCC, MNC and carrier", line)
+		}
+
+		number, err := portedNumberKey(strings.TrimSpace(row[0]))
+		if err != nil {
+			if first {
+				continue
+			}
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		network := PortedNumber{Mcc: strings.TrimSpace(row[1]), Mnc: strings.TrimSpace(row[2])}
+		if len(row) > 3 {
+			network.Carrier = strings.TrimSpace(row[3])
+		}
+		if err := validatePortedNetwork(network); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		provider.add(number, network, interned)
+	}
+	return provider, nil
+}
+
+// NewPortabilityProviderFromBinary reads ported numbers in the binary format written by
+// WriteBinary.
+func NewPortabilityProviderFromBinary(r io.Reader) (*FilePortabilityProvider, error) {
+	zipReader, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPortabilityDB, err)
+	}
+	reader := bufio.NewReader(zipReader)
+
+	magic := make([]byte, len(PORTABILITY_DB_MAGIC))
+	if _, err := io.ReadFull(reader, magic); err != nil || string(magic) != PORTABILITY_DB_MAGIC {
+		return nil, ErrInvalidPortabilityDB
+	}
+
+	// first our networks, as a length and their fields joined by newlines
+	var networkSize uint32
+	if err := binary.Read(reader, binary.LittleEndian, &networkSize); err != nil {
+		return nil, err
+	}
+	if networkSize > MAX_PORTABILITY_DB_NETWORK_BYTES {
+		return nil, fmt.Errorf("%w: networks take up %d bytes", ErrInvalidPortabilityDB, networkSize)
+	}
+	networkBytes, err := io.ReadAll(io.LimitReader(reader, int64(networkSize)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read all networks: %w", err)
+	}
+	if len(networkBytes) != int(networkSize) {
+		return nil, fmt.Errorf("unable to read all networks: %w", io.ErrUnexpectedEOF)
+	}
+
+	provider := newFilePortabilityProvider()
+	if networkSize > 0 {
+		fields := strings.Split(string(networkBytes), "\n")
+		if len(fields)%3 != 0 {
+			return nil, ErrInvalidPortabilityDB
+		}
+		for i := 0; i < len(fields); i += 3 {
+			provider.networks = append(provider.networks, PortedNumber{Mcc: fields[i], Mnc: fields[i+1], Carrier: fields[i+2]})
+		}
+	}
+
+	// then our numbers, each as a varint of the difference to the previous number and a varint
+	// of the index of its network
+	var numberCount uint32
+	if err := binary.Read(reader, binary.LittleEndian, &numberCount); err != nil {
+		return nil, err
+	}
+	provider.numbers = make(map[uint64]uint32, min(numberCount, maxPortabilityDBPreallocatedNumbers))
+	number := uint64(0)
+	for i := uint32(0); i < numberCount; i++ {
+		diff, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		index, err := binary.ReadUvarint(reader)
+		if err != nil {
+			return nil, err
+		}
+		if index >= uint64(len(provider.networks)) {
+			return nil, ErrInvalidPortabilityDB
+		}
+		// numbers are written in increasing order and none is too big for a uint64, so a
+		// difference which repeats or overflows the number can only come from a corrupt database
+		if (i > 0 && diff == 0) || diff > math.MaxUint64-number {
+			return nil, fmt.Errorf("%w: number %d is out of order", ErrInvalidPortabilityDB, i)
+		}
+		number += diff
+		provider.numbers[number] = uint32(index)
+	}
+	return provider, nil
+}
+
+// WriteBinary writes the ported numbers in a compact binary format, which loads much faster
+// than CSV, see NewPortabilityProviderFromBinary.
+func (p *FilePortabilityProvider) WriteBinary(w io.Writer) error {
+	data := &bytes.Buffer{}
+	data.WriteString(PORTABILITY_DB_MAGIC)
+
+	fields := make([]string, 0, len(p.networks)*3)
+	for _, network := range p.networks {
+		fields = append(fields, network.Mcc, network.Mnc, network.Carrier)
+	}
+	joined := strings.Join(fields, "\n")
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(joined))); err != nil {
+		return err
+	}
+	data.WriteString(joined)
+
+	numbers := make([]uint64, 0, len(p.numbers))
+	for number := range p.numbers {
+		numbers = append(numbers, number)
+	}
+	sort.Slice(numbers, func(i, j int) bool { return numbers[i] < numbers[j] })
+
+	if err := binary.Write(data, binary.LittleEndian, uint32(len(numbers))); err != nil {
+		return err
+	}
+	last := uint64(0)
+	intBuf := make([]byte, binary.MaxVarintLen64)
+	for _, number := range numbers {
+		data.Write(intBuf[:binary.PutUvarint(intBuf, number-last)])
+		data.Write(intBuf[:binary.PutUvarint(intBuf, uint64(p.numbers[number]))])
+		last = number
+	}
+
+	zipWriter := gzip.NewWriter(w)
+	if _, err := zipWriter.Write(data.Bytes()); err != nil {
+		return err
+	}
+	return zipWriter.Close()
+}
+
+// returns the E164 number, with or without its leading plus, as an integer
+func portedNumberKey(e164 string) (uint64, error) {
+	digits := strings.TrimPrefix(e164, string(PLUS_SIGN))
+	if len(digits) < MIN_LENGTH_FOR_NSN || len(digits) > MAX_LENGTH_FOR_NSN+MAX_LENGTH_COUNTRY_CODE || !isDigits(digits) {
+		return 0, ErrInvalidPortedNumber
+	}
+	number, err := strconv.ParseUint(digits, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPortedNumber
+	}
+	return number, nil
+}
+
+func validatePortedNetwork(network PortedNumber) error {
+	if len(network.Mcc) != 3 || !isDigits(network.Mcc) || len(network.Mnc) < 2 || len(network.Mnc) > 3 || !isDigits(network.Mnc) {
+		return ErrInvalidPortedNetwork
+	}
+	if strings.Contains(network.Carrier, "\n") {
+		return ErrInvalidPortedNetwork
+	}
+	return nil
+}
+
+// GetPortedCarrierForNumber returns the carrier the number has been ported to according to the
+// provider, falling back to the carrier we believe its number range belongs to, as returned by
+// GetCarrierForNumber, when it hasn't been ported. network is the network the number has been
+// ported to, or nil if it hasn't been.
+func GetPortedCarrierForNumber(number *PhoneNumber, lang string, provider PortabilityProvider) (carrier string, network *PortedNumber, err error) {
+	return getPortedCarrierForNumber(activeMetadata(), number, lang, provider)
+}
+
+func getPortedCarrierForNumber(md *metadataState, number *PhoneNumber, lang string, provider PortabilityProvider) (carrier string, network *PortedNumber, err error) {
+	if provider != nil {
+		ported, found, err := provider.LookupPorted(format(md, number, E164))
+		if err != nil {
+			return "", nil, err
+		}
+		if found {
+			if ported.Carrier == "" {
+				if known, found := NetworkByMccMnc(ported.Mcc, ported.Mnc); found {
+					ported.Carrier = known.Brand
+					if ported.Carrier == "" {
+						ported.Carrier = known.Operator
+					}
+				}
+			}
+			return ported.Carrier, &ported, nil
+		}
+	}
+	carrier, err = getCarrierForNumber(md, number, lang)
+	return carrier, nil, err
+}
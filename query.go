@@ -0,0 +1,617 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var ErrInvalidQuery = errors.New("invalid query")
+
+// The query operators, conditions are always combined with AND.
+const (
+	QUERY_EQ       = "="
+	QUERY_NEQ      = "!="
+	QUERY_LT       = "<"
+	QUERY_LTE      = "<="
+	QUERY_GT       = ">"
+	QUERY_GTE      = ">="
+	QUERY_IN       = "IN"
+	QUERY_NOT_IN   = "NOT IN"
+	QUERY_LIKE     = "LIKE"
+	QUERY_NOT_LIKE = "NOT LIKE"
+
+	// Operator of a projection counting the numbers in each group
+	QUERY_COUNT = "COUNT"
+
+	// Separates the values of an IN or NOT IN operation in Ops.Value
+	QUERY_VALUE_SEPARATOR = "\x1f"
+)
+
+// Index of the Number fields by their JSON name, the names queries use.
+var numberQueryFields = func() map[string]int {
+	fields := make(map[string]int)
+	numberType := reflect.TypeOf(Number{})
+	for i := 0; i < numberType.NumField(); i++ {
+		name, _, _ := strings.Cut(numberType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = i
+		}
+	}
+	return fields
+}()
+
+// ParseQuery parses a SQL-like query over verified numbers such as:
+//
+//	SELECT carrier_name, COUNT(*) AS total
+//	WHERE country_code = 'NP' AND phone_type_human IN ('MOBILE')
+//	GROUP BY carrier_name
+//
+// Both the SELECT and WHERE keywords are optional, so the above can also be
+// written as "country_code = 'NP' AND phone_type_human IN ('MOBILE') GROUP BY
+// carrier_name". Fields are the JSON names of the Number fields. Conditions
+// support =, != (or <>), <, <=, >, >=, [NOT] IN and [NOT] LIKE, and are
+// combined with AND. A query with GROUP BY and no projection returns the
+// grouped fields and their count.
+func ParseQuery(raw string) (*Query, error) {
+	tokens, err := tokenizeQuery(raw)
+	if err != nil {
+		return nil, err
+	}
+	p := &queryParser{tokens: tokens}
+	query := &Query{Raw: raw}
+
+	if p.acceptKeyword("SELECT") {
+		if err := p.parseProjections(query); err != nil {
+			return nil, err
+		}
+		if !p.done() && !p.isKeyword("WHERE") && !p.isKeyword("GROUP") {
+			return nil, p.errorf("expected WHERE or GROUP BY")
+		}
+	}
+
+	if p.acceptKeyword("WHERE") && (p.done() || p.isKeyword("GROUP")) {
+		return nil, p.errorf("expected a condition after WHERE")
+	}
+	if !p.done() && !p.isKeyword("GROUP") {
+		for {
+			op, err := p.parseCondition()
+			if err != nil {
+				return nil, err
+			}
+			query.Operations = append(query.Operations, op)
+			if !p.acceptKeyword("AND") {
+				break
+			}
+		}
+	}
+
+	if p.acceptKeyword("GROUP") {
+		if !p.acceptKeyword("BY") {
+			return nil, p.errorf("expected BY after GROUP")
+		}
+		for {
+			field, err := p.parseField()
+			if err != nil {
+				return nil, err
+			}
+			query.GroupBy = append(query.GroupBy, field)
+			if !p.accept(",") {
+				break
+			}
+		}
+	}
+
+	if !p.done() {
+		return nil, p.errorf("unexpected %q", p.peek().text)
+	}
+	return query, nil
+}
+
+// QueryNumbers parses raw with ParseQuery and executes it over numbers.
+func QueryNumbers(numbers []Number, raw string) ([]map[string]interface{}, error) {
+	query, err := ParseQuery(raw)
+	if err != nil {
+		return nil, err
+	}
+	return query.Execute(numbers)
+}
+
+// Execute runs the query over numbers, returning a row for each matching
+// number, or for each group if the query groups or counts, keyed by the
+// names in Fields. Groups are returned in the order they first appear in
+// numbers. A Query built by hand rather than by ParseQuery may leave Select
+// empty, in which case Fields are the projected field names, where "count"
+// counts the numbers in each group.
+func (q *Query) Execute(numbers []Number) ([]map[string]interface{}, error) {
+	projections, err := q.projections()
+	if err != nil {
+		return nil, err
+	}
+	// LIKE patterns are compiled once for each execution rather than cached, as they come from
+	// queries and there's no end to them
+	likes := make([]*regexp.Regexp, len(q.Operations))
+	for i, op := range q.Operations {
+		if _, found := numberQueryFields[op.Field]; !found {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidQuery, op.Field)
+		}
+		if op.Operator == QUERY_LIKE || op.Operator == QUERY_NOT_LIKE {
+			likes[i] = likePattern(op.Value)
+		}
+	}
+	for _, field := range q.GroupBy {
+		if _, found := numberQueryFields[field]; !found {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidQuery, field)
+		}
+	}
+
+	var matched []reflect.Value
+	for i := range numbers {
+		number := reflect.ValueOf(numbers[i])
+		matches := true
+		for i, op := range q.Operations {
+			if matches, err = op.matches(number.Field(numberQueryFields[op.Field]), likes[i]); err != nil {
+				return nil, err
+			} else if !matches {
+				break
+			}
+		}
+		if matches {
+			matched = append(matched, number)
+		}
+	}
+
+	aggregate := len(q.GroupBy) > 0
+	for _, proj := range projections {
+		if proj.Operator == QUERY_COUNT {
+			aggregate = true
+		}
+	}
+
+	if !aggregate {
+		rows := make([]map[string]interface{}, 0, len(matched))
+		for _, number := range matched {
+			row := make(map[string]interface{}, len(projections))
+			for _, proj := range projections {
+				row[proj.Alias] = number.Field(numberQueryFields[proj.Field]).Interface()
+			}
+			rows = append(rows, row)
+		}
+		return rows, nil
+	}
+
+	grouped := make(map[string]bool, len(q.GroupBy))
+	for _, field := range q.GroupBy {
+		grouped[field] = true
+	}
+	for _, proj := range projections {
+		if proj.Operator != QUERY_COUNT && !grouped[proj.Field] {
+			return nil, fmt.Errorf("%w: field %q must appear in GROUP BY to be selected", ErrInvalidQuery, proj.Field)
+		}
+	}
+
+	var rows []map[string]interface{}
+	groups := make(map[string]map[string]interface{})
+	counts := make(map[string]int)
+	for _, number := range matched {
+		key := make([]string, len(q.GroupBy))
+		for i, field := range q.GroupBy {
+			key[i] = fmt.Sprint(number.Field(numberQueryFields[field]).Interface())
+		}
+		hash := strings.Join(key, QUERY_VALUE_SEPARATOR)
+
+		counts[hash]++
+		if groups[hash] == nil {
+			row := make(map[string]interface{}, len(projections))
+			for _, proj := range projections {
+				if proj.Operator != QUERY_COUNT {
+					row[proj.Alias] = number.Field(numberQueryFields[proj.Field]).Interface()
+				}
+			}
+			groups[hash] = row
+			rows = append(rows, row)
+		}
+	}
+	// counting without grouping always returns a single row
+	if len(q.GroupBy) == 0 && len(rows) == 0 {
+		groups[""] = make(map[string]interface{})
+		rows = append(rows, groups[""])
+	}
+	for hash, row := range groups {
+		for _, proj := range projections {
+			if proj.Operator == QUERY_COUNT {
+				row[proj.Alias] = counts[hash]
+			}
+		}
+	}
+	return rows, nil
+}
+
+// Returns the projections of the query with their aliases filled in.
+func (q *Query) projections() ([]Ops, error) {
+	projections := append([]Ops(nil), q.Select...)
+	if len(projections) == 0 {
+		for _, field := range q.Fields {
+			if strings.EqualFold(field, QUERY_COUNT) {
+				projections = append(projections, Ops{Raw: field, Field: "*", Operator: QUERY_COUNT, Alias: field})
+			} else {
+				projections = append(projections, Ops{Raw: field, Field: field, Alias: field})
+			}
+		}
+	}
+
+	// default to the grouped fields and their count, or all fields
+	if len(projections) == 0 && len(q.GroupBy) > 0 {
+		for _, field := range q.GroupBy {
+			projections = append(projections, Ops{Field: field, Alias: field})
+		}
+		projections = append(projections, Ops{Field: "*", Operator: QUERY_COUNT, Alias: "count"})
+	} else if len(projections) == 0 {
+		numberType := reflect.TypeOf(Number{})
+		for i := 0; i < numberType.NumField(); i++ {
+			name, _, _ := strings.Cut(numberType.Field(i).Tag.Get("json"), ",")
+			if name != "" && name != "-" {
+				projections = append(projections, Ops{Field: name, Alias: name})
+			}
+		}
+	}
+
+	for i, proj := range projections {
+		if proj.Operator == QUERY_COUNT {
+			if proj.Alias == "" {
+				projections[i].Alias = "count"
+			}
+			continue
+		}
+		if proj.Operator != "" {
+			return nil, fmt.Errorf("%w: unsupported projection %q", ErrInvalidQuery, proj.Operator)
+		}
+		if _, found := numberQueryFields[proj.Field]; !found {
+			return nil, fmt.Errorf("%w: unknown field %q", ErrInvalidQuery, proj.Field)
+		}
+		if proj.Alias == "" {
+			projections[i].Alias = proj.Field
+		}
+	}
+	return projections, nil
+}
+
+// Returns whether the value of the field matches this condition, like being
+// the compiled pattern of a LIKE condition. Values are compared as numbers
+// when both sides are numeric and as strings otherwise.
+func (op Ops) matches(field reflect.Value, like *regexp.Regexp) (bool, error) {
+	value := fmt.Sprint(field.Interface())
+	switch op.Operator {
+	case QUERY_EQ, QUERY_NEQ, QUERY_LT, QUERY_LTE, QUERY_GT, QUERY_GTE:
+		cmp := compareQueryValues(value, op.Value)
+		switch op.Operator {
+		case QUERY_EQ:
+			return cmp == 0, nil
+		case QUERY_NEQ:
+			return cmp != 0, nil
+		case QUERY_LT:
+			return cmp < 0, nil
+		case QUERY_LTE:
+			return cmp <= 0, nil
+		case QUERY_GT:
+			return cmp > 0, nil
+		default:
+			return cmp >= 0, nil
+		}
+	case QUERY_IN, QUERY_NOT_IN:
+		found := false
+		for _, v := range strings.Split(op.Value, QUERY_VALUE_SEPARATOR) {
+			if compareQueryValues(value, v) == 0 {
+				found = true
+				break
+			}
+		}
+		return found == (op.Operator == QUERY_IN), nil
+	case QUERY_LIKE, QUERY_NOT_LIKE:
+		matched := like.MatchString(value)
+		return matched == (op.Operator == QUERY_LIKE), nil
+	}
+	return false, fmt.Errorf("%w: unsupported operator %q", ErrInvalidQuery, op.Operator)
+}
+
+func compareQueryValues(a, b string) int {
+	if x, err := strconv.ParseFloat(a, 64); err == nil {
+		if y, err := strconv.ParseFloat(b, 64); err == nil {
+			switch {
+			case x < y:
+				return -1
+			case x > y:
+				return 1
+			}
+			return 0
+		}
+	}
+	return strings.Compare(a, b)
+}
+
+// Converts a LIKE pattern, where % matches any run of characters and _ any
+// single character, into a case insensitive regular expression.
+func likePattern(pattern string) *regexp.Regexp {
+	regex := NewBuilderString("(?is)^")
+	for _, r := range pattern {
+		switch r {
+		case '%':
+			regex.WriteString(".*")
+		case '_':
+			regex.WriteString(".")
+		default:
+			regex.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	regex.WriteString("$")
+	return regexp.MustCompile(regex.String())
+}
+
+type queryTokenKind int
+
+const (
+	queryIdent queryTokenKind = iota
+	queryString
+	queryNumber
+	querySymbol
+)
+
+type queryToken struct {
+	kind queryTokenKind
+	text string
+	pos  int
+}
+
+func tokenizeQuery(raw string) ([]queryToken, error) {
+	var tokens []queryToken
+	runes := []rune(raw)
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '\'' || r == '"':
+			// quoted string, with the quote escaped by doubling it
+			value := NewBuilder(nil)
+			start := i
+			i++
+			for {
+				if i >= len(runes) {
+					return nil, fmt.Errorf("%w: unterminated string at offset %d", ErrInvalidQuery, start)
+				}
+				if runes[i] == r {
+					if i+1 < len(runes) && runes[i+1] == r {
+						value.WriteRune(r)
+						i += 2
+						continue
+					}
+					i++
+					break
+				}
+				value.WriteRune(runes[i])
+				i++
+			}
+			tokens = append(tokens, queryToken{queryString, value.String(), start})
+		case unicode.IsDigit(r) || (r == '-' && i+1 < len(runes) && unicode.IsDigit(runes[i+1])):
+			start := i
+			i++
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, queryToken{queryNumber, string(runes[start:i]), start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, queryToken{queryIdent, string(runes[start:i]), start})
+		case strings.ContainsRune("<>!", r) && i+1 < len(runes) && (runes[i+1] == '=' || (r == '<' && runes[i+1] == '>')):
+			tokens = append(tokens, queryToken{querySymbol, string(runes[i : i+2]), i})
+			i += 2
+		case strings.ContainsRune("=<>(),*", r):
+			tokens = append(tokens, queryToken{querySymbol, string(r), i})
+			i++
+		default:
+			return nil, fmt.Errorf("%w: unexpected %q at offset %d", ErrInvalidQuery, r, i)
+		}
+	}
+	return tokens, nil
+}
+
+type queryParser struct {
+	tokens []queryToken
+	pos    int
+}
+
+func (p *queryParser) done() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *queryParser) peek() queryToken {
+	if p.done() {
+		return queryToken{}
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *queryParser) isKeyword(keyword string) bool {
+	token := p.peek()
+	return !p.done() && token.kind == queryIdent && strings.EqualFold(token.text, keyword)
+}
+
+func (p *queryParser) acceptKeyword(keyword string) bool {
+	if p.isKeyword(keyword) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *queryParser) accept(symbol string) bool {
+	token := p.peek()
+	if !p.done() && token.kind == querySymbol && token.text == symbol {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *queryParser) errorf(format string, args ...interface{}) error {
+	offset := len(p.tokens)
+	if !p.done() {
+		offset = p.peek().pos
+	} else if len(p.tokens) > 0 {
+		last := p.tokens[len(p.tokens)-1]
+		offset = last.pos + len([]rune(last.text))
+	}
+	return fmt.Errorf("%w: %s at offset %d", ErrInvalidQuery, fmt.Sprintf(format, args...), offset)
+}
+
+func (p *queryParser) parseField() (string, error) {
+	token := p.peek()
+	if p.done() || token.kind != queryIdent {
+		return "", p.errorf("expected field name")
+	}
+	p.pos++
+	return strings.ToLower(token.text), nil
+}
+
+func (p *queryParser) parseValue() (string, error) {
+	token := p.peek()
+	if p.done() || (token.kind != queryString && token.kind != queryNumber && token.kind != queryIdent) {
+		return "", p.errorf("expected value")
+	}
+	p.pos++
+	return token.text, nil
+}
+
+func (p *queryParser) parseProjections(query *Query) error {
+	for {
+		start := p.pos
+		var proj Ops
+		if p.acceptKeyword(QUERY_COUNT) {
+			if !p.accept("(") {
+				return p.errorf("expected ( after COUNT")
+			}
+			if !p.accept("*") {
+				return p.errorf("expected * in COUNT")
+			}
+			proj.Field = "*"
+			if !p.accept(")") {
+				return p.errorf("expected )")
+			}
+			proj.Operator = QUERY_COUNT
+			proj.Alias = "count"
+		} else if p.accept("*") {
+			// all fields, which is the same as no projection
+			if p.accept(",") {
+				return p.errorf("* can't be combined with other fields")
+			}
+			return nil
+		} else {
+			field, err := p.parseField()
+			if err != nil {
+				return err
+			}
+			proj.Field = field
+			proj.Alias = field
+		}
+		if p.acceptKeyword("AS") {
+			alias, err := p.parseValue()
+			if err != nil {
+				return err
+			}
+			proj.Alias = alias
+		}
+		proj.Raw = p.rawSince(start)
+		query.Select = append(query.Select, proj)
+		query.Fields = append(query.Fields, proj.Alias)
+		if !p.accept(",") {
+			return nil
+		}
+	}
+}
+
+func (p *queryParser) parseCondition() (Ops, error) {
+	start := p.pos
+	field, err := p.parseField()
+	if err != nil {
+		return Ops{}, err
+	}
+	op := Ops{Field: field}
+
+	switch {
+	case p.acceptKeyword("NOT"):
+		if p.acceptKeyword("IN") {
+			op.Operator = QUERY_NOT_IN
+		} else if p.acceptKeyword("LIKE") {
+			op.Operator = QUERY_NOT_LIKE
+		} else {
+			return Ops{}, p.errorf("expected IN or LIKE after NOT")
+		}
+	case p.acceptKeyword("IN"):
+		op.Operator = QUERY_IN
+	case p.acceptKeyword("LIKE"):
+		op.Operator = QUERY_LIKE
+	default:
+		token := p.peek()
+		if p.done() || token.kind != querySymbol {
+			return Ops{}, p.errorf("expected operator")
+		}
+		switch token.text {
+		case "=", "!=", "<", "<=", ">", ">=":
+			op.Operator = token.text
+		case "<>":
+			op.Operator = QUERY_NEQ
+		default:
+			return Ops{}, p.errorf("expected operator")
+		}
+		p.pos++
+	}
+
+	if op.Operator == QUERY_IN || op.Operator == QUERY_NOT_IN {
+		if !p.accept("(") {
+			return Ops{}, p.errorf("expected ( after %s", op.Operator)
+		}
+		var values []string
+		for {
+			value, err := p.parseValue()
+			if err != nil {
+				return Ops{}, err
+			}
+			values = append(values, value)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if !p.accept(")") {
+			return Ops{}, p.errorf("expected )")
+		}
+		op.Value = strings.Join(values, QUERY_VALUE_SEPARATOR)
+	} else {
+		value, err := p.parseValue()
+		if err != nil {
+			return Ops{}, err
+		}
+		op.Value = value
+	}
+	op.Raw = p.rawSince(start)
+	return op, nil
+}
+
+// Returns the tokens consumed since start, joined by spaces.
+func (p *queryParser) rawSince(start int) string {
+	parts := make([]string, 0, p.pos-start)
+	for _, token := range p.tokens[start:p.pos] {
+		if token.kind == queryString {
+			parts = append(parts, "'"+strings.ReplaceAll(token.text, "'", "''")+"'")
+		} else {
+			parts = append(parts, token.text)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,97 @@
+package services
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+// The characters separating numbers in a cell holding several, as in "+84 8 829 5368 / 822 6111".
+const DEFAULT_NUMBER_SEPARATORS = "/;|"
+
+// splits the cell into the numbers it holds, those without an international prefix being for the
+// region. A number without a plus after another number is first taken to be a number of the same
+// region as that one, so "+977 1 4123456 / 9841234567" is split into "+977 1 4123456" and
+// "+9779841234567". If it isn't a valid number of that region, is shorter than the number before it
+// and doesn't start with the national prefix of the region, it is taken to be shorthand for a
+// number sharing its prefix, so "+84 8 829 5368 / 822 6111" is split into "+84 8 829 5368" and
+// "+848822 6111". Shorthand can start with zeros, as in "+1 650 253 0000 / 0001", so these aren't
+// taken as an international prefix.
+func splitNumbers(cell, separators, region string) []string {
+	parts := strings.FieldsFunc(cell, func(r rune) bool { return strings.ContainsRune(separators, r) })
+
+	numbers := make([]string, 0, len(parts))
+	previous, previousRegion := "", region
+	for _, part := range parts {
+		part = strings.TrimSpace(part)
+		digits := leadingDigits(part)
+		if digits == "" {
+			continue
+		}
+
+		if previous != "" && !strings.HasPrefix(part, "+") {
+			num, err := phonenumbers.Parse(part, previousRegion)
+			if err == nil && phonenumbers.IsValidNumber(num) {
+				// numbers of another region than that of the row need their calling code
+				if previousRegion != region {
+					part = phonenumbers.Format(num, phonenumbers.E164)
+					digits = part
+				}
+			} else if isShorthand(digits, previous, previousRegion) {
+				previousDigits := strings.TrimPrefix(previous, "+")
+				prefix := previousDigits[:len(previousDigits)-len(digits)]
+				if strings.HasPrefix(previous, "+") {
+					prefix = "+" + prefix
+				}
+				part = prefix + part
+				digits = leadingDigits(part)
+			}
+		}
+
+		numbers = append(numbers, part)
+		previous, previousRegion = digits, numberRegion(digits, region)
+	}
+	return numbers
+}
+
+// returns whether the digits of a number are shorthand for the end of the previous number, being
+// shorter than it and not starting with the national prefix of its region
+func isShorthand(digits, previous, previousRegion string) bool {
+	if len(digits) >= len(strings.TrimPrefix(previous, "+")) {
+		return false
+	}
+	nationalPrefix := phonenumbers.GetNddPrefixForRegion(previousRegion, true)
+	return nationalPrefix == "" || !strings.HasPrefix(digits, nationalPrefix)
+}
+
+// returns the region of the number, or the region passed in if it can't be parsed or has no region
+func numberRegion(digits, region string) string {
+	num, err := phonenumbers.Parse(digits, region)
+	if err != nil {
+		return region
+	}
+	if numRegion := phonenumbers.GetRegionCodeForNumber(num); numRegion != "" && numRegion != phonenumbers.UNKNOWN_REGION {
+		return numRegion
+	}
+	return region
+}
+
+// returns the digits of the number before any extension, with its leading plus if it has one
+func leadingDigits(number string) string {
+	digits := &strings.Builder{}
+	for i, r := range number {
+		if unicode.IsLetter(r) {
+			break
+		}
+		if r >= '0' && r <= '9' {
+			digits.WriteRune(r)
+		} else if r == '+' && i == 0 {
+			digits.WriteRune(r)
+		}
+	}
+	if digits.String() == "+" {
+		return ""
+	}
+	return digits.String()
+}
@@ -0,0 +1,463 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown record format")
+	ErrNotAnArray    = errors.New("the input is not a JSON array")
+	ErrNotAnObject   = errors.New("the record is not a JSON object")
+)
+
+// Format is the format records are read or written in.
+type Format string
+
+const (
+	FORMAT_CSV   Format = "csv"
+	FORMAT_TSV   Format = "tsv"
+	FORMAT_JSONL Format = "jsonl"
+	FORMAT_JSON  Format = "json"
+	FORMAT_XLSX  Format = "xlsx"
+)
+
+// RecordReader reads records of string fields, the first of which is the header naming the
+// fields of those after it. Read returns io.EOF once there are no more records, and a
+// *RecordError for a record which can't be read but is followed by others that can.
+// *csv.Reader is a RecordReader, though it returns *csv.ParseError for bad records.
+type RecordReader interface {
+	Read() ([]string, error)
+}
+
+// RecordWriter writes records of string fields, the first of which is the header naming the
+// fields of those after it. Close finishes the output, after which nothing more can be written.
+// It doesn't close the underlying writer.
+type RecordWriter interface {
+	Write(record []string) error
+	Close() error
+}
+
+// KeyDropper is a RecordReader of JSON objects, whose header is the keys of the first object.
+// DroppedKeys returns the keys of later objects which aren't in the header, and so were left out
+// of their records, with the number of records each was left out of.
+type KeyDropper interface {
+	RecordReader
+	DroppedKeys() map[string]int
+}
+
+// RecordError is a record which couldn't be read.
+type RecordError struct {
+	// The line of the record, or for JSON arrays and XLSX its position in the array or row in the
+	// sheet.
+	Line int
+
+	Err error
+}
+
+func (e *RecordError) Error() string {
+	return fmt.Sprintf("record on line %d: %v", e.Line, e.Err)
+}
+
+func (e *RecordError) Unwrap() error {
+	return e.Err
+}
+
+// DetectFormat returns the format of a file from the extension of its name, or if that doesn't
+// say from the start of its content, falling back to CSV. Content is taken to be TSV if its first
+// line has more tabs than commas.
+func DetectFormat(name string, content []byte) Format {
+	if format := formatForName(name); format != "" {
+		return format
+	}
+
+	content = bytes.TrimLeft(bytes.TrimPrefix(content, []byte("\xef\xbb\xbf")), " \t\r\n")
+	switch {
+	case bytes.HasPrefix(content, []byte("PK\x03\x04")):
+		return FORMAT_XLSX
+	case bytes.HasPrefix(content, []byte("[")):
+		return FORMAT_JSON
+	case bytes.HasPrefix(content, []byte("{")):
+		return FORMAT_JSONL
+	}
+
+	line, _, _ := bytes.Cut(content, []byte("\n"))
+	if bytes.Count(line, []byte("\t")) > bytes.Count(line, []byte(",")) {
+		return FORMAT_TSV
+	}
+	return FORMAT_CSV
+}
+
+// returns the format of the extension of name, or an empty string if it isn't one we know
+func formatForName(name string) Format {
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".csv":
+		return FORMAT_CSV
+	case ".tsv", ".tab":
+		return FORMAT_TSV
+	case ".jsonl", ".ndjson":
+		return FORMAT_JSONL
+	case ".json":
+		return FORMAT_JSON
+	case ".xlsx":
+		return FORMAT_XLSX
+	}
+	return ""
+}
+
+// returns the format, detected from the start of r if empty, and a reader of all of r
+func detectFormat(r io.Reader, format Format) (io.Reader, Format) {
+	if format != "" {
+		return r, format
+	}
+	buffered := bufio.NewReader(r)
+	content, _ := buffered.Peek(512)
+	return buffered, DetectFormat("", content)
+}
+
+// NewRecordReader returns a reader of the records of r in the format, which is detected from
+// the content of r if empty. comma is the separator of CSV, a comma if 0. XLSX is read into
+// memory in full, as its parts can come in any order, and only its first sheet is read.
+func NewRecordReader(r io.Reader, format Format, comma rune) (RecordReader, error) {
+	r, format = detectFormat(r, format)
+
+	switch format {
+	case FORMAT_CSV, FORMAT_TSV:
+		reader := csv.NewReader(r)
+		reader.Comma = comma
+		if format == FORMAT_TSV {
+			reader.Comma = '\t'
+		} else if comma == 0 {
+			reader.Comma = ','
+		}
+		reader.TrimLeadingSpace = true
+		return &csvRecordReader{reader: reader}, nil
+	case FORMAT_JSONL:
+		return &jsonlReader{reader: bufio.NewReader(r)}, nil
+	case FORMAT_JSON:
+		return &jsonArrayReader{decoder: json.NewDecoder(r)}, nil
+	case FORMAT_XLSX:
+		return newXLSXReader(r)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+}
+
+// NewRecordWriter returns a writer of records to w in the format. comma is the separator of
+// CSV, a comma if 0. JSON Lines and JSON arrays are written as objects keyed by the header, with
+// every value a string.
+func NewRecordWriter(w io.Writer, format Format, comma rune) (RecordWriter, error) {
+	switch format {
+	case FORMAT_CSV, FORMAT_TSV:
+		writer := csv.NewWriter(w)
+		if format == FORMAT_TSV {
+			writer.Comma = '\t'
+		} else if comma != 0 {
+			writer.Comma = comma
+		}
+		return &csvRecordWriter{writer: writer}, nil
+	case FORMAT_JSONL, FORMAT_JSON:
+		return &jsonWriter{writer: bufio.NewWriter(w), array: format == FORMAT_JSON}, nil
+	case FORMAT_XLSX:
+		return newXLSXWriter(w)
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnknownFormat, format)
+}
+
+type csvRecordReader struct {
+	reader *csv.Reader
+}
+
+func (r *csvRecordReader) Read() ([]string, error) {
+	record, err := r.reader.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return record, &RecordError{Line: parseErr.StartLine, Err: parseErr.Err}
+	}
+	return record, err
+}
+
+type csvRecordWriter struct {
+	writer *csv.Writer
+}
+
+func (w *csvRecordWriter) Write(record []string) error {
+	return w.writer.Write(record)
+}
+
+func (w *csvRecordWriter) Close() error {
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+// objectRecords turns JSON objects into records, the header being the keys of the first object.
+// Keys the first object doesn't have are left out of later records and counted in dropped, as
+// exports often only include the keys an object has values for, and keys it has which later
+// objects don't are empty.
+type objectRecords struct {
+	header  []string
+	columns map[string]int
+	dropped map[string]int
+
+	// the first object, read to find the header but not yet returned
+	first []string
+}
+
+// returns the record of the object read from data, starting the header if this is the first
+func (o *objectRecords) record(data []byte) ([]string, error) {
+	keys, values, err := decodeObject(data)
+	if err != nil {
+		return values, err
+	}
+
+	if o.header == nil {
+		o.header = keys
+		o.columns = make(map[string]int, len(keys))
+		for i, key := range keys {
+			if _, found := o.columns[key]; !found {
+				o.columns[key] = i
+			}
+		}
+		return values, nil
+	}
+
+	record := make([]string, len(o.header))
+	for i, key := range keys {
+		if column, found := o.columns[key]; found {
+			record[column] = values[i]
+		} else {
+			if o.dropped == nil {
+				o.dropped = make(map[string]int)
+			}
+			o.dropped[key]++
+		}
+	}
+	return record, nil
+}
+
+func (o *objectRecords) droppedKeys() map[string]int {
+	dropped := make(map[string]int, len(o.dropped))
+	for key, count := range o.dropped {
+		dropped[key] = count
+	}
+	return dropped
+}
+
+// returns the keys of the JSON object in data, in order, and their values as strings. Strings
+// are unquoted, null is empty and anything else is kept as JSON.
+func decodeObject(data []byte) ([]string, []string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, nil, err
+	}
+	if token != json.Delim('{') {
+		return nil, nil, ErrNotAnObject
+	}
+
+	var keys, values []string
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, nil, err
+		}
+		var raw json.RawMessage
+		if err := decoder.Decode(&raw); err != nil {
+			return nil, nil, err
+		}
+		keys = append(keys, token.(string))
+
+		value := string(raw)
+		switch {
+		case value == "null":
+			value = ""
+		case strings.HasPrefix(value, `"`):
+			if err := json.Unmarshal(raw, &value); err != nil {
+				return nil, nil, err
+			}
+		}
+		values = append(values, value)
+	}
+	return keys, values, nil
+}
+
+// reads a JSON object from each line, skipping blank lines
+type jsonlReader struct {
+	reader  *bufio.Reader
+	line    int
+	objects objectRecords
+}
+
+func (r *jsonlReader) Read() ([]string, error) {
+	if r.objects.first != nil {
+		record := r.objects.first
+		r.objects.first = nil
+		return record, nil
+	}
+
+	for {
+		data, err := r.reader.ReadBytes('\n')
+		if len(bytes.TrimSpace(data)) == 0 {
+			if err != nil {
+				return nil, err
+			}
+			r.line++
+			continue
+		}
+		r.line++
+		if err != nil && err != io.EOF {
+			return nil, err
+		}
+
+		started := r.objects.header != nil
+		record, err := r.objects.record(data)
+		if err != nil {
+			if !started {
+				return nil, &RecordError{Line: r.line, Err: err}
+			}
+			return record, &RecordError{Line: r.line, Err: err}
+		}
+		if !started {
+			r.objects.first = record
+			return r.objects.header, nil
+		}
+		return record, nil
+	}
+}
+
+func (r *jsonlReader) DroppedKeys() map[string]int {
+	return r.objects.droppedKeys()
+}
+
+// reads the objects of a JSON array
+type jsonArrayReader struct {
+	decoder  *json.Decoder
+	started  bool
+	position int
+	objects  objectRecords
+}
+
+func (r *jsonArrayReader) Read() ([]string, error) {
+	if r.objects.first != nil {
+		record := r.objects.first
+		r.objects.first = nil
+		return record, nil
+	}
+
+	if !r.started {
+		token, err := r.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if token != json.Delim('[') {
+			return nil, ErrNotAnArray
+		}
+		r.started = true
+	}
+
+	if !r.decoder.More() {
+		if _, err := r.decoder.Token(); err != nil {
+			return nil, err
+		}
+		return nil, io.EOF
+	}
+
+	var raw json.RawMessage
+	if err := r.decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+	r.position++
+
+	started := r.objects.header != nil
+	record, err := r.objects.record(raw)
+	if err != nil {
+		if !started {
+			return nil, &RecordError{Line: r.position, Err: err}
+		}
+		return record, &RecordError{Line: r.position, Err: err}
+	}
+	if !started {
+		r.objects.first = record
+		return r.objects.header, nil
+	}
+	return record, nil
+}
+
+func (r *jsonArrayReader) DroppedKeys() map[string]int {
+	return r.objects.droppedKeys()
+}
+
+// writes each record as a JSON object on its own line, or as an element of an array
+type jsonWriter struct {
+	writer  *bufio.Writer
+	array   bool
+	header  []string
+	written int
+
+	// encodes strings without escaping HTML, as the output isn't for web pages
+	buffer  bytes.Buffer
+	encoder *json.Encoder
+}
+
+func (w *jsonWriter) Write(record []string) error {
+	if w.header == nil {
+		w.header = append([]string{}, record...)
+		w.encoder = json.NewEncoder(&w.buffer)
+		w.encoder.SetEscapeHTML(false)
+		return nil
+	}
+
+	switch {
+	case w.array && w.written == 0:
+		w.writer.WriteString("[\n")
+	case w.array:
+		w.writer.WriteString(",\n")
+	}
+	w.written++
+
+	w.writer.WriteByte('{')
+	for i, key := range w.header {
+		if i > 0 {
+			w.writer.WriteByte(',')
+		}
+		w.writeString(key)
+		w.writer.WriteByte(':')
+		if i < len(record) {
+			w.writeString(record[i])
+		} else {
+			w.writer.WriteString(`""`)
+		}
+	}
+	if w.array {
+		return w.writer.WriteByte('}')
+	}
+
+	// bufio errors stick, so the last write fails if any before it did
+	_, err := w.writer.WriteString("}\n")
+	return err
+}
+
+func (w *jsonWriter) Close() error {
+	if w.array {
+		if w.written == 0 {
+			w.writer.WriteString("[]\n")
+		} else {
+			w.writer.WriteString("\n]\n")
+		}
+	}
+	return w.writer.Flush()
+}
+
+func (w *jsonWriter) writeString(s string) {
+	w.buffer.Reset()
+	w.encoder.Encode(s)
+	w.writer.Write(bytes.TrimSuffix(w.buffer.Bytes(), []byte("\n")))
+}
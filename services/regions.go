@@ -0,0 +1,87 @@
+package services
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"unicode"
+
+	"github.com/nyaruka/phonenumbers"
+)
+
+var (
+	// the entries of phonenumbers.Countries keyed by their upper cased names
+	countriesByName     map[string]phonenumbers.Country
+	countriesByNameOnce sync.Once
+)
+
+// resolves the value of a region column, an ISO region code, a country name or a dial code such as
+// +977, to the region numbers of the row are parsed for. Returns false if the value isn't any of
+// these.
+func resolveRegion(value string) (string, bool) {
+	value = strings.ToUpper(strings.TrimSpace(value))
+	if value == "" {
+		return "", false
+	}
+
+	if isDialCode(value) {
+		code, err := strconv.Atoi(strings.TrimLeft(strings.TrimPrefix(value, "+"), "0"))
+		if err != nil {
+			return "", false
+		}
+		return regionForDialCode(code)
+	}
+
+	if phonenumbers.GetSupportedRegions()[value] {
+		return value, true
+	}
+	if country, found := phonenumbers.Countries[value]; found {
+		return regionForCountry(country)
+	}
+
+	countriesByNameOnce.Do(func() {
+		countriesByName = make(map[string]phonenumbers.Country, len(phonenumbers.Countries))
+		for _, country := range phonenumbers.Countries {
+			countriesByName[strings.ToUpper(country.Name)] = country
+		}
+	})
+	if country, found := countriesByName[value]; found {
+		return regionForCountry(country)
+	}
+	return "", false
+}
+
+// returns the region of the country, which is its code unless that isn't a region with the
+// country's dial code, as is the case for the UK entry and a few others, when it is the main
+// region of the dial code
+func regionForCountry(country phonenumbers.Country) (string, bool) {
+	code, err := strconv.Atoi(strings.TrimPrefix(country.Phone, "+"))
+	if err != nil {
+		return "", false
+	}
+	if phonenumbers.GetCountryCodeForRegion(country.Code) == code {
+		return country.Code, true
+	}
+	return regionForDialCode(code)
+}
+
+func regionForDialCode(code int) (string, bool) {
+	region := phonenumbers.GetRegionCodeForCountryCode(code)
+	if region == phonenumbers.UNKNOWN_REGION || region == phonenumbers.REGION_CODE_FOR_NON_GEO_ENTITY {
+		return "", false
+	}
+	return region, true
+}
+
+func isDialCode(value string) bool {
+	digits := strings.TrimPrefix(value, "+")
+	if digits == "" {
+		return false
+	}
+	for _, r := range digits {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
@@ -1,139 +1,805 @@
 package services
 
 import (
-	"bufio"
-	"bytes"
 	"encoding/csv"
 	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/nyaruka/phonenumbers"
 )
 
-func GetCsvHeader(scanner *bufio.Scanner, comma rune) map[int]string {
-	scanner.Scan()
-	r := csv.NewReader(strings.NewReader(scanner.Text()))
-	r.Comma = comma
-	r.TrimLeadingSpace = true
-	colHeader, _ := r.Read()
-	colPosition := make(map[int]string)
-	for key, col := range colHeader {
-		colPosition[key] = col
+var (
+	ErrMissingPhoneColumn  = errors.New("the phone column is not in the header")
+	ErrMissingRegionColumn = errors.New("the region column is not in the header")
+	ErrUnknownField        = errors.New("unknown enrichment field")
+	ErrDuplicateColumn     = errors.New("the output has more than one column with the same name")
+)
+
+// Layout is how rows with several numbers are written.
+type Layout int
+
+const (
+	// Each row is written once, with the fields of each number in columns of their own, see
+	// Options.NumbersPerColumn.
+	LAYOUT_COLUMN_PER_NUMBER Layout = iota
+	// Each row is written once for each of its numbers, with the fields of that number.
+	LAYOUT_ROW_PER_NUMBER
+)
+
+// The column naming the phone column each row came from, added when rows are written per number
+// and there are several phone columns.
+const PHONE_COLUMN Field = "phone_column"
+
+// The column saying whether the value of the region column of each row couldn't be resolved,
+// added when there is a region column.
+const REGION_UNRESOLVED Field = "region_unresolved"
+
+// Field is a result of validating a number which can be added to each row.
+type Field string
+
+const (
+	FIELD_VALIDATED          Field = "validated"
+	FIELD_INVALID            Field = "invalid"
+	FIELD_INVALID_REASON     Field = "invalid_reason"
+	FIELD_REGION             Field = "region"
+	FIELD_DIAL_CODE          Field = "dial_code"
+	FIELD_PHONE_TYPE_CODE    Field = "phone_type_code"
+	FIELD_PHONE_TYPE_LABEL   Field = "phone_type_label"
+	FIELD_CARRIER_NAME       Field = "carrier_name"
+	FIELD_CARRIER_MCC        Field = "carrier_mcc"
+	FIELD_CARRIER_MNC        Field = "carrier_mnc"
+	FIELD_CARRIER_NNC        Field = "carrier_nnc"
+	FIELD_CARRIER_CONFIDENCE Field = "carrier_confidence"
+	FIELD_CARRIER_AMBIGUOUS  Field = "carrier_ambiguous"
+	FIELD_CARRIER_SOURCE     Field = "carrier_source"
+	FIELD_TIMEZONE           Field = "timezone"
+	FIELD_GEOCODING          Field = "geocoding"
+)
+
+// DefaultFields are the fields added to each row when Options.Fields is empty, in order.
+var DefaultFields = []Field{
+	FIELD_VALIDATED, FIELD_INVALID, FIELD_INVALID_REASON, FIELD_REGION, FIELD_DIAL_CODE,
+	FIELD_PHONE_TYPE_CODE, FIELD_PHONE_TYPE_LABEL, FIELD_CARRIER_NAME, FIELD_CARRIER_MCC, FIELD_CARRIER_MNC,
+	FIELD_CARRIER_NNC, FIELD_CARRIER_CONFIDENCE, FIELD_CARRIER_AMBIGUOUS, FIELD_CARRIER_SOURCE,
+}
+
+// the value of each field for a verified number
+var fieldValues = map[Field]func(num *phonenumbers.Number) string{
+	FIELD_VALIDATED:          func(num *phonenumbers.Number) string { return num.Phone },
+	FIELD_INVALID:            func(num *phonenumbers.Number) string { return strconv.FormatBool(num.Invalid) },
+	FIELD_INVALID_REASON:     func(num *phonenumbers.Number) string { return num.InvalidReason },
+	FIELD_REGION:             func(num *phonenumbers.Number) string { return num.CountryCode },
+	FIELD_DIAL_CODE:          func(num *phonenumbers.Number) string { return strconv.Itoa(int(num.DialCode)) },
+	FIELD_PHONE_TYPE_CODE:    func(num *phonenumbers.Number) string { return strconv.Itoa(num.PhoneType) },
+	FIELD_PHONE_TYPE_LABEL:   func(num *phonenumbers.Number) string { return num.PhoneTypeHuman },
+	FIELD_CARRIER_NAME:       func(num *phonenumbers.Number) string { return num.CarrierName },
+	FIELD_CARRIER_MCC:        func(num *phonenumbers.Number) string { return num.CarrierMcc },
+	FIELD_CARRIER_MNC:        func(num *phonenumbers.Number) string { return num.CarrierMnc },
+	FIELD_CARRIER_NNC:        func(num *phonenumbers.Number) string { return num.CarrierNnc },
+	FIELD_CARRIER_CONFIDENCE: func(num *phonenumbers.Number) string { return num.CarrierConfidence },
+	FIELD_CARRIER_AMBIGUOUS:  func(num *phonenumbers.Number) string { return strconv.FormatBool(num.CarrierAmbiguous) },
+	FIELD_CARRIER_SOURCE:     func(num *phonenumbers.Number) string { return num.CarrierSource },
+	FIELD_TIMEZONE:           func(num *phonenumbers.Number) string { return num.Timezone },
+	FIELD_GEOCODING:          func(num *phonenumbers.Number) string { return num.Location },
+}
+
+// Options controls how a CSV, or records in another format, of phone numbers is validated.
+type Options struct {
+	// The column holding the phone numbers, or the columns if there are several.
+	PhoneKey  string
+	PhoneKeys []string
+
+	// The characters separating several numbers in a cell, DEFAULT_NUMBER_SEPARATORS if empty.
+	// When DisableSplitting is set each cell is taken to hold a single number.
+	NumberSeparators string
+	DisableSplitting bool
+
+	// How rows with several numbers are written and for LAYOUT_COLUMN_PER_NUMBER how many numbers
+	// of each phone column are given columns, by default 1. Further numbers are dropped.
+	Layout           Layout
+	NumbersPerColumn int
+
+	// The formats of the input and output. When empty the input format is detected from the name
+	// or content of the input, and the output format from the name of the output, falling back to
+	// the input format.
+	InputFormat  Format
+	OutputFormat Format
+
+	// The separators of CSV input and output, the output defaulting to that of the input and
+	// the input to a comma.
+	Comma       rune
+	OutputComma rune
+
+	// Region used for numbers not written in international format.
+	DefaultPrefix string
+
+	// The column holding the region of each row, overriding DefaultPrefix for its numbers. Its
+	// values can be region codes, country names or dial codes such as +977, looked up in
+	// phonenumbers.Countries. Rows with an empty or unknown value fall back to DefaultPrefix, the
+	// latter being reported in the REGION_UNRESOLVED column.
+	RegionColumn string
+
+	// The fields added to each row after the columns of the input, in order, DefaultFields if
+	// empty. Carriers are only looked up when a carrier field is included and numbers are only
+	// geocoded when FIELD_GEOCODING is.
+	Fields []Field
+
+	// The names of the columns of fields, by default the name of the field, except for
+	// FIELD_VALIDATED, FIELD_INVALID and FIELD_INVALID_REASON which are suffixed with an
+	// underscore and PhoneKey. When each row has columns for several numbers every name is
+	// suffixed with its phone column, and from the second number of a column on with the position
+	// of the number too, as in validated_mobile_2. A default name the input already has a column
+	// of, such as region when that is the RegionColumn, is suffixed with an underscore and the
+	// phone column too.
+	FieldNames map[Field]string
+
+	// Number of goroutines numbers are verified on, defaults to GOMAXPROCS.
+	Workers int
+
+	// Called with each row of the output, keyed by column, once our fields are added, in the way
+	// phonenumbers.Worker is. It can change the values of the row and set those of WorkerColumns,
+	// which are added to the output after our fields unless the input has them already, and drops
+	// the row by returning false. It is called from several goroutines at once.
+	Worker        func(data map[string]string) bool
+	WorkerColumns []string
+
+	// Receives the rows which can't be read, such as those which aren't valid CSV or don't have
+	// as many fields as the header, as CSV of their line number, the error and whatever fields
+	// could be read. When nil the first such row stops validation with an error.
+	Rejects io.Writer
+}
+
+func (o Options) comma() rune {
+	if o.Comma == 0 {
+		return ','
 	}
-	return colPosition
+	return o.Comma
 }
 
-func ValidatePhoneReader(reader io.Reader, phoneKey string, comma rune, defaultPrefix string) []map[string]string {
-	scanner := bufio.NewScanner(reader)
-	colPosition := GetCsvHeader(scanner, comma)
-	for k, v := range colPosition {
-		colPosition[k] = clean([]byte(v))
+func (o Options) outputComma() rune {
+	if o.OutputComma == 0 {
+		return o.comma()
 	}
-	jobs := make(chan []byte)
-	results := make(chan map[string]string)
+	return o.OutputComma
+}
 
-	wg := new(sync.WaitGroup)
-	for w := 1; w <= 2; w++ {
-		wg.Add(1)
-		go ProcessNumber(jobs, results, wg, colPosition, phoneKey, comma, defaultPrefix)
+func (o Options) fields() []Field {
+	if len(o.Fields) == 0 {
+		return DefaultFields
 	}
-	go func() {
-		for scanner.Scan() {
-			jobs <- scanner.Bytes()
+	return o.Fields
+}
+
+func (o Options) phoneKeys() []string {
+	if len(o.PhoneKeys) == 0 {
+		return []string{o.PhoneKey}
+	}
+	return o.PhoneKeys
+}
+
+func (o Options) numbersPerColumn() int {
+	if o.Layout != LAYOUT_COLUMN_PER_NUMBER || o.NumbersPerColumn <= 0 {
+		return 1
+	}
+	return o.NumbersPerColumn
+}
+
+// returns the name of the column of the field for the index'th number, counting from 0, of the
+// phone column key
+func (o Options) fieldName(field Field, key string, index int) string {
+	name := o.FieldNames[field]
+	keys := o.phoneKeys()
+
+	// a column per number needs every name to say which number it is for
+	if o.Layout == LAYOUT_COLUMN_PER_NUMBER && (len(keys) > 1 || o.numbersPerColumn() > 1) {
+		if name == "" {
+			name = string(field)
 		}
-		close(jobs)
-	}()
+		name += "_" + key
+		if index > 0 {
+			name += "_" + strconv.Itoa(index+1)
+		}
+		return name
+	}
 
-	go func() {
-		wg.Wait()
-		close(results)
-	}()
+	if name != "" {
+		return name
+	}
+	switch field {
+	case FIELD_VALIDATED, FIELD_INVALID, FIELD_INVALID_REASON:
+		// rows for numbers from several columns say which column in PHONE_COLUMN
+		if len(keys) == 1 {
+			return string(field) + "_" + key
+		}
+	}
+	return string(field)
+}
 
-	var data []map[string]string
-	for v := range results {
-		data = append(data, v)
+// returns the name of a column which isn't for any one number
+func (o Options) columnName(field Field) string {
+	if name := o.FieldNames[field]; name != "" {
+		return name
 	}
+	return string(field)
+}
 
-	return data
+func (o Options) numberSeparators() string {
+	if o.NumberSeparators == "" {
+		return DEFAULT_NUMBER_SEPARATORS
+	}
+	return o.NumberSeparators
 }
 
+// the options numbers are verified with, skipping the lookups none of our fields need
+func (o Options) verifyOptions() phonenumbers.VerifyOptions {
+	opts := phonenumbers.VerifyOptions{DefaultRegion: o.DefaultPrefix, DisableCarrier: true}
+	for _, field := range o.fields() {
+		if strings.HasPrefix(string(field), "carrier_") {
+			opts.DisableCarrier = false
+		}
+		if field == FIELD_GEOCODING {
+			opts.Geocode = true
+		}
+	}
+	return opts
+}
+
+func (o Options) workers() int {
+	if o.Workers <= 0 {
+		return runtime.GOMAXPROCS(0)
+	}
+	return o.Workers
+}
+
+// Stats counts the rows read by a validation and the numbers in them.
+type Stats struct {
+	Rows     int `json:"rows"`
+	Rejected int `json:"rejected"`
+	Numbers  int `json:"numbers"`
+	Invalid  int `json:"invalid"`
+	Dropped  int `json:"dropped"`
+
+	// Phone cells without any numbers, or in LAYOUT_ROW_PER_NUMBER rows, which are written as
+	// invalid but not counted in Numbers or Invalid.
+	Empty int `json:"empty"`
+
+	// Rows whose value of the region column couldn't be resolved.
+	Unresolved int `json:"unresolved"`
+
+	// Rows of the output dropped by Options.Worker.
+	Filtered int `json:"filtered"`
+
+	// For JSON input, the keys of objects which weren't in the first, whose values are left out
+	// of the output, with the number of rows each was left out of.
+	DroppedKeys map[string]int `json:"dropped_keys,omitempty"`
+}
+
+// ValidatePhoneReader validates the numbers in the phoneKey column of the CSV read from reader,
+// returning every row with the result of its validation added. Rows with several numbers are
+// returned once, with the fields of the first number.
+func ValidatePhoneReader(reader io.Reader, phoneKey string, comma rune, defaultPrefix string) ([]map[string]string, error) {
+	data, _, err := ValidatePhoneReaderWithOptions(reader, Options{PhoneKey: phoneKey, Comma: comma, DefaultPrefix: defaultPrefix})
+	return data, err
+}
+
+// ValidatePhoneReaderWithOptions validates the numbers of the records read from reader as
+// ValidatePhoneReader does, with the passed in options.
+func ValidatePhoneReaderWithOptions(reader io.Reader, opts Options) ([]map[string]string, Stats, error) {
+	records, err := NewRecordReader(reader, opts.InputFormat, opts.comma())
+	if err != nil {
+		return nil, Stats{}, err
+	}
+
+	var header []string
+	var data []map[string]string
+	stats, err := validateRows(records, opts, func(h []string) error {
+		header = h
+		return nil
+	}, func(row []string) error {
+		values := make(map[string]string, len(header))
+		for i, col := range header {
+			values[col] = row[i]
+		}
+		data = append(data, values)
+		return nil
+	})
+	return data, stats, err
+}
+
+// ValidatePhone validates the numbers in the phoneKey column of csvFile, writing every row with
+// the result of its validation added to out. out is only replaced once every row has been
+// written, so it is left as it was if validation fails.
 func ValidatePhone(csvFile, out, phoneKey string, comma rune, outputComma rune, defaultPrefix string) error {
+	_, err := ValidatePhoneFile(csvFile, out, Options{PhoneKey: phoneKey, Comma: comma, OutputComma: outputComma, DefaultPrefix: defaultPrefix})
+	return err
+}
+
+// ValidatePhoneFile validates the numbers of csvFile as ValidatePhone does, with the passed in
+// options. Unless set in opts the formats of csvFile and out are detected from their extensions,
+// so either can be CSV, TSV, JSON Lines, a JSON array or XLSX.
+func ValidatePhoneFile(csvFile, out string, opts Options) (Stats, error) {
+	if opts.InputFormat == "" {
+		opts.InputFormat = formatForName(csvFile)
+	}
+	if opts.OutputFormat == "" {
+		opts.OutputFormat = formatForName(out)
+	}
+
 	file, err := os.Open(csvFile)
 	if err != nil {
-		return errors.New("File not found or unable to open")
+		return Stats{}, fmt.Errorf("unable to open %s: %w", csvFile, err)
 	}
-	outFile, err := os.OpenFile(out, os.O_CREATE|os.O_WRONLY, 0777)
+	defer file.Close()
+
+	// write to a temporary file next to out which we swap in once we are done
+	tmpFile, err := os.CreateTemp(filepath.Dir(out), "."+filepath.Base(out)+".*")
 	if err != nil {
-		return errors.New("File not found")
+		return Stats{}, fmt.Errorf("unable to create %s: %w", out, err)
+	}
+	defer os.Remove(tmpFile.Name())
+
+	stats, err := Validate(file, tmpFile, opts)
+	if err != nil {
+		tmpFile.Close()
+		return stats, err
+	}
+	if err := tmpFile.Chmod(0664); err != nil {
+		tmpFile.Close()
+		return stats, err
+	}
+	if err := tmpFile.Close(); err != nil {
+		return stats, fmt.Errorf("unable to write %s: %w", out, err)
 	}
-	defer outFile.Close()
-	scanner := bufio.NewScanner(file)
-	colPosition := GetCsvHeader(scanner, comma)
-	for k, v := range colPosition {
-		colPosition[k] = clean([]byte(v))
+	if err := os.Rename(tmpFile.Name(), out); err != nil {
+		return stats, fmt.Errorf("unable to replace %s: %w", out, err)
 	}
-	jobs := make(chan []byte)
-	results := make(chan map[string]string)
+	return stats, nil
+}
 
-	wg := new(sync.WaitGroup)
-	for w := 1; w <= 2; w++ {
-		wg.Add(1)
-		go ProcessNumber(jobs, results, wg, colPosition, phoneKey, comma, defaultPrefix)
+// ValidateCSV validates the numbers of the CSV read from r, writing every row with the result of
+// its validation added to w in the order they were read.
+func ValidateCSV(r io.Reader, w io.Writer, opts Options) (Stats, error) {
+	opts.InputFormat, opts.OutputFormat = FORMAT_CSV, FORMAT_CSV
+	return Validate(r, w, opts)
+}
+
+// Validate validates the numbers of the records read from r as ValidateCSV does, reading and
+// writing them in the formats of opts. When not set the input format is detected from the content
+// of r and the output format is that of the input.
+func Validate(r io.Reader, w io.Writer, opts Options) (Stats, error) {
+	r, opts.InputFormat = detectFormat(r, opts.InputFormat)
+	if opts.OutputFormat == "" {
+		opts.OutputFormat = opts.InputFormat
+	}
+
+	records, err := NewRecordReader(r, opts.InputFormat, opts.comma())
+	if err != nil {
+		return Stats{}, err
+	}
+	writer, err := NewRecordWriter(w, opts.OutputFormat, opts.outputComma())
+	if err != nil {
+		return Stats{}, err
+	}
+
+	stats, err := validateRows(records, opts, writer.Write, writer.Write)
+	if err != nil {
+		return stats, err
+	}
+	if err := writer.Close(); err != nil {
+		return stats, fmt.Errorf("error writing output: %w", err)
+	}
+	return stats, nil
+}
+
+// a row read from the input, seq being its position among the rows we verify
+type job struct {
+	seq    int
+	fields []string
+}
+
+type result struct {
+	seq        int
+	rows       [][]string
+	numbers    int
+	invalid    int
+	empty      int
+	dropped    int
+	unresolved bool
+	filtered   int
+}
+
+// reads the records of reader, passing the header of the output to writeHeader and then each row
+// with the result of its validation added to writeRow, in the order they were read
+func validateRows(reader RecordReader, opts Options, writeHeader func([]string) error, writeRow func([]string) error) (Stats, error) {
+	var stats Stats
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return stats, nil
+	}
+	if err != nil {
+		return stats, fmt.Errorf("error reading header: %w", err)
+	}
+	for i, col := range header {
+		header[i] = clean([]byte(col))
+	}
+	schema, err := newRowSchema(header, opts)
+	if err != nil {
+		return stats, err
+	}
+	if err := writeHeader(schema.header); err != nil {
+		return stats, fmt.Errorf("error writing header: %w", err)
+	}
+
+	var rejects *csv.Writer
+	if opts.Rejects != nil {
+		rejects = csv.NewWriter(opts.Rejects)
+		rejects.Comma = opts.outputComma()
 	}
+
+	jobs := make(chan job)
+	results := make(chan result)
+	done := make(chan struct{})
+	readErr := make(chan error, 1)
+
+	// read rows, handing those we can verify to our workers
 	go func() {
-		for scanner.Scan() {
-			jobs <- scanner.Bytes()
+		defer close(jobs)
+		seq := 0
+		for {
+			fields, err := reader.Read()
+			if err == io.EOF {
+				readErr <- nil
+				return
+			}
+			if err != nil {
+				var recordErr *RecordError
+				if !errors.As(err, &recordErr) {
+					readErr <- fmt.Errorf("error reading input: %w", err)
+					return
+				}
+				if rejects == nil {
+					readErr <- err
+					return
+				}
+				stats.Rejected++
+				if err := rejects.Write(append([]string{strconv.Itoa(recordErr.Line), recordErr.Err.Error()}, fields...)); err != nil {
+					readErr <- fmt.Errorf("error writing rejects: %w", err)
+					return
+				}
+				continue
+			}
+
+			select {
+			case jobs <- job{seq: seq, fields: fields}:
+				seq++
+			case <-done:
+				readErr <- nil
+				return
+			}
 		}
-		close(jobs)
 	}()
 
+	wg := new(sync.WaitGroup)
+	for w := 0; w < opts.workers(); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := range jobs {
+				res := schema.verify(j.fields)
+				res.seq = j.seq
+				select {
+				case results <- res:
+				case <-done:
+					return
+				}
+			}
+		}()
+	}
 	go func() {
 		wg.Wait()
 		close(results)
 	}()
 
-	writer := csv.NewWriter(outFile)
-	writer.Comma = outputComma
-	defer writer.Flush()
-	header := make(map[int]string)
-
-	count := 0
-	for v := range results {
-		h := make([]string, len(v))
-		d := make([]string, len(v))
-		idx := 0
-		if count == 0 {
-			for col, _ := range v {
-				header[idx] = col
-				h[idx] = col
-				idx++
+	// write our results in the order their rows were read, holding on to those that finish early
+	var writeErr error
+	pending := make(map[int]result)
+	next := 0
+	for res := range results {
+		if writeErr != nil {
+			continue
+		}
+		pending[res.seq] = res
+		for {
+			res, found := pending[next]
+			if !found {
+				break
+			}
+			delete(pending, next)
+			next++
+
+			stats.Rows++
+			stats.Numbers += res.numbers
+			stats.Invalid += res.invalid
+			stats.Empty += res.empty
+			stats.Dropped += res.dropped
+			if res.unresolved {
+				stats.Unresolved++
 			}
-			err = writer.Write(h)
-			if err != nil {
-				panic(err)
+			stats.Filtered += res.filtered
+			for _, row := range res.rows {
+				if err := writeRow(row); err != nil {
+					writeErr = fmt.Errorf("error writing row: %w", err)
+					break
+				}
 			}
+			if writeErr != nil {
+				close(done)
+				break
+			}
+		}
+	}
+
+	// our reader is finished once results is closed, as it closes jobs before it returns
+	if err := <-readErr; err != nil {
+		return stats, err
+	}
+	if dropper, isDropper := reader.(KeyDropper); isDropper {
+		if dropped := dropper.DroppedKeys(); len(dropped) > 0 {
+			stats.DroppedKeys = dropped
 		}
+	}
+	if writeErr != nil {
+		return stats, writeErr
+	}
+	if rejects != nil {
+		rejects.Flush()
+		if err := rejects.Error(); err != nil {
+			return stats, fmt.Errorf("error writing rejects: %w", err)
+		}
+	}
+	return stats, nil
+}
 
-		for col, val := range v {
-			for id, head := range header {
-				if col == head {
-					d[id] = val
+// rowSchema is how the rows of an input are verified and written
+type rowSchema struct {
+	opts         Options
+	header       []string
+	phoneKeys    []string
+	phoneIndexes []int
+	regionIndex  int
+	fields       []Field
+	verifyOpts   phonenumbers.VerifyOptions
+}
+
+// works out the schema of the output for the header of the input, the columns of the input
+// followed by those of our fields
+func newRowSchema(header []string, opts Options) (*rowSchema, error) {
+	schema := &rowSchema{opts: opts, phoneKeys: opts.phoneKeys(), regionIndex: -1, fields: opts.fields(), verifyOpts: opts.verifyOptions()}
+
+	columns := make(map[string]int, len(header))
+	for i, col := range header {
+		if _, found := columns[col]; !found {
+			columns[col] = i
+		}
+	}
+	for _, key := range schema.phoneKeys {
+		index, found := columns[key]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrMissingPhoneColumn, key)
+		}
+		schema.phoneIndexes = append(schema.phoneIndexes, index)
+	}
+	if opts.RegionColumn != "" {
+		index, found := columns[opts.RegionColumn]
+		if !found {
+			return nil, fmt.Errorf("%w: %s", ErrMissingRegionColumn, opts.RegionColumn)
+		}
+		schema.regionIndex = index
+	}
+	for _, field := range schema.fields {
+		if _, found := fieldValues[field]; !found {
+			return nil, fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+	}
+
+	schema.header = append([]string{}, header...)
+	seen := make(map[string]bool, len(header))
+	for _, col := range header {
+		seen[col] = true
+	}
+	add := func(name string) error {
+		if seen[name] {
+			return fmt.Errorf("%w: %s, give the field another name with FieldNames", ErrDuplicateColumn, name)
+		}
+		seen[name] = true
+		schema.header = append(schema.header, name)
+		return nil
+	}
+
+	// default names of fields the input already has a column of, such as a region column named
+	// region, are suffixed with the phone column
+	addField := func(field Field, key string, index int) error {
+		name := opts.fieldName(field, key, index)
+		if _, inInput := columns[name]; inInput && opts.FieldNames[field] == "" {
+			name += "_" + key
+		}
+		return add(name)
+	}
+
+	if schema.regionIndex >= 0 {
+		if err := add(opts.columnName(REGION_UNRESOLVED)); err != nil {
+			return nil, err
+		}
+	}
+
+	if opts.Layout == LAYOUT_ROW_PER_NUMBER {
+		if len(schema.phoneKeys) > 1 {
+			if err := add(opts.columnName(PHONE_COLUMN)); err != nil {
+				return nil, err
+			}
+		}
+		for _, field := range schema.fields {
+			if err := addField(field, schema.phoneKeys[0], 0); err != nil {
+				return nil, err
+			}
+		}
+	} else {
+		for _, key := range schema.phoneKeys {
+			for i := 0; i < opts.numbersPerColumn(); i++ {
+				for _, field := range schema.fields {
+					if err := addField(field, key, i); err != nil {
+						return nil, err
+					}
 				}
 			}
 		}
+	}
 
-		err = writer.Write(d)
-		if err != nil {
-			panic(err)
+	// the worker can set columns we already have
+	for _, col := range opts.WorkerColumns {
+		if !seen[col] {
+			seen[col] = true
+			schema.header = append(schema.header, col)
 		}
-		count++
 	}
+	return schema, nil
+}
 
-	return nil
+// returns the numbers in each of the phone columns of the row, whose region is that passed
+func (s *rowSchema) numbers(values []string, region string) [][]string {
+	numbers := make([][]string, len(s.phoneIndexes))
+	for i, index := range s.phoneIndexes {
+		cell := strings.TrimSpace(values[index])
+		if s.opts.DisableSplitting {
+			if cell != "" {
+				numbers[i] = []string{cell}
+			}
+			continue
+		}
+		numbers[i] = splitNumbers(cell, s.opts.numberSeparators(), region)
+	}
+	return numbers
+}
+
+// verifies the numbers of the row, returning the rows to write with the fields of the numbers
+// added
+func (s *rowSchema) verify(values []string) result {
+	var res result
+
+	base := make([]string, len(values))
+	for i, value := range values {
+		base[i] = strings.TrimSpace(value)
+	}
+
+	region := strings.ToUpper(s.opts.DefaultPrefix)
+	if s.regionIndex >= 0 {
+		value := base[s.regionIndex]
+		if resolved, found := resolveRegion(value); found {
+			region = resolved
+		} else if value != "" {
+			res.unresolved = true
+		}
+		base = append(base, strconv.FormatBool(res.unresolved))
+	}
+	numbers := s.numbers(values, region)
+
+	verify := func(phone string) []string {
+		num := phonenumbers.Number{DefaultPrefix: region, Phone: phone}
+		num.VerifyWithOptions(s.verifyOpts)
+		switch {
+		case phone == "":
+			res.empty++
+		case num.Invalid:
+			res.numbers++
+			res.invalid++
+		default:
+			res.numbers++
+		}
+		fields := make([]string, len(s.fields))
+		for i, field := range s.fields {
+			fields[i] = fieldValues[field](&num)
+		}
+		return fields
+	}
+
+	if s.opts.Layout == LAYOUT_ROW_PER_NUMBER {
+		withColumn := func(key string) []string {
+			row := append(make([]string, 0, len(s.header)), base...)
+			if len(s.phoneKeys) > 1 {
+				row = append(row, key)
+			}
+			return row
+		}
+		for i, key := range s.phoneKeys {
+			for _, phone := range numbers[i] {
+				res.rows = append(res.rows, append(withColumn(key), verify(phone)...))
+			}
+		}
+		// rows without any numbers are still written, as invalid
+		if len(res.rows) == 0 {
+			res.rows = append(res.rows, append(withColumn(""), verify("")...))
+		}
+		s.work(&res)
+		return res
+	}
+
+	// the first number of each column is always verified so empty cells are reported as invalid,
+	// the columns of further numbers are left empty if the cell doesn't have them
+	row := append(make([]string, 0, len(s.header)), base...)
+	perColumn := s.opts.numbersPerColumn()
+	for i := range s.phoneKeys {
+		for n := 0; n < perColumn; n++ {
+			switch {
+			case n < len(numbers[i]):
+				row = append(row, verify(numbers[i][n])...)
+			case n == 0:
+				row = append(row, verify("")...)
+			default:
+				row = append(row, make([]string, len(s.fields))...)
+			}
+		}
+		if len(numbers[i]) > perColumn {
+			res.dropped += len(numbers[i]) - perColumn
+		}
+	}
+	res.rows = [][]string{row}
+	s.work(&res)
+	return res
+}
+
+// passes the rows of the result to our worker, replacing them with its changes and leaving out
+// those it drops
+func (s *rowSchema) work(res *result) {
+	if s.opts.Worker == nil {
+		return
+	}
+
+	rows := res.rows[:0]
+	for _, row := range res.rows {
+		data := make(map[string]string, len(s.header))
+		for i, value := range row {
+			data[s.header[i]] = value
+		}
+		if !s.opts.Worker(data) {
+			res.filtered++
+			continue
+		}
+
+		worked := make([]string, len(s.header))
+		for i, col := range s.header {
+			worked[i] = data[col]
+		}
+		rows = append(rows, worked)
+	}
+	res.rows = rows
 }
 
 func clean(s []byte) string {
@@ -149,36 +815,3 @@ func clean(s []byte) string {
 	}
 	return strings.TrimSpace(string(s[:j]))
 }
-
-func ProcessNumber(jobs <-chan []byte, results chan<- map[string]string, wg *sync.WaitGroup, col map[int]string, phoneKey string, comma rune, defaultPrefix string) {
-	// Decreasing internal counter for wait-group as soon as goroutine finishes
-	defer wg.Done()
-
-	// eventually I want to have a []string channel to work on a chunk of lines not just one line of text
-	for j := range jobs {
-		data := make(map[string]string)
-		r := csv.NewReader(bytes.NewReader(j))
-		r.Comma = comma
-		r.TrimLeadingSpace = true
-		fields, _ := r.Read()
-		for key, dt := range fields {
-			data[col[key]] = strings.TrimSpace(dt)
-		}
-		num := phonenumbers.Number{DefaultPrefix: defaultPrefix}
-		num.Phone = strings.TrimSpace(data[phoneKey])
-		num.Verify()
-		validatedPhone := "validated_" + phoneKey
-		validPhone := "invalid_" + phoneKey
-		data["region"] = num.CountryCode
-		data[validatedPhone] = num.Phone
-		data["phone_type_label"] = num.PhoneTypeHuman
-		data["carrier_name"] = num.CarrierName
-		data["carrier_mnc"] = num.CarrierMnc
-		data["carrier_mcc"] = num.CarrierMcc
-		data["carrier_nnc"] = num.CarrierNnc
-		data["phone_type_code"] = fmt.Sprintf("%d", num.PhoneType)
-		data["dial_code"] = fmt.Sprintf("%d", num.DialCode)
-		data[validPhone] = fmt.Sprintf("%v", num.Invalid)
-		results <- data
-	}
-}
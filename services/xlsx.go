@@ -0,0 +1,339 @@
+package services
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strconv"
+	"strings"
+)
+
+var ErrInvalidXLSX = errors.New("the input is not a valid XLSX workbook")
+
+// the sheet read when the workbook doesn't say where its first sheet is
+const defaultXLSXSheet = "xl/worksheets/sheet1.xml"
+
+// the text of a shared string or inline string cell, either plain or made up of runs
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+	text := &strings.Builder{}
+	for _, run := range t.Runs {
+		text.WriteString(run.Text)
+	}
+	return text.String()
+}
+
+type xlsxRow struct {
+	Ref   int `xml:"r,attr"`
+	Cells []struct {
+		Ref    string   `xml:"r,attr"`
+		Type   string   `xml:"t,attr"`
+		Value  string   `xml:"v"`
+		Inline xlsxText `xml:"is"`
+	} `xml:"c"`
+}
+
+// reads the rows of the first sheet of an XLSX workbook
+type xlsxReader struct {
+	strings []string
+	decoder *xml.Decoder
+	sheet   io.Closer
+	row     int
+	width   int
+}
+
+func newXLSXReader(r io.Reader) (*xlsxReader, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	files := make(map[string]*zip.File, len(archive.File))
+	for _, file := range archive.File {
+		files[file.Name] = file
+	}
+
+	reader := &xlsxReader{}
+	if file, found := files["xl/sharedStrings.xml"]; found {
+		var shared struct {
+			Items []xlsxText `xml:"si"`
+		}
+		if err := decodeZipXML(file, &shared); err != nil {
+			return nil, err
+		}
+		for _, item := range shared.Items {
+			reader.strings = append(reader.strings, item.String())
+		}
+	}
+
+	sheet, found := files[firstXLSXSheet(files)]
+	if !found {
+		return nil, fmt.Errorf("%w: it has no sheets", ErrInvalidXLSX)
+	}
+	content, err := sheet.Open()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	reader.sheet = content
+	reader.decoder = xml.NewDecoder(content)
+	return reader, nil
+}
+
+// returns the name of the part holding the first sheet of the workbook
+func firstXLSXSheet(files map[string]*zip.File) string {
+	workbookFile, found := files["xl/workbook.xml"]
+	relsFile, relsFound := files["xl/_rels/workbook.xml.rels"]
+	if !found || !relsFound {
+		return defaultXLSXSheet
+	}
+
+	var workbook struct {
+		Sheets []struct {
+			ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+		} `xml:"sheets>sheet"`
+	}
+	var rels struct {
+		Relationships []struct {
+			ID     string `xml:"Id,attr"`
+			Target string `xml:"Target,attr"`
+		} `xml:"Relationship"`
+	}
+	if decodeZipXML(workbookFile, &workbook) != nil || decodeZipXML(relsFile, &rels) != nil || len(workbook.Sheets) == 0 {
+		return defaultXLSXSheet
+	}
+	for _, rel := range rels.Relationships {
+		if rel.ID == workbook.Sheets[0].ID {
+			// targets are relative to xl/ unless absolute
+			if strings.HasPrefix(rel.Target, "/") {
+				return strings.TrimPrefix(rel.Target, "/")
+			}
+			return path.Join("xl", rel.Target)
+		}
+	}
+	return defaultXLSXSheet
+}
+
+func decodeZipXML(file *zip.File, v any) error {
+	content, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+	}
+	defer content.Close()
+	if err := xml.NewDecoder(content).Decode(v); err != nil {
+		return fmt.Errorf("%w: %s: %v", ErrInvalidXLSX, file.Name, err)
+	}
+	return nil
+}
+
+// Read returns the cells of the next row of the sheet which isn't empty, padded to the width of
+// the header
+func (r *xlsxReader) Read() ([]string, error) {
+	for {
+		token, err := r.decoder.Token()
+		if err == io.EOF {
+			r.sheet.Close()
+			return nil, io.EOF
+		}
+		if err != nil {
+			r.sheet.Close()
+			return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+		}
+		start, isStart := token.(xml.StartElement)
+		if !isStart || start.Name.Local != "row" {
+			continue
+		}
+
+		var row xlsxRow
+		if err := r.decoder.DecodeElement(&row, &start); err != nil {
+			r.sheet.Close()
+			return nil, fmt.Errorf("%w: %v", ErrInvalidXLSX, err)
+		}
+		r.row++
+		if row.Ref > 0 {
+			r.row = row.Ref
+		}
+
+		record, err := r.record(row)
+		if err != nil {
+			return record, &RecordError{Line: r.row, Err: err}
+		}
+		if len(record) == 0 {
+			continue
+		}
+
+		// the header decides how many fields every row has
+		if r.width == 0 {
+			r.width = len(record)
+			return record, nil
+		}
+		if len(record) > r.width {
+			return record, &RecordError{Line: r.row, Err: fmt.Errorf("the row has %d cells, more than the %d of the header", len(record), r.width)}
+		}
+		return append(record, make([]string, r.width-len(record))...), nil
+	}
+}
+
+// returns the values of the cells of the row, up to its last cell which isn't empty
+func (r *xlsxReader) record(row xlsxRow) ([]string, error) {
+	var record []string
+	for _, cell := range row.Cells {
+		column := len(record)
+		if cell.Ref != "" {
+			var err error
+			if column, err = xlsxColumn(cell.Ref); err != nil {
+				return record, err
+			}
+		}
+
+		var value string
+		switch cell.Type {
+		case "s":
+			index, err := strconv.Atoi(cell.Value)
+			if err != nil || index < 0 || index >= len(r.strings) {
+				return record, fmt.Errorf("cell %s refers to a missing shared string", cell.Ref)
+			}
+			value = r.strings[index]
+		case "inlineStr":
+			value = cell.Inline.String()
+		case "b":
+			value = strconv.FormatBool(cell.Value == "1")
+		case "", "n":
+			value = cell.Value
+			// long numbers, as phone numbers often are, may be written in scientific notation
+			if strings.ContainsAny(value, "eE") {
+				if number, err := strconv.ParseFloat(value, 64); err == nil {
+					value = strconv.FormatFloat(number, 'f', -1, 64)
+				}
+			}
+		default:
+			value = cell.Value
+		}
+		if value == "" {
+			continue
+		}
+
+		if column >= len(record) {
+			record = append(record, make([]string, column+1-len(record))...)
+		}
+		record[column] = value
+	}
+	return record, nil
+}
+
+// returns the index of the column of a cell reference such as AB12, counting from 0
+func xlsxColumn(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A') + 1
+		letters++
+	}
+	if letters == 0 || letters > 3 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+	return column - 1, nil
+}
+
+// returns the letters naming the column with the index, counting from 0
+func xlsxColumnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+// the parts of a workbook with a single sheet, other than the sheet itself
+var xlsxParts = []struct{ name, content string }{
+	{"[Content_Types].xml", xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>` +
+		`</Types>`},
+	{"_rels/.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+		`</Relationships>`},
+	{"xl/workbook.xml", xml.Header + `<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">` +
+		`<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets>` +
+		`</workbook>`},
+	{"xl/_rels/workbook.xml.rels", xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+		`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/>` +
+		`</Relationships>`},
+}
+
+// writes records as the rows of a workbook with a single sheet, every cell being an inline string
+type xlsxWriter struct {
+	archive *zip.Writer
+	sheet   *bufio.Writer
+	row     int
+}
+
+func newXLSXWriter(w io.Writer) (*xlsxWriter, error) {
+	archive := zip.NewWriter(w)
+	for _, part := range xlsxParts {
+		file, err := archive.Create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(file, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	// the sheet is written last so its rows can be streamed into it
+	sheet, err := archive.Create(defaultXLSXSheet)
+	if err != nil {
+		return nil, err
+	}
+	writer := &xlsxWriter{archive: archive, sheet: bufio.NewWriter(sheet)}
+	writer.sheet.WriteString(xml.Header + `<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	return writer, nil
+}
+
+func (w *xlsxWriter) Write(record []string) error {
+	w.row++
+	fmt.Fprintf(w.sheet, `<row r="%d">`, w.row)
+	for i, value := range record {
+		if value == "" {
+			continue
+		}
+		fmt.Fprintf(w.sheet, `<c r="%s%d" t="inlineStr"><is><t xml:space="preserve">`, xlsxColumnName(i), w.row)
+		if err := xml.EscapeText(w.sheet, []byte(value)); err != nil {
+			return err
+		}
+		w.sheet.WriteString(`</t></is></c>`)
+	}
+
+	// bufio errors stick, so the last write fails if any before it did
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (w *xlsxWriter) Close() error {
+	w.sheet.WriteString(`</sheetData></worksheet>`)
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.archive.Close()
+}
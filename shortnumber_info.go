@@ -8,6 +8,24 @@ import (
 
 var (
 	shortNumberRegionToMetadataMap = make(map[string]*PhoneMetadata)
+
+	// In these countries, if extra digits are added to an emergency number, it no longer connects
+	// to the emergency service.
+	REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT = map[string]bool{
+		"BR": true,
+		"CL": true,
+		"NI": true,
+	}
+)
+
+// Cost categories of short numbers.
+type ShortNumberCost int
+
+const (
+	SHORT_NUMBER_TOLL_FREE ShortNumberCost = iota
+	SHORT_NUMBER_STANDARD_RATE
+	SHORT_NUMBER_PREMIUM_RATE
+	SHORT_NUMBER_UNKNOWN_COST
 )
 
 func readFromShortNumberRegionToMetadataMap(key string) (*PhoneMetadata, bool) {
@@ -76,7 +94,9 @@ func loadShortNumberMetadataFromFile() error {
 // lenient check than #isValidShortNumber.
 // See IsPossibleShortNumberForRegion(PhoneNumber, string) for details.
 func IsPossibleShortNumber(number *PhoneNumber) bool {
-	regionsCodes := GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	md := activeMetadata()
+
+	regionsCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
 	shortNumberLength := len(GetNationalSignificantNumber(number))
 	for _, region := range regionsCodes {
 		phoneMetadata := getShortNumberMetadataForRegion(region)
@@ -93,7 +113,9 @@ func IsPossibleShortNumber(number *PhoneNumber) bool {
 // Check whether a short number is a possible number when dialed from the given region. This
 // provides a more lenient check than IsValidShortNumberForRegion.
 func IsPossibleShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
-	if !regionDialingFromMatchesNumber(number, regionDialingFrom) {
+	md := activeMetadata()
+
+	if !regionDialingFromMatchesNumber(md, number, regionDialingFrom) {
 		return false
 	}
 	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
@@ -109,20 +131,26 @@ func IsPossibleShortNumberForRegion(number *PhoneNumber, regionDialingFrom strin
 // the number is actually in use, which is impossible to tell by just looking at the number
 // itself. See IsValidShortNumberForRegion(PhoneNumber, String) for details.
 func IsValidShortNumber(number *PhoneNumber) bool {
-	regionCodes := GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+	md := activeMetadata()
+
+	regionCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
 	regionCode := getRegionCodeForShortNumberFromRegionList(number, regionCodes)
 	if len(regionCodes) > 1 && regionCode != "" {
 		// If a matching region had been found for the phone number from among two or more regions,
 		// then we have already implicitly verified its validity for that region.
 		return true
 	}
-	return IsValidShortNumberForRegion(number, regionCode)
+	return isValidShortNumberForRegion(md, number, regionCode)
 }
 
 // Tests whether a short number matches a valid pattern in a region. Note that this doesn't verify
 // the number is actually in use, which is impossible to tell by just looking at the number itself.
 func IsValidShortNumberForRegion(number *PhoneNumber, regionDialingFrom string) bool {
-	if !regionDialingFromMatchesNumber(number, regionDialingFrom) {
+	return isValidShortNumberForRegion(activeMetadata(), number, regionDialingFrom)
+}
+
+func isValidShortNumberForRegion(md *metadataState, number *PhoneNumber, regionDialingFrom string) bool {
+	if !regionDialingFromMatchesNumber(md, number, regionDialingFrom) {
 		return false
 	}
 	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
@@ -138,6 +166,235 @@ func IsValidShortNumberForRegion(number *PhoneNumber, regionDialingFrom string)
 	return matchesPossibleNumberAndNationalNumber(shortNumber, shortNumberDesc)
 }
 
+// Gets the expected cost category of a short number when dialed from a region (however, nothing is
+// implied about its validity). If it is important that the number is valid, then its validity
+// must first be checked using IsValidShortNumberForRegion. Note that emergency numbers are always
+// considered toll-free. Example usage:
+//
+//	number, _ := Parse("110", "FR")
+//	if IsValidShortNumberForRegion(number, "FR") {
+//		cost := GetExpectedCostForRegion(number, "FR")
+//		// Do something with the cost information here.
+//	}
+func GetExpectedCostForRegion(number *PhoneNumber, regionDialingFrom string) ShortNumberCost {
+	return getExpectedCostForRegion(activeMetadata(), number, regionDialingFrom)
+}
+
+func getExpectedCostForRegion(md *metadataState, number *PhoneNumber, regionDialingFrom string) ShortNumberCost {
+	if !regionDialingFromMatchesNumber(md, number, regionDialingFrom) {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+	// Note that regionDialingFrom may be empty, in which case phoneMetadata will also be nil.
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	if phoneMetadata == nil {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+
+	shortNumber := GetNationalSignificantNumber(number)
+
+	// The possible lengths are not present for a particular sub-type if they match the general
+	// description; for this reason, we check the possible lengths against the general description
+	// first to allow an early exit if possible.
+	if !phoneMetadata.GetGeneralDesc().hasPossibleLength(int32(len(shortNumber))) {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+
+	// The cost categories are tested in order of decreasing expense, since if for some reason the
+	// patterns overlap the most expensive matching cost category should be returned.
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetPremiumRate()) {
+		return SHORT_NUMBER_PREMIUM_RATE
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetStandardRate()) {
+		return SHORT_NUMBER_STANDARD_RATE
+	}
+	if matchesPossibleNumberAndNationalNumber(shortNumber, phoneMetadata.GetTollFree()) {
+		return SHORT_NUMBER_TOLL_FREE
+	}
+	if IsEmergencyNumber(shortNumber, regionDialingFrom) {
+		// Emergency numbers are implicitly toll-free.
+		return SHORT_NUMBER_TOLL_FREE
+	}
+	return SHORT_NUMBER_UNKNOWN_COST
+}
+
+// Gets the expected cost category of a short number (however, nothing is implied about its
+// validity). If the country calling code is unique to a region, this method behaves exactly the
+// same as GetExpectedCostForRegion. However, if the country calling code is shared by multiple
+// regions, then it returns the highest cost in the sequence PREMIUM_RATE, UNKNOWN_COST,
+// STANDARD_RATE, TOLL_FREE. The reason for the position of UNKNOWN_COST in this order is that if
+// a number is UNKNOWN_COST in one region but STANDARD_RATE or TOLL_FREE in another, its expected
+// cost cannot be estimated as one of the latter since it might be a PREMIUM_RATE number.
+//
+// For example, if a number is STANDARD_RATE in the US, but TOLL_FREE in Canada, the expected
+// cost returned by this method will be STANDARD_RATE, since the NANPA countries share the same
+// country calling code.
+//
+// Note: If the region from which the number is dialed is known, it is highly preferable to call
+// GetExpectedCostForRegion instead.
+func GetExpectedCost(number *PhoneNumber) ShortNumberCost {
+	md := activeMetadata()
+
+	regionCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
+	if len(regionCodes) == 0 {
+		return SHORT_NUMBER_UNKNOWN_COST
+	}
+	if len(regionCodes) == 1 {
+		return getExpectedCostForRegion(md, number, regionCodes[0])
+	}
+	cost := SHORT_NUMBER_TOLL_FREE
+	for _, regionCode := range regionCodes {
+		switch getExpectedCostForRegion(md, number, regionCode) {
+		case SHORT_NUMBER_PREMIUM_RATE:
+			return SHORT_NUMBER_PREMIUM_RATE
+		case SHORT_NUMBER_UNKNOWN_COST:
+			cost = SHORT_NUMBER_UNKNOWN_COST
+		case SHORT_NUMBER_STANDARD_RATE:
+			if cost != SHORT_NUMBER_UNKNOWN_COST {
+				cost = SHORT_NUMBER_STANDARD_RATE
+			}
+		case SHORT_NUMBER_TOLL_FREE:
+			// Do nothing.
+		}
+	}
+	return cost
+}
+
+// Gets a valid short number for the specified region. Returns an empty string when the metadata
+// does not contain such information.
+func GetExampleShortNumber(regionCode string) string {
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+	return phoneMetadata.GetShortCode().GetExampleNumber()
+}
+
+// Gets a valid short number for the specified cost category. Returns an empty string when the
+// metadata does not contain such information, or the cost is UNKNOWN_COST.
+func GetExampleShortNumberForCost(regionCode string, cost ShortNumberCost) string {
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	if phoneMetadata == nil {
+		return ""
+	}
+	var desc *PhoneNumberDesc
+	switch cost {
+	case SHORT_NUMBER_TOLL_FREE:
+		desc = phoneMetadata.GetTollFree()
+	case SHORT_NUMBER_STANDARD_RATE:
+		desc = phoneMetadata.GetStandardRate()
+	case SHORT_NUMBER_PREMIUM_RATE:
+		desc = phoneMetadata.GetPremiumRate()
+	default:
+		// UNKNOWN_COST numbers are computed by the process of elimination from the other cost
+		// categories.
+	}
+	return desc.GetExampleNumber()
+}
+
+// Returns true if the given number, exactly as dialed, might be used to connect to an emergency
+// service in the given region.
+//
+// This method accepts a string, rather than a PhoneNumber, because it needs to distinguish cases
+// such as "+1 911" and "911", where the former may not connect to an emergency service in all
+// cases but the latter would. This method takes into account cases where the number might
+// contain formatting, or might have additional digits appended (when it is okay to do that in
+// the specified region).
+func ConnectsToEmergencyNumber(number, regionCode string) bool {
+	return matchesEmergencyNumberHelper(number, regionCode, true /* allows prefix match */)
+}
+
+// Returns true if the given number exactly matches an emergency service number in the given
+// region.
+//
+// This method takes into account cases where the number might contain formatting, but doesn't
+// allow additional digits to be appended. Note that IsEmergencyNumber(number, region) implies
+// ConnectsToEmergencyNumber(number, region).
+func IsEmergencyNumber(number, regionCode string) bool {
+	return matchesEmergencyNumberHelper(number, regionCode, false /* doesn't allow prefix match */)
+}
+
+func matchesEmergencyNumberHelper(number, regionCode string, allowPrefixMatch bool) bool {
+	possibleNumber := extractPossibleNumber(number)
+	if loc := PLUS_CHARS_PATTERN.FindStringIndex(possibleNumber); loc != nil && loc[0] == 0 {
+		// Returns false if the number starts with a plus sign. We don't believe dialing the country
+		// code before emergency numbers (e.g. +1911) works, but later, if that proves to work, we can
+		// add additional logic here to handle it.
+		return false
+	}
+	metadata := getShortNumberMetadataForRegion(regionCode)
+	if metadata == nil || metadata.GetEmergency() == nil {
+		return false
+	}
+
+	normalizedNumber := NormalizeDigitsOnly(possibleNumber)
+	allowPrefixMatchForRegion := allowPrefixMatch && !REGIONS_WHERE_EMERGENCY_NUMBERS_MUST_BE_EXACT[regionCode]
+	return MatchNationalNumber(normalizedNumber, metadata.GetEmergency(), allowPrefixMatchForRegion)
+}
+
+// Given a valid short number, determines whether it is carrier-specific (however, nothing is
+// implied about its validity). Carrier-specific numbers may connect to a different end-point, or
+// not connect at all, depending on the user's carrier. If it is important that the number is
+// valid, then its validity must first be checked using IsValidShortNumber or
+// IsValidShortNumberForRegion.
+func IsCarrierSpecific(number *PhoneNumber) bool {
+	md := activeMetadata()
+
+	regionCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
+	regionCode := getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is carrier-specific when dialed from the
+// given region (however, nothing is implied about its validity). Carrier-specific numbers may
+// connect to a different end-point, or not connect at all, depending on the user's carrier. If
+// it is important that the number is valid, then its validity must first be checked using
+// IsValidShortNumber or IsValidShortNumberForRegion. This method returns false if the number
+// doesn't match the region provided.
+func IsCarrierSpecificForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	md := activeMetadata()
+
+	if !regionDialingFromMatchesNumber(md, number, regionDialingFrom) {
+		return false
+	}
+	nationalNumber := GetNationalSignificantNumber(number)
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(nationalNumber, phoneMetadata.GetCarrierSpecific())
+}
+
+// Given a valid short number, determines whether it is an SMS service (however, nothing is
+// implied about its validity). If the country calling code is shared by multiple regions, the
+// region the number is valid in is used. See IsSMSServiceForRegion for details.
+func IsSMSService(number *PhoneNumber) bool {
+	md := activeMetadata()
+
+	regionCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
+	regionCode := getRegionCodeForShortNumberFromRegionList(number, regionCodes)
+	phoneMetadata := getShortNumberMetadataForRegion(regionCode)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(GetNationalSignificantNumber(number), phoneMetadata.GetSmsServices())
+}
+
+// Given a valid short number, determines whether it is an SMS service when dialed from the given
+// region (however, nothing is implied about its validity). An SMS service is where the primary or only intended usage is to
+// receive and/or send text messages (SMSs). This includes MMS as MMS numbers downgrade to SMS if
+// the other party isn't MMS-capable. If it is important that the number is valid, then its
+// validity must first be checked using IsValidShortNumber or IsValidShortNumberForRegion. This
+// method returns false if the number doesn't match the region provided.
+func IsSMSServiceForRegion(number *PhoneNumber, regionDialingFrom string) bool {
+	md := activeMetadata()
+
+	if !regionDialingFromMatchesNumber(md, number, regionDialingFrom) {
+		return false
+	}
+	phoneMetadata := getShortNumberMetadataForRegion(regionDialingFrom)
+	return phoneMetadata != nil &&
+		matchesPossibleNumberAndNationalNumber(GetNationalSignificantNumber(number), phoneMetadata.GetSmsServices())
+}
+
 func getShortNumberMetadataForRegion(regionCode string) *PhoneMetadata {
 	val, _ := readFromShortNumberRegionToMetadataMap(regionCode)
 	return val
@@ -163,8 +420,8 @@ func getRegionCodeForShortNumberFromRegionList(number *PhoneNumber, regionCodes
 
 // Helper method to check that the country calling code of the number matches the region it's
 // being dialed from.
-func regionDialingFromMatchesNumber(number *PhoneNumber, regionDialingFrom string) bool {
-	regionCodes := GetRegionCodesForCountryCode(int(number.GetCountryCode()))
+func regionDialingFromMatchesNumber(md *metadataState, number *PhoneNumber, regionDialingFrom string) bool {
+	regionCodes := getRegionCodesForCountryCode(md, int(number.GetCountryCode()))
 	for _, region := range regionCodes {
 		if region == regionDialingFrom {
 			return true
@@ -182,5 +439,5 @@ func matchesPossibleNumberAndNationalNumber(number string, numberDesc *PhoneNumb
 	if len(numberDesc.PossibleLength) > 0 && !numberDesc.hasPossibleLength(int32(len(number))) {
 		return false
 	}
-	return MatchNationalNumber(number, *numberDesc, false)
+	return MatchNationalNumber(number, numberDesc, false)
 }
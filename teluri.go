@@ -0,0 +1,224 @@
+package phonenumbers
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+var (
+	// Regular expression of valid local-number-digits, following the syntax defined in RFC3966.
+	RFC3966_PHONE_DIGIT_HEX             = "[0-9A-Fa-f*#\\-\\.\\(\\)]"
+	RFC3966_LOCAL_NUMBER_DIGITS         = "^" + RFC3966_PHONE_DIGIT_HEX + "*[0-9A-Fa-f*#]" + RFC3966_PHONE_DIGIT_HEX + "*$"
+	RFC3966_LOCAL_NUMBER_DIGITS_PATTERN = regexp.MustCompile(RFC3966_LOCAL_NUMBER_DIGITS)
+
+	// Regular expressions of valid extension, isdn-subaddress and generic parameter values,
+	// following the syntax defined in RFC3966.
+	RFC3966_EXTENSION_PATTERN       = regexp.MustCompile(`^[0-9\-\.\(\)]*[0-9][0-9\-\.\(\)]*$`)
+	RFC3966_URIC                    = `(?:[A-Za-z0-9\-_.!~*'()/?:@&=+$,\[\]]|%[0-9A-Fa-f]{2})`
+	RFC3966_ISDN_SUBADDRESS_PATTERN = regexp.MustCompile("^" + RFC3966_URIC + "+$")
+	RFC3966_PARAM_NAME_PATTERN      = regexp.MustCompile(`^[A-Za-z0-9\-]+$`)
+	RFC3966_PARAM_VALUE_PATTERN     = regexp.MustCompile(`^(?:[A-Za-z0-9\-_.!~*'()\[\]/:&+$]|%[0-9A-Fa-f]{2})+$`)
+)
+
+var (
+	ErrTelURIMissingPrefix       = errors.New("the URI does not start with tel:")
+	ErrTelURIInvalidNumber       = errors.New("the URI does not contain a valid global or local number")
+	ErrTelURIMissingPhoneContext = errors.New("a local number requires a phone-context parameter")
+	ErrTelURIInvalidPhoneContext = errors.New("the phone-context parameter is not valid")
+	ErrTelURIInvalidExtension    = errors.New("the ext parameter is not valid")
+	ErrTelURIInvalidSubaddress   = errors.New("the isub parameter is not valid")
+	ErrTelURIInvalidParameter    = errors.New("the URI contains an invalid parameter")
+	ErrTelURIDuplicateParameter  = errors.New("the URI contains a parameter more than once")
+)
+
+// TelURIError is returned by ParseTelURI when a URI is malformed. Err is one of the ErrTelURI
+// sentinel errors, so callers can test for a specific failure with errors.Is, and Offset is the
+// byte offset in URI of the component that was rejected.
+type TelURIError struct {
+	URI    string
+	Offset int
+	Err    error
+}
+
+func (e *TelURIError) Error() string {
+	return fmt.Sprintf("invalid tel URI %q at offset %d: %s", e.URI, e.Offset, e.Err)
+}
+
+func (e *TelURIError) Unwrap() error {
+	return e.Err
+}
+
+// TelURIParam is a parameter of a tel: URI that has no dedicated field on TelURI.
+type TelURIParam struct {
+	Name  string
+	Value string
+}
+
+// TelURI is a tel: URI as defined by RFC3966. Number holds the global-number-digits (starting
+// with '+') or local-number-digits as they were written, visual separators included. Local
+// numbers always carry a PhoneContext, which is either a domain name or a global number prefix.
+type TelURI struct {
+	Number         string
+	Extension      string
+	IsdnSubaddress string
+	PhoneContext   string
+	Params         []TelURIParam
+}
+
+// IsGlobal returns whether the URI contains a global number, i.e. one starting with '+'.
+func (t *TelURI) IsGlobal() bool {
+	return strings.HasPrefix(t.Number, string(PLUS_SIGN))
+}
+
+// Param returns the value of the named parameter and whether it was present. Parameter names
+// are case insensitive.
+func (t *TelURI) Param(name string) (string, bool) {
+	name = strings.ToLower(name)
+	for _, p := range t.Params {
+		if p.Name == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// PhoneNumber parses the number held by the URI, taking any extension and phone-context into
+// account. defaultRegion is only used for local numbers with a domain name phone-context.
+func (t *TelURI) PhoneNumber(defaultRegion string) (*PhoneNumber, error) {
+	md := activeMetadata()
+
+	stripped := &TelURI{
+		Number:       t.Number,
+		Extension:    t.Extension,
+		PhoneContext: t.PhoneContext,
+	}
+	return parse(md, FormatTelURI(stripped), defaultRegion)
+}
+
+func (t *TelURI) String() string {
+	return FormatTelURI(t)
+}
+
+// TelURIForNumber returns the tel: URI for the passed in number, as formatted by Format in
+// RFC3966 format.
+func TelURIForNumber(number *PhoneNumber) (*TelURI, error) {
+	md := activeMetadata()
+
+	return ParseTelURI(format(md, number, RFC3966))
+}
+
+// ParseTelURI parses a tel: URI following the syntax defined in RFC3966. The ext, isub and
+// phone-context parameters are stored in their own fields and any other parameters are kept
+// in Params in the order they appeared. Parameter names are lower-cased, values are kept
+// exactly as written, including percent-encoding. Malformed URIs return a *TelURIError.
+func ParseTelURI(uri string) (*TelURI, error) {
+	if len(uri) < len(RFC3966_PREFIX) || !strings.EqualFold(uri[:len(RFC3966_PREFIX)], RFC3966_PREFIX) {
+		return nil, &TelURIError{URI: uri, Offset: 0, Err: ErrTelURIMissingPrefix}
+	}
+
+	offset := len(RFC3966_PREFIX)
+	parts := strings.Split(uri[offset:], ";")
+
+	telURI := &TelURI{Number: parts[0]}
+	numberOffset := offset
+	offset += len(parts[0]) + 1
+
+	// offset of each parameter we've seen, by name
+	seen := make(map[string]int)
+	for _, part := range parts[1:] {
+		name, value, hasValue := strings.Cut(part, "=")
+		name = strings.ToLower(name)
+
+		if !RFC3966_PARAM_NAME_PATTERN.MatchString(name) {
+			return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIInvalidParameter}
+		}
+		if _, found := seen[name]; found {
+			return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIDuplicateParameter}
+		}
+		seen[name] = offset
+
+		switch name {
+		case "ext":
+			if !RFC3966_EXTENSION_PATTERN.MatchString(value) {
+				return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIInvalidExtension}
+			}
+			telURI.Extension = value
+		case "isub":
+			if !RFC3966_ISDN_SUBADDRESS_PATTERN.MatchString(value) {
+				return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIInvalidSubaddress}
+			}
+			telURI.IsdnSubaddress = value
+		case "phone-context":
+			if !isPhoneContextValid(value) {
+				return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIInvalidPhoneContext}
+			}
+			telURI.PhoneContext = value
+		default:
+			if hasValue && !RFC3966_PARAM_VALUE_PATTERN.MatchString(value) {
+				return nil, &TelURIError{URI: uri, Offset: offset, Err: ErrTelURIInvalidParameter}
+			}
+			telURI.Params = append(telURI.Params, TelURIParam{Name: name, Value: value})
+		}
+		offset += len(part) + 1
+	}
+
+	// An extension and an isdn-subaddress are mutually exclusive, see paragraph 5.3 of RFC3966.
+	if telURI.Extension != "" && telURI.IsdnSubaddress != "" {
+		return nil, &TelURIError{URI: uri, Offset: seen["isub"], Err: ErrTelURIInvalidParameter}
+	}
+
+	if telURI.IsGlobal() {
+		if !RFC3966_GLOBAL_NUMBER_DIGITS_PATTERN.MatchString(telURI.Number) {
+			return nil, &TelURIError{URI: uri, Offset: numberOffset, Err: ErrTelURIInvalidNumber}
+		}
+		// Global numbers are already unique and may not carry a phone-context.
+		if contextOffset, found := seen["phone-context"]; found {
+			return nil, &TelURIError{URI: uri, Offset: contextOffset, Err: ErrTelURIInvalidPhoneContext}
+		}
+	} else {
+		if !RFC3966_LOCAL_NUMBER_DIGITS_PATTERN.MatchString(telURI.Number) {
+			return nil, &TelURIError{URI: uri, Offset: numberOffset, Err: ErrTelURIInvalidNumber}
+		}
+		if _, found := seen["phone-context"]; !found {
+			return nil, &TelURIError{URI: uri, Offset: len(uri), Err: ErrTelURIMissingPhoneContext}
+		}
+	}
+
+	return telURI, nil
+}
+
+// FormatTelURI formats the passed in URI as a tel: URI. Following paragraph 3 of RFC3966, the
+// extension or isdn-subaddress comes first, followed by the phone-context and then any other
+// parameters in lexicographical order, so ParseTelURI(FormatTelURI(t)) always yields a URI
+// equal to t save for the order of Params.
+func FormatTelURI(t *TelURI) string {
+	uri := NewBuilderString(RFC3966_PREFIX)
+	uri.WriteString(t.Number)
+	if t.Extension != "" {
+		uri.WriteString(RFC3966_EXTN_PREFIX)
+		uri.WriteString(t.Extension)
+	} else if t.IsdnSubaddress != "" {
+		uri.WriteString(RFC3966_ISDN_SUBADDRESS)
+		uri.WriteString(t.IsdnSubaddress)
+	}
+	if t.PhoneContext != "" {
+		uri.WriteString(RFC3966_PHONE_CONTEXT)
+		uri.WriteString(t.PhoneContext)
+	}
+
+	params := make([]TelURIParam, len(t.Params))
+	copy(params, t.Params)
+	sort.SliceStable(params, func(i, j int) bool { return params[i].Name < params[j].Name })
+	for _, p := range params {
+		uri.WriteString(";")
+		uri.WriteString(p.Name)
+		if p.Value != "" {
+			uri.WriteString("=")
+			uri.WriteString(p.Value)
+		}
+	}
+	return uri.String()
+}